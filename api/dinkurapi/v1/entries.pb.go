@@ -29,6 +29,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -41,6 +42,201 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Weekday is an enumeration of the days of the week.
+type Weekday int32
+
+const (
+	Weekday_WEEKDAY_SUNDAY    Weekday = 0
+	Weekday_WEEKDAY_MONDAY    Weekday = 1
+	Weekday_WEEKDAY_TUESDAY   Weekday = 2
+	Weekday_WEEKDAY_WEDNESDAY Weekday = 3
+	Weekday_WEEKDAY_THURSDAY  Weekday = 4
+	Weekday_WEEKDAY_FRIDAY    Weekday = 5
+	Weekday_WEEKDAY_SATURDAY  Weekday = 6
+)
+
+// Enum value maps for Weekday.
+var (
+	Weekday_name = map[int32]string{
+		0: "WEEKDAY_SUNDAY",
+		1: "WEEKDAY_MONDAY",
+		2: "WEEKDAY_TUESDAY",
+		3: "WEEKDAY_WEDNESDAY",
+		4: "WEEKDAY_THURSDAY",
+		5: "WEEKDAY_FRIDAY",
+		6: "WEEKDAY_SATURDAY",
+	}
+	Weekday_value = map[string]int32{
+		"WEEKDAY_SUNDAY":    0,
+		"WEEKDAY_MONDAY":    1,
+		"WEEKDAY_TUESDAY":   2,
+		"WEEKDAY_WEDNESDAY": 3,
+		"WEEKDAY_THURSDAY":  4,
+		"WEEKDAY_FRIDAY":    5,
+		"WEEKDAY_SATURDAY":  6,
+	}
+)
+
+func (x Weekday) Enum() *Weekday {
+	p := new(Weekday)
+	*p = x
+	return p
+}
+
+func (x Weekday) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weekday) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_dinkurapi_v1_entries_proto_enumTypes[0].Descriptor()
+}
+
+func (Weekday) Type() protoreflect.EnumType {
+	return &file_api_dinkurapi_v1_entries_proto_enumTypes[0]
+}
+
+func (x Weekday) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weekday.Descriptor instead.
+func (Weekday) EnumDescriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{0}
+}
+
+// OverlapPolicy is an enumeration of how to handle entries that overlap.
+type OverlapPolicy int32
+
+const (
+	// OVERLAP_POLICY_UNSPECIFIED means the policy is not properly initialized,
+	// and is considered undefined behavior.
+	OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED OverlapPolicy = 0
+	// OVERLAP_POLICY_ALLOW leaves overlapping entries as-is.
+	OverlapPolicy_OVERLAP_POLICY_ALLOW OverlapPolicy = 1
+	// OVERLAP_POLICY_REJECT fails any change that would make entries overlap.
+	OverlapPolicy_OVERLAP_POLICY_REJECT OverlapPolicy = 2
+	// OVERLAP_POLICY_TRIM shortens overlapped entries, or removes them if they
+	// are entirely covered.
+	OverlapPolicy_OVERLAP_POLICY_TRIM OverlapPolicy = 3
+	// OVERLAP_POLICY_SPLIT is the same as OVERLAP_POLICY_TRIM, except that an
+	// overlapped entry that covers the entire other entry is split in two
+	// around it.
+	OverlapPolicy_OVERLAP_POLICY_SPLIT OverlapPolicy = 4
+)
+
+// Enum value maps for OverlapPolicy.
+var (
+	OverlapPolicy_name = map[int32]string{
+		0: "OVERLAP_POLICY_UNSPECIFIED",
+		1: "OVERLAP_POLICY_ALLOW",
+		2: "OVERLAP_POLICY_REJECT",
+		3: "OVERLAP_POLICY_TRIM",
+		4: "OVERLAP_POLICY_SPLIT",
+	}
+	OverlapPolicy_value = map[string]int32{
+		"OVERLAP_POLICY_UNSPECIFIED": 0,
+		"OVERLAP_POLICY_ALLOW":       1,
+		"OVERLAP_POLICY_REJECT":      2,
+		"OVERLAP_POLICY_TRIM":        3,
+		"OVERLAP_POLICY_SPLIT":       4,
+	}
+)
+
+func (x OverlapPolicy) Enum() *OverlapPolicy {
+	p := new(OverlapPolicy)
+	*p = x
+	return p
+}
+
+func (x OverlapPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OverlapPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_dinkurapi_v1_entries_proto_enumTypes[1].Descriptor()
+}
+
+func (OverlapPolicy) Type() protoreflect.EnumType {
+	return &file_api_dinkurapi_v1_entries_proto_enumTypes[1]
+}
+
+func (x OverlapPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OverlapPolicy.Descriptor instead.
+func (OverlapPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{1}
+}
+
+// JournalAction is an enumeration of the kinds of changes to entries that can
+// be undone and redone.
+type JournalAction int32
+
+const (
+	// JOURNAL_ACTION_UNSPECIFIED means the action is not properly initialized,
+	// and is considered undefined behavior.
+	JournalAction_JOURNAL_ACTION_UNSPECIFIED JournalAction = 0
+	// JOURNAL_ACTION_CREATE means one or more entries were created, and may
+	// also have stopped the previously active entry.
+	JournalAction_JOURNAL_ACTION_CREATE JournalAction = 1
+	// JOURNAL_ACTION_UPDATE means an entry was edited.
+	JournalAction_JOURNAL_ACTION_UPDATE JournalAction = 2
+	// JOURNAL_ACTION_DELETE means an entry was removed.
+	JournalAction_JOURNAL_ACTION_DELETE JournalAction = 3
+	// JOURNAL_ACTION_STOP means the active entry was stopped.
+	JournalAction_JOURNAL_ACTION_STOP JournalAction = 4
+	// JOURNAL_ACTION_RESTORE means an entry was restored from the trash.
+	JournalAction_JOURNAL_ACTION_RESTORE JournalAction = 5
+)
+
+// Enum value maps for JournalAction.
+var (
+	JournalAction_name = map[int32]string{
+		0: "JOURNAL_ACTION_UNSPECIFIED",
+		1: "JOURNAL_ACTION_CREATE",
+		2: "JOURNAL_ACTION_UPDATE",
+		3: "JOURNAL_ACTION_DELETE",
+		4: "JOURNAL_ACTION_STOP",
+		5: "JOURNAL_ACTION_RESTORE",
+	}
+	JournalAction_value = map[string]int32{
+		"JOURNAL_ACTION_UNSPECIFIED": 0,
+		"JOURNAL_ACTION_CREATE":      1,
+		"JOURNAL_ACTION_UPDATE":      2,
+		"JOURNAL_ACTION_DELETE":      3,
+		"JOURNAL_ACTION_STOP":        4,
+		"JOURNAL_ACTION_RESTORE":     5,
+	}
+)
+
+func (x JournalAction) Enum() *JournalAction {
+	p := new(JournalAction)
+	*p = x
+	return p
+}
+
+func (x JournalAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JournalAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_dinkurapi_v1_entries_proto_enumTypes[2].Descriptor()
+}
+
+func (JournalAction) Type() protoreflect.EnumType {
+	return &file_api_dinkurapi_v1_entries_proto_enumTypes[2]
+}
+
+func (x JournalAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JournalAction.Descriptor instead.
+func (JournalAction) EnumDescriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{2}
+}
+
 // Shorthand is an enumeration of time span shorthands used for easier
 // queries.
 type GetEntryListRequest_Shorthand int32
@@ -114,11 +310,11 @@ func (x GetEntryListRequest_Shorthand) String() string {
 }
 
 func (GetEntryListRequest_Shorthand) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_dinkurapi_v1_entries_proto_enumTypes[0].Descriptor()
+	return file_api_dinkurapi_v1_entries_proto_enumTypes[3].Descriptor()
 }
 
 func (GetEntryListRequest_Shorthand) Type() protoreflect.EnumType {
-	return &file_api_dinkurapi_v1_entries_proto_enumTypes[0]
+	return &file_api_dinkurapi_v1_entries_proto_enumTypes[3]
 }
 
 func (x GetEntryListRequest_Shorthand) Number() protoreflect.EnumNumber {
@@ -130,6 +326,64 @@ func (GetEntryListRequest_Shorthand) EnumDescriptor() ([]byte, []int) {
 	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{6, 0}
 }
 
+// GroupBy is an enumeration of how entries are bucketed.
+type GetEntrySummaryRequest_GroupBy int32
+
+const (
+	// GROUP_BY_DAY buckets entries by the days they span.
+	GetEntrySummaryRequest_GROUP_BY_DAY GetEntrySummaryRequest_GroupBy = 0
+	// GROUP_BY_WEEK buckets entries by the ISO weeks they span, where weeks
+	// start on Mondays.
+	GetEntrySummaryRequest_GROUP_BY_WEEK GetEntrySummaryRequest_GroupBy = 1
+	// GROUP_BY_MONTH buckets entries by the months they span.
+	GetEntrySummaryRequest_GROUP_BY_MONTH GetEntrySummaryRequest_GroupBy = 2
+	// GROUP_BY_NAME buckets entries by their name.
+	GetEntrySummaryRequest_GROUP_BY_NAME GetEntrySummaryRequest_GroupBy = 3
+)
+
+// Enum value maps for GetEntrySummaryRequest_GroupBy.
+var (
+	GetEntrySummaryRequest_GroupBy_name = map[int32]string{
+		0: "GROUP_BY_DAY",
+		1: "GROUP_BY_WEEK",
+		2: "GROUP_BY_MONTH",
+		3: "GROUP_BY_NAME",
+	}
+	GetEntrySummaryRequest_GroupBy_value = map[string]int32{
+		"GROUP_BY_DAY":   0,
+		"GROUP_BY_WEEK":  1,
+		"GROUP_BY_MONTH": 2,
+		"GROUP_BY_NAME":  3,
+	}
+)
+
+func (x GetEntrySummaryRequest_GroupBy) Enum() *GetEntrySummaryRequest_GroupBy {
+	p := new(GetEntrySummaryRequest_GroupBy)
+	*p = x
+	return p
+}
+
+func (x GetEntrySummaryRequest_GroupBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetEntrySummaryRequest_GroupBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_dinkurapi_v1_entries_proto_enumTypes[4].Descriptor()
+}
+
+func (GetEntrySummaryRequest_GroupBy) Type() protoreflect.EnumType {
+	return &file_api_dinkurapi_v1_entries_proto_enumTypes[4]
+}
+
+func (x GetEntrySummaryRequest_GroupBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetEntrySummaryRequest_GroupBy.Descriptor instead.
+func (GetEntrySummaryRequest_GroupBy) EnumDescriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{8, 0}
+}
+
 // PingRequest is an empty message and unused. It is here as a
 // placeholder for potential future use.
 type PingRequest struct {
@@ -433,6 +687,16 @@ type GetEntryListRequest struct {
 	// NameHighlightEnd enables name search result highlighting. It does nothing
 	// if the fuzzy name query is empty.
 	NameHighlightEnd string `protobuf:"bytes,7,opt,name=name_highlight_end,json=nameHighlightEnd,proto3" json:"name_highlight_end,omitempty"`
+	// Tags filters the results to only include entries that has all of these
+	// tags.
+	Tags []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// ExcludeTags filters the results to only include entries that has none of
+	// these tags.
+	ExcludeTags []string `protobuf:"bytes,9,rep,name=exclude_tags,json=excludeTags,proto3" json:"exclude_tags,omitempty"`
+	// ProjectIdOrZero filters the results to only include entries that
+	// references the project with this ID. A value of zero means no project
+	// filtering is applied.
+	ProjectIdOrZero uint64 `protobuf:"varint,10,opt,name=project_id_or_zero,json=projectIdOrZero,proto3" json:"project_id_or_zero,omitempty"`
 }
 
 func (x *GetEntryListRequest) Reset() {
@@ -516,6 +780,27 @@ func (x *GetEntryListRequest) GetNameHighlightEnd() string {
 	return ""
 }
 
+func (x *GetEntryListRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetEntryListRequest) GetExcludeTags() []string {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
+func (x *GetEntryListRequest) GetProjectIdOrZero() uint64 {
+	if x != nil {
+		return x.ProjectIdOrZero
+	}
+	return 0
+}
+
 // GetEntryListResponse holds the list of entries that matches the search
 // request.
 type GetEntryListResponse struct {
@@ -566,36 +851,40 @@ func (x *GetEntryListResponse) GetEntries() []*Entry {
 	return nil
 }
 
-// CreateEntryRequest defines a new entry to be created.
-type CreateEntryRequest struct {
+// GetEntrySummaryRequest holds query parameters for summarizing the durations
+// of entries. When bucketed by day, week, or month, entries that cross a bucket
+// boundary are split so that each bucket only counts the part of the entry
+// within that bucket. When bucketed by name, entries are filtered by their start
+// timestamp.
+type GetEntrySummaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name is the name of the new entry to be created. May not be left unset.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Start is the timestamp of when the entry starts. If left unset, it will
-	// default to now.
-	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
-	// End is the timestamp of when the entry ends. If set, the new entry will not
-	// be marked as active. If left unset, any currently active entry will be
-	// stopped at when this new entry starts.
-	End *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
-	// StartAfterIdOrZero allows automatically setting the start timestamp to the
-	// end timestamp of a entry by ID.
-	StartAfterIdOrZero uint64 `protobuf:"varint,4,opt,name=start_after_id_or_zero,json=startAfterIdOrZero,proto3" json:"start_after_id_or_zero,omitempty"`
-	// EndBeforeIdOrZero allows automatically setting the end timestamp to the
-	// start timestamp of a entry by ID.
-	EndBeforeIdOrZero uint64 `protobuf:"varint,5,opt,name=end_before_id_or_zero,json=endBeforeIdOrZero,proto3" json:"end_before_id_or_zero,omitempty"`
-	// StartAfterLast allows automatically setting the start timestamp to the
-	// end timestamp of the latest entry. Using this and the
-	// "start after ID or zero" field is considered undefined behavior, and should
-	// be avoided.
-	StartAfterLast bool `protobuf:"varint,6,opt,name=start_after_last,json=startAfterLast,proto3" json:"start_after_last,omitempty"`
-}
-
-func (x *CreateEntryRequest) Reset() {
-	*x = CreateEntryRequest{}
+	// Start is the starting timestamp bound of entries to summarize. Any entry
+	// that starts after this time is included. Will override any start
+	// timestamp (if any) set by the shorthand field. If left unset, it defaults
+	// to the start of the first entry.
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// End is the ending timestamp bound of entries to summarize. Any entry that
+	// starts before this time is included. Will override any end timestamp
+	// (if any) set by the shorthand field. If left unset, it defaults to the
+	// end of the last entry.
+	End *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// Shorthand sets the default start and end timestamps to some predefined
+	// time ranges, relative to now in the given time zone. Setting the start or
+	// end fields separately will override the shorthand ranges.
+	Shorthand GetEntryListRequest_Shorthand `protobuf:"varint,3,opt,name=shorthand,proto3,enum=dinkurapi.v1.GetEntryListRequest_Shorthand" json:"shorthand,omitempty"`
+	// GroupBy decides how entries are bucketed. Defaults to by day.
+	GroupBy GetEntrySummaryRequest_GroupBy `protobuf:"varint,4,opt,name=group_by,json=groupBy,proto3,enum=dinkurapi.v1.GetEntrySummaryRequest_GroupBy" json:"group_by,omitempty"`
+	// TimeZone is the IANA time zone name, such as "Europe/Stockholm", used when
+	// deciding the day, week, and month boundaries. If left empty, then the
+	// local time zone of the Dinkur daemon is used.
+	TimeZone string `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *GetEntrySummaryRequest) Reset() {
+	*x = GetEntrySummaryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,13 +892,13 @@ func (x *CreateEntryRequest) Reset() {
 	}
 }
 
-func (x *CreateEntryRequest) String() string {
+func (x *GetEntrySummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEntryRequest) ProtoMessage() {}
+func (*GetEntrySummaryRequest) ProtoMessage() {}
 
-func (x *CreateEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *GetEntrySummaryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -621,68 +910,60 @@ func (x *CreateEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEntryRequest.ProtoReflect.Descriptor instead.
-func (*CreateEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEntrySummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetEntrySummaryRequest) Descriptor() ([]byte, []int) {
 	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateEntryRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateEntryRequest) GetStart() *timestamppb.Timestamp {
+func (x *GetEntrySummaryRequest) GetStart() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Start
 	}
 	return nil
 }
 
-func (x *CreateEntryRequest) GetEnd() *timestamppb.Timestamp {
+func (x *GetEntrySummaryRequest) GetEnd() *timestamppb.Timestamp {
 	if x != nil {
 		return x.End
 	}
 	return nil
 }
 
-func (x *CreateEntryRequest) GetStartAfterIdOrZero() uint64 {
+func (x *GetEntrySummaryRequest) GetShorthand() GetEntryListRequest_Shorthand {
 	if x != nil {
-		return x.StartAfterIdOrZero
+		return x.Shorthand
 	}
-	return 0
+	return GetEntryListRequest_SHORTHAND_UNSPECIFIED
 }
 
-func (x *CreateEntryRequest) GetEndBeforeIdOrZero() uint64 {
+func (x *GetEntrySummaryRequest) GetGroupBy() GetEntrySummaryRequest_GroupBy {
 	if x != nil {
-		return x.EndBeforeIdOrZero
+		return x.GroupBy
 	}
-	return 0
+	return GetEntrySummaryRequest_GROUP_BY_DAY
 }
 
-func (x *CreateEntryRequest) GetStartAfterLast() bool {
+func (x *GetEntrySummaryRequest) GetTimeZone() string {
 	if x != nil {
-		return x.StartAfterLast
+		return x.TimeZone
 	}
-	return false
+	return ""
 }
 
-// CreateEntryResponse holds the response data of a successfully created entry.
-type CreateEntryResponse struct {
+// GetEntrySummaryResponse holds the summarized buckets of entries.
+type GetEntrySummaryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// CreatedEntry is the newly created entry.
-	CreatedEntry *Entry `protobuf:"bytes,1,opt,name=created_entry,json=createdEntry,proto3" json:"created_entry,omitempty"`
-	// PreviouslyActiveEntry is the previously active entry that was stopped
-	// (if any).
-	PreviouslyActiveEntry *Entry `protobuf:"bytes,2,opt,name=previously_active_entry,json=previouslyActiveEntry,proto3" json:"previously_active_entry,omitempty"`
+	// Summaries is the list of buckets, sorted by time when bucketed by day,
+	// week, or month, or by longest duration first when bucketed by name.
+	// Empty day, week, or month buckets are included.
+	Summaries []*EntrySummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
 }
 
-func (x *CreateEntryResponse) Reset() {
-	*x = CreateEntryResponse{}
+func (x *GetEntrySummaryResponse) Reset() {
+	*x = GetEntrySummaryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -690,13 +971,13 @@ func (x *CreateEntryResponse) Reset() {
 	}
 }
 
-func (x *CreateEntryResponse) String() string {
+func (x *GetEntrySummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEntryResponse) ProtoMessage() {}
+func (*GetEntrySummaryResponse) ProtoMessage() {}
 
-func (x *CreateEntryResponse) ProtoReflect() protoreflect.Message {
+func (x *GetEntrySummaryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -708,73 +989,42 @@ func (x *CreateEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEntryResponse.ProtoReflect.Descriptor instead.
-func (*CreateEntryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEntrySummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetEntrySummaryResponse) Descriptor() ([]byte, []int) {
 	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateEntryResponse) GetCreatedEntry() *Entry {
-	if x != nil {
-		return x.CreatedEntry
-	}
-	return nil
-}
-
-func (x *CreateEntryResponse) GetPreviouslyActiveEntry() *Entry {
+func (x *GetEntrySummaryResponse) GetSummaries() []*EntrySummary {
 	if x != nil {
-		return x.PreviouslyActiveEntry
+		return x.Summaries
 	}
 	return nil
 }
 
-// UpdateEntryRequest holds data for updating a entry.
-type UpdateEntryRequest struct {
+// EntrySummary is the summed up duration of a bucket of entries.
+type EntrySummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// IdOrZero is either the ID of the entry to update, or left as zero to update
-	// the latest or currently active entry.
-	IdOrZero uint64 `protobuf:"varint,1,opt,name=id_or_zero,json=idOrZero,proto3" json:"id_or_zero,omitempty"`
-	// Name is the new name of the entry. If left unset, the name will not be
-	// updated.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Start is the new starting timestamp of the entry. If left unset, the start
-	// timestamp will not be updated.
-	Start *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
-	// End is the new ending timestamp of the entry. If left unset, the end
-	// timestamp will not be updated. If set and the entry was previously active
-	// then the entry is no longer active.
-	End *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
-	// AppendName changes the name field in this message to be appended to the
-	// end of the previous name, with a space as delimiter.
-	AppendName bool `protobuf:"varint,5,opt,name=append_name,json=appendName,proto3" json:"append_name,omitempty"`
-	// StartAfterIdOrZero allows automatically setting the start timestamp to the
-	// end timestamp of a entry by ID.
-	StartAfterIdOrZero uint64 `protobuf:"varint,6,opt,name=start_after_id_or_zero,json=startAfterIdOrZero,proto3" json:"start_after_id_or_zero,omitempty"`
-	// EndBeforeIdOrZero allows automatically setting the end timestamp to the
-	// start timestamp of a entry by ID. If set and the entry was previously
-	// active then the entry is no longer active.
-	EndBeforeIdOrZero uint64 `protobuf:"varint,7,opt,name=end_before_id_or_zero,json=endBeforeIdOrZero,proto3" json:"end_before_id_or_zero,omitempty"`
-	// StartAfterLast allows automatically setting the start timestamp to the
-	// end timestamp of the latest entry. Using this and the
-	// "start after ID or zero" field is considered undefined behavior, and should
-	// be avoided.
-	StartAfterLast bool `protobuf:"varint,8,opt,name=start_after_last,json=startAfterLast,proto3" json:"start_after_last,omitempty"`
-	// StartFuzzy is the new entry start timestamp, but will be parsed fuzzy.
-	// This is ignored if empty string or if Start is supplied.
-	//
-	// No change to the entry start timestamp is applied if this is set to empty.
-	StartFuzzy string `protobuf:"bytes,9,opt,name=start_fuzzy,json=startFuzzy,proto3" json:"start_fuzzy,omitempty"`
-	// EndFuzzy is the new entry end timestamp, but will be parsed fuzzy.
-	// This is ignored if empty string or if End is supplied.
-	//
-	// No change to the entry end timestamp is applied if this is set to empty.
-	EndFuzzy string `protobuf:"bytes,10,opt,name=end_fuzzy,json=endFuzzy,proto3" json:"end_fuzzy,omitempty"`
+	// Key identifies the bucket, such as "2021-12-24" when bucketed by day,
+	// "2021-W51" when bucketed by week, "2021-12" when bucketed by month, or the
+	// entry name when bucketed by name.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Start is the start of the bucket's time span, or unset when bucketed by
+	// name.
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// End is the end of the bucket's time span, or unset when bucketed by name.
+	End *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// Entries is the number of entries in the bucket.
+	Entries uint64 `protobuf:"varint,4,opt,name=entries,proto3" json:"entries,omitempty"`
+	// Duration is the sum of all the entries' durations in the bucket, where
+	// active entries are counted up until now.
+	Duration *durationpb.Duration `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
-func (x *UpdateEntryRequest) Reset() {
-	*x = UpdateEntryRequest{}
+func (x *EntrySummary) Reset() {
+	*x = EntrySummary{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -782,13 +1032,13 @@ func (x *UpdateEntryRequest) Reset() {
 	}
 }
 
-func (x *UpdateEntryRequest) String() string {
+func (x *EntrySummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEntryRequest) ProtoMessage() {}
+func (*EntrySummary) ProtoMessage() {}
 
-func (x *UpdateEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *EntrySummary) ProtoReflect() protoreflect.Message {
 	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -800,110 +1050,195 @@ func (x *UpdateEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEntryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EntrySummary.ProtoReflect.Descriptor instead.
+func (*EntrySummary) Descriptor() ([]byte, []int) {
 	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateEntryRequest) GetIdOrZero() uint64 {
+func (x *EntrySummary) GetKey() string {
 	if x != nil {
-		return x.IdOrZero
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateEntryRequest) GetName() string {
+func (x *EntrySummary) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.Start
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateEntryRequest) GetStart() *timestamppb.Timestamp {
+func (x *EntrySummary) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *EntrySummary) GetEntries() uint64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *EntrySummary) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// GetEntryGapsRequest holds fields used when searching for gaps of
+// unaccounted time between entries.
+type GetEntryGapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start is the starting timestamp bound of entries to find gaps between.
+	// Will override any start timestamp (if any) set by the shorthand field.
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// End is the ending timestamp bound of entries to find gaps between. Will
+	// override any end timestamp (if any) set by the shorthand field.
+	End *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// Shorthand sets the default start and end timestamps to some predefined
+	// time ranges, relative to now in the given time zone. Setting the start or
+	// end fields separately will override the shorthand ranges.
+	Shorthand GetEntryListRequest_Shorthand `protobuf:"varint,3,opt,name=shorthand,proto3,enum=dinkurapi.v1.GetEntryListRequest_Shorthand" json:"shorthand,omitempty"`
+	// WorkHoursStart is the time of day, as a duration since midnight, when
+	// working hours start.
+	WorkHoursStart *durationpb.Duration `protobuf:"bytes,4,opt,name=work_hours_start,json=workHoursStart,proto3" json:"work_hours_start,omitempty"`
+	// WorkHoursEnd is the time of day, as a duration since midnight, when
+	// working hours end. If left unset, then working hours end at midnight.
+	WorkHoursEnd *durationpb.Duration `protobuf:"bytes,5,opt,name=work_hours_end,json=workHoursEnd,proto3" json:"work_hours_end,omitempty"`
+	// WorkDays are the days of the week that have working hours. If left
+	// empty, then all days have working hours.
+	WorkDays []Weekday `protobuf:"varint,6,rep,packed,name=work_days,json=workDays,proto3,enum=dinkurapi.v1.Weekday" json:"work_days,omitempty"`
+	// MinDuration leaves out gaps that are shorter than this.
+	MinDuration *durationpb.Duration `protobuf:"bytes,7,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	// TimeZone is the IANA time zone name, such as "Europe/Stockholm", used when
+	// deciding the working hours of each day. If left empty, then the local
+	// time zone of the Dinkur daemon is used.
+	TimeZone string `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *GetEntryGapsRequest) Reset() {
+	*x = GetEntryGapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntryGapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntryGapsRequest) ProtoMessage() {}
+
+func (x *GetEntryGapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntryGapsRequest.ProtoReflect.Descriptor instead.
+func (*GetEntryGapsRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetEntryGapsRequest) GetStart() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Start
 	}
 	return nil
 }
 
-func (x *UpdateEntryRequest) GetEnd() *timestamppb.Timestamp {
+func (x *GetEntryGapsRequest) GetEnd() *timestamppb.Timestamp {
 	if x != nil {
 		return x.End
 	}
 	return nil
 }
 
-func (x *UpdateEntryRequest) GetAppendName() bool {
+func (x *GetEntryGapsRequest) GetShorthand() GetEntryListRequest_Shorthand {
 	if x != nil {
-		return x.AppendName
+		return x.Shorthand
 	}
-	return false
+	return GetEntryListRequest_SHORTHAND_UNSPECIFIED
 }
 
-func (x *UpdateEntryRequest) GetStartAfterIdOrZero() uint64 {
+func (x *GetEntryGapsRequest) GetWorkHoursStart() *durationpb.Duration {
 	if x != nil {
-		return x.StartAfterIdOrZero
+		return x.WorkHoursStart
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateEntryRequest) GetEndBeforeIdOrZero() uint64 {
+func (x *GetEntryGapsRequest) GetWorkHoursEnd() *durationpb.Duration {
 	if x != nil {
-		return x.EndBeforeIdOrZero
+		return x.WorkHoursEnd
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateEntryRequest) GetStartAfterLast() bool {
+func (x *GetEntryGapsRequest) GetWorkDays() []Weekday {
 	if x != nil {
-		return x.StartAfterLast
+		return x.WorkDays
 	}
-	return false
+	return nil
 }
 
-func (x *UpdateEntryRequest) GetStartFuzzy() string {
+func (x *GetEntryGapsRequest) GetMinDuration() *durationpb.Duration {
 	if x != nil {
-		return x.StartFuzzy
+		return x.MinDuration
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateEntryRequest) GetEndFuzzy() string {
+func (x *GetEntryGapsRequest) GetTimeZone() string {
 	if x != nil {
-		return x.EndFuzzy
+		return x.TimeZone
 	}
 	return ""
 }
 
-// UpdateEntryResponse holds the before and after state of the updated entry.
-type UpdateEntryResponse struct {
+// GetEntryGapsResponse holds the gaps between entries.
+type GetEntryGapsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Before is the state of the entry before the update.
-	Before *Entry `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
-	// After is the up-to-date state of the entry now after the update.
-	After *Entry `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	// Gaps is the list of gaps, sorted by time.
+	Gaps []*EntryGap `protobuf:"bytes,1,rep,name=gaps,proto3" json:"gaps,omitempty"`
 }
 
-func (x *UpdateEntryResponse) Reset() {
-	*x = UpdateEntryResponse{}
+func (x *GetEntryGapsResponse) Reset() {
+	*x = GetEntryGapsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[11]
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateEntryResponse) String() string {
+func (x *GetEntryGapsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEntryResponse) ProtoMessage() {}
+func (*GetEntryGapsResponse) ProtoMessage() {}
 
-func (x *UpdateEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[11]
+func (x *GetEntryGapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,52 +1249,1753 @@ func (x *UpdateEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEntryResponse.ProtoReflect.Descriptor instead.
-func (*UpdateEntryResponse) Descriptor() ([]byte, []int) {
-	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetEntryGapsResponse.ProtoReflect.Descriptor instead.
+func (*GetEntryGapsResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UpdateEntryResponse) GetBefore() *Entry {
+func (x *GetEntryGapsResponse) GetGaps() []*EntryGap {
 	if x != nil {
-		return x.Before
+		return x.Gaps
 	}
 	return nil
 }
 
-func (x *UpdateEntryResponse) GetAfter() *Entry {
+// EntryGap is a span of unaccounted time between two entries, within working
+// hours.
+type EntryGap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start is the start of the gap.
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// End is the end of the gap.
+	End *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// Previous is the last entry that ended before the gap. Unset if the gap
+	// starts at the start of the searched time span.
+	Previous *Entry `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	// Next is the first entry that started after the gap. Unset if the gap ends
+	// at the end of the searched time span.
+	Next *Entry `protobuf:"bytes,4,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *EntryGap) Reset() {
+	*x = EntryGap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntryGap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryGap) ProtoMessage() {}
+
+func (x *EntryGap) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryGap.ProtoReflect.Descriptor instead.
+func (*EntryGap) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EntryGap) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.After
+		return x.Start
 	}
 	return nil
 }
 
-// DeleteEntryRequest holds the ID of the entry to delete.
-type DeleteEntryRequest struct {
+func (x *EntryGap) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *EntryGap) GetPrevious() *Entry {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *EntryGap) GetNext() *Entry {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+// CreateEntryRequest defines a new entry to be created.
+type CreateEntryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Id is the ID of the entry to delete.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name is the name of the new entry to be created. May not be left unset.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Start is the timestamp of when the entry starts. If left unset, it will
+	// default to now.
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// End is the timestamp of when the entry ends. If set, the new entry will not
+	// be marked as active. If left unset, any currently active entry will be
+	// stopped at when this new entry starts.
+	End *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// StartAfterIdOrZero allows automatically setting the start timestamp to the
+	// end timestamp of a entry by ID.
+	StartAfterIdOrZero uint64 `protobuf:"varint,4,opt,name=start_after_id_or_zero,json=startAfterIdOrZero,proto3" json:"start_after_id_or_zero,omitempty"`
+	// EndBeforeIdOrZero allows automatically setting the end timestamp to the
+	// start timestamp of a entry by ID.
+	EndBeforeIdOrZero uint64 `protobuf:"varint,5,opt,name=end_before_id_or_zero,json=endBeforeIdOrZero,proto3" json:"end_before_id_or_zero,omitempty"`
+	// StartAfterLast allows automatically setting the start timestamp to the
+	// end timestamp of the latest entry. Using this and the
+	// "start after ID or zero" field is considered undefined behavior, and should
+	// be avoided.
+	StartAfterLast bool `protobuf:"varint,6,opt,name=start_after_last,json=startAfterLast,proto3" json:"start_after_last,omitempty"`
+	// Tags is the list of tags to attach to the new entry.
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// ProjectIdOrZero is the ID of the project the new entry references, or
+	// zero to not reference any project.
+	ProjectIdOrZero uint64 `protobuf:"varint,8,opt,name=project_id_or_zero,json=projectIdOrZero,proto3" json:"project_id_or_zero,omitempty"`
+	// Note is the longer free-text description of the new entry. May be left
+	// unset.
+	Note string `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	// ProjectName is the name of the project the new entry references, used
+	// only if the "project ID or zero" field is left unset. The project is
+	// created if no project with this name and client already exists.
+	ProjectName string `protobuf:"bytes,10,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	// ProjectClient is the client name of the project referenced by the
+	// "project name" field. May be left unset.
+	ProjectClient string `protobuf:"bytes,11,opt,name=project_client,json=projectClient,proto3" json:"project_client,omitempty"`
 }
 
-func (x *DeleteEntryRequest) Reset() {
-	*x = DeleteEntryRequest{}
+func (x *CreateEntryRequest) Reset() {
+	*x = CreateEntryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[12]
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteEntryRequest) String() string {
+func (x *CreateEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntryRequest) ProtoMessage() {}
+func (*CreateEntryRequest) ProtoMessage() {}
 
-func (x *DeleteEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[12]
+func (x *CreateEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateEntryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEntryRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *CreateEntryRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *CreateEntryRequest) GetStartAfterIdOrZero() uint64 {
+	if x != nil {
+		return x.StartAfterIdOrZero
+	}
+	return 0
+}
+
+func (x *CreateEntryRequest) GetEndBeforeIdOrZero() uint64 {
+	if x != nil {
+		return x.EndBeforeIdOrZero
+	}
+	return 0
+}
+
+func (x *CreateEntryRequest) GetStartAfterLast() bool {
+	if x != nil {
+		return x.StartAfterLast
+	}
+	return false
+}
+
+func (x *CreateEntryRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateEntryRequest) GetProjectIdOrZero() uint64 {
+	if x != nil {
+		return x.ProjectIdOrZero
+	}
+	return 0
+}
+
+func (x *CreateEntryRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreateEntryRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *CreateEntryRequest) GetProjectClient() string {
+	if x != nil {
+		return x.ProjectClient
+	}
+	return ""
+}
+
+// CreateEntryResponse holds the response data of a successfully created entry.
+type CreateEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CreatedEntry is the newly created entry.
+	CreatedEntry *Entry `protobuf:"bytes,1,opt,name=created_entry,json=createdEntry,proto3" json:"created_entry,omitempty"`
+	// PreviouslyActiveEntry is the previously active entry that was stopped
+	// (if any).
+	PreviouslyActiveEntry *Entry `protobuf:"bytes,2,opt,name=previously_active_entry,json=previouslyActiveEntry,proto3" json:"previously_active_entry,omitempty"`
+}
+
+func (x *CreateEntryResponse) Reset() {
+	*x = CreateEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEntryResponse) ProtoMessage() {}
+
+func (x *CreateEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEntryResponse.ProtoReflect.Descriptor instead.
+func (*CreateEntryResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateEntryResponse) GetCreatedEntry() *Entry {
+	if x != nil {
+		return x.CreatedEntry
+	}
+	return nil
+}
+
+func (x *CreateEntryResponse) GetPreviouslyActiveEntry() *Entry {
+	if x != nil {
+		return x.PreviouslyActiveEntry
+	}
+	return nil
+}
+
+// CreateEntriesRequest holds the new entries to be created.
+type CreateEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Entries are the new entries to be created, in order.
+	Entries []*CreateEntryRequest `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *CreateEntriesRequest) Reset() {
+	*x = CreateEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEntriesRequest) ProtoMessage() {}
+
+func (x *CreateEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEntriesRequest.ProtoReflect.Descriptor instead.
+func (*CreateEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateEntriesRequest) GetEntries() []*CreateEntryRequest {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// CreateEntriesResponse holds the response data of the successfully created
+// entries.
+type CreateEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Entries holds the created entries, in the same order as in the request.
+	Entries []*CreateEntryResponse `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *CreateEntriesResponse) Reset() {
+	*x = CreateEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEntriesResponse) ProtoMessage() {}
+
+func (x *CreateEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEntriesResponse.ProtoReflect.Descriptor instead.
+func (*CreateEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateEntriesResponse) GetEntries() []*CreateEntryResponse {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// UpdateEntryRequest holds data for updating a entry.
+type UpdateEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IdOrZero is either the ID of the entry to update, or left as zero to update
+	// the latest or currently active entry.
+	IdOrZero uint64 `protobuf:"varint,1,opt,name=id_or_zero,json=idOrZero,proto3" json:"id_or_zero,omitempty"`
+	// Name is the new name of the entry. If left unset, the name will not be
+	// updated.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Start is the new starting timestamp of the entry. If left unset, the start
+	// timestamp will not be updated.
+	Start *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	// End is the new ending timestamp of the entry. If left unset, the end
+	// timestamp will not be updated. If set and the entry was previously active
+	// then the entry is no longer active.
+	End *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// AppendName changes the name field in this message to be appended to the
+	// end of the previous name, with a space as delimiter.
+	AppendName bool `protobuf:"varint,5,opt,name=append_name,json=appendName,proto3" json:"append_name,omitempty"`
+	// StartAfterIdOrZero allows automatically setting the start timestamp to the
+	// end timestamp of a entry by ID.
+	StartAfterIdOrZero uint64 `protobuf:"varint,6,opt,name=start_after_id_or_zero,json=startAfterIdOrZero,proto3" json:"start_after_id_or_zero,omitempty"`
+	// EndBeforeIdOrZero allows automatically setting the end timestamp to the
+	// start timestamp of a entry by ID. If set and the entry was previously
+	// active then the entry is no longer active.
+	EndBeforeIdOrZero uint64 `protobuf:"varint,7,opt,name=end_before_id_or_zero,json=endBeforeIdOrZero,proto3" json:"end_before_id_or_zero,omitempty"`
+	// StartAfterLast allows automatically setting the start timestamp to the
+	// end timestamp of the latest entry. Using this and the
+	// "start after ID or zero" field is considered undefined behavior, and should
+	// be avoided.
+	StartAfterLast bool `protobuf:"varint,8,opt,name=start_after_last,json=startAfterLast,proto3" json:"start_after_last,omitempty"`
+	// StartFuzzy is the new entry start timestamp, but will be parsed fuzzy.
+	// This is ignored if empty string or if Start is supplied.
+	//
+	// No change to the entry start timestamp is applied if this is set to empty.
+	StartFuzzy string `protobuf:"bytes,9,opt,name=start_fuzzy,json=startFuzzy,proto3" json:"start_fuzzy,omitempty"`
+	// EndFuzzy is the new entry end timestamp, but will be parsed fuzzy.
+	// This is ignored if empty string or if End is supplied.
+	//
+	// No change to the entry end timestamp is applied if this is set to empty.
+	EndFuzzy string `protobuf:"bytes,10,opt,name=end_fuzzy,json=endFuzzy,proto3" json:"end_fuzzy,omitempty"`
+	// Tags are added to the entry's existing tags. Tags that the entry already
+	// has are ignored.
+	Tags []string `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	// RemoveTags are removed from the entry's existing tags. Tags that the entry
+	// does not have are ignored.
+	RemoveTags []string `protobuf:"bytes,12,rep,name=remove_tags,json=removeTags,proto3" json:"remove_tags,omitempty"`
+	// ProjectIdOrZero is the ID of the new project the entry references. If
+	// left as zero, the project reference will not be updated.
+	ProjectIdOrZero uint64 `protobuf:"varint,13,opt,name=project_id_or_zero,json=projectIdOrZero,proto3" json:"project_id_or_zero,omitempty"`
+	// RemoveProject changes the entry to no longer reference any project. The
+	// project ID field is ignored if this is set.
+	RemoveProject bool `protobuf:"varint,14,opt,name=remove_project,json=removeProject,proto3" json:"remove_project,omitempty"`
+	// Note is the new note of the entry. If left unset, the note will not be
+	// updated.
+	Note string `protobuf:"bytes,15,opt,name=note,proto3" json:"note,omitempty"`
+	// AppendNote changes the note field in this message to be appended to the
+	// end of the previous note, with a newline as delimiter.
+	AppendNote bool `protobuf:"varint,16,opt,name=append_note,json=appendNote,proto3" json:"append_note,omitempty"`
+	// RemoveNote changes the entry to no longer have a note. The note field is
+	// ignored if this is set.
+	RemoveNote bool `protobuf:"varint,17,opt,name=remove_note,json=removeNote,proto3" json:"remove_note,omitempty"`
+}
+
+func (x *UpdateEntryRequest) Reset() {
+	*x = UpdateEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEntryRequest) ProtoMessage() {}
+
+func (x *UpdateEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEntryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateEntryRequest) GetIdOrZero() uint64 {
+	if x != nil {
+		return x.IdOrZero
+	}
+	return 0
+}
+
+func (x *UpdateEntryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateEntryRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *UpdateEntryRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *UpdateEntryRequest) GetAppendName() bool {
+	if x != nil {
+		return x.AppendName
+	}
+	return false
+}
+
+func (x *UpdateEntryRequest) GetStartAfterIdOrZero() uint64 {
+	if x != nil {
+		return x.StartAfterIdOrZero
+	}
+	return 0
+}
+
+func (x *UpdateEntryRequest) GetEndBeforeIdOrZero() uint64 {
+	if x != nil {
+		return x.EndBeforeIdOrZero
+	}
+	return 0
+}
+
+func (x *UpdateEntryRequest) GetStartAfterLast() bool {
+	if x != nil {
+		return x.StartAfterLast
+	}
+	return false
+}
+
+func (x *UpdateEntryRequest) GetStartFuzzy() string {
+	if x != nil {
+		return x.StartFuzzy
+	}
+	return ""
+}
+
+func (x *UpdateEntryRequest) GetEndFuzzy() string {
+	if x != nil {
+		return x.EndFuzzy
+	}
+	return ""
+}
+
+func (x *UpdateEntryRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateEntryRequest) GetRemoveTags() []string {
+	if x != nil {
+		return x.RemoveTags
+	}
+	return nil
+}
+
+func (x *UpdateEntryRequest) GetProjectIdOrZero() uint64 {
+	if x != nil {
+		return x.ProjectIdOrZero
+	}
+	return 0
+}
+
+func (x *UpdateEntryRequest) GetRemoveProject() bool {
+	if x != nil {
+		return x.RemoveProject
+	}
+	return false
+}
+
+func (x *UpdateEntryRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *UpdateEntryRequest) GetAppendNote() bool {
+	if x != nil {
+		return x.AppendNote
+	}
+	return false
+}
+
+func (x *UpdateEntryRequest) GetRemoveNote() bool {
+	if x != nil {
+		return x.RemoveNote
+	}
+	return false
+}
+
+// UpdateEntryResponse holds the before and after state of the updated entry.
+type UpdateEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Before is the state of the entry before the update.
+	Before *Entry `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	// After is the up-to-date state of the entry now after the update.
+	After *Entry `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *UpdateEntryResponse) Reset() {
+	*x = UpdateEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEntryResponse) ProtoMessage() {}
+
+func (x *UpdateEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEntryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEntryResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateEntryResponse) GetBefore() *Entry {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *UpdateEntryResponse) GetAfter() *Entry {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// DeleteEntryRequest holds the ID of the entry to delete.
+type DeleteEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the ID of the entry to delete.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteEntryRequest) Reset() {
+	*x = DeleteEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntryRequest) ProtoMessage() {}
+
+func (x *DeleteEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteEntryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeleteEntryResponse holds the entry that was deleted.
+type DeleteEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DeletedEntry is the entry that was deleted.
+	DeletedEntry *Entry `protobuf:"bytes,1,opt,name=deleted_entry,json=deletedEntry,proto3" json:"deleted_entry,omitempty"`
+}
+
+func (x *DeleteEntryResponse) Reset() {
+	*x = DeleteEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntryResponse) ProtoMessage() {}
+
+func (x *DeleteEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEntryResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteEntryResponse) GetDeletedEntry() *Entry {
+	if x != nil {
+		return x.DeletedEntry
+	}
+	return nil
+}
+
+// UpdateEntriesRequest holds fields used when updating all entries matching
+// a search query.
+type UpdateEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Search is the query used to find the entries to update. The name
+	// highlighting fields are ignored.
+	Search *GetEntryListRequest `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	// Name is the new name of all the matched entries. If left unset, the names
+	// will not be updated.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// ReplaceNameOld is replaced with the "replace name new" field in the names
+	// of all the matched entries. This is applied after the name field. If left
+	// unset, no replacement is applied.
+	ReplaceNameOld string `protobuf:"bytes,3,opt,name=replace_name_old,json=replaceNameOld,proto3" json:"replace_name_old,omitempty"`
+	// ReplaceNameNew is the replacement of the "replace name old" field.
+	ReplaceNameNew string `protobuf:"bytes,4,opt,name=replace_name_new,json=replaceNameNew,proto3" json:"replace_name_new,omitempty"`
+	// Shift is added to both the start and end timestamps of all the matched
+	// entries. A negative value shifts the entries back in time. Any other
+	// entries that the shifted entries then overlap are changed according to
+	// the overlap policy.
+	Shift *durationpb.Duration `protobuf:"bytes,5,opt,name=shift,proto3" json:"shift,omitempty"`
+	// DryRun makes the updates without saving them, so the results can be
+	// previewed.
+	DryRun bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *UpdateEntriesRequest) Reset() {
+	*x = UpdateEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEntriesRequest) ProtoMessage() {}
+
+func (x *UpdateEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEntriesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateEntriesRequest) GetSearch() *GetEntryListRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *UpdateEntriesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateEntriesRequest) GetReplaceNameOld() string {
+	if x != nil {
+		return x.ReplaceNameOld
+	}
+	return ""
+}
+
+func (x *UpdateEntriesRequest) GetReplaceNameNew() string {
+	if x != nil {
+		return x.ReplaceNameNew
+	}
+	return ""
+}
+
+func (x *UpdateEntriesRequest) GetShift() *durationpb.Duration {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+func (x *UpdateEntriesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// UpdateEntriesResponse holds the before and after state of the updated
+// entries.
+type UpdateEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Updated is the list of changed entries.
+	Updated []*EntryChange `protobuf:"bytes,1,rep,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (x *UpdateEntriesResponse) Reset() {
+	*x = UpdateEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEntriesResponse) ProtoMessage() {}
+
+func (x *UpdateEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEntriesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateEntriesResponse) GetUpdated() []*EntryChange {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+// DeleteEntriesRequest holds fields used when removing all entries matching a
+// search query.
+type DeleteEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Search is the query used to find the entries to remove. The name
+	// highlighting fields are ignored.
+	Search *GetEntryListRequest `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	// DryRun finds the entries without removing them, so the results can be
+	// previewed.
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *DeleteEntriesRequest) Reset() {
+	*x = DeleteEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntriesRequest) ProtoMessage() {}
+
+func (x *DeleteEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntriesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteEntriesRequest) GetSearch() *GetEntryListRequest {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+func (x *DeleteEntriesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// DeleteEntriesResponse holds the entries that were removed.
+type DeleteEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DeletedEntries is the list of removed entries.
+	DeletedEntries []*Entry `protobuf:"bytes,1,rep,name=deleted_entries,json=deletedEntries,proto3" json:"deleted_entries,omitempty"`
+}
+
+func (x *DeleteEntriesResponse) Reset() {
+	*x = DeleteEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntriesResponse) ProtoMessage() {}
+
+func (x *DeleteEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntriesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteEntriesResponse) GetDeletedEntries() []*Entry {
+	if x != nil {
+		return x.DeletedEntries
+	}
+	return nil
+}
+
+// StopActiveEntryRequest holds fields used when stopping the currently active
+// entry.
+type StopActiveEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// End allows changing the end timestamp of the active entry to stop. If not
+	// set, the current timestamp is used instead.
+	End *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *StopActiveEntryRequest) Reset() {
+	*x = StopActiveEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopActiveEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopActiveEntryRequest) ProtoMessage() {}
+
+func (x *StopActiveEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopActiveEntryRequest.ProtoReflect.Descriptor instead.
+func (*StopActiveEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StopActiveEntryRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+// StopActiveEntryResponse holds the entry that was stopped (if any).
+type StopActiveEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// StoppedEntry is the entry that was stopped (if any).
+	StoppedEntry *Entry `protobuf:"bytes,1,opt,name=stopped_entry,json=stoppedEntry,proto3" json:"stopped_entry,omitempty"`
+}
+
+func (x *StopActiveEntryResponse) Reset() {
+	*x = StopActiveEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopActiveEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopActiveEntryResponse) ProtoMessage() {}
+
+func (x *StopActiveEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopActiveEntryResponse.ProtoReflect.Descriptor instead.
+func (*StopActiveEntryResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StopActiveEntryResponse) GetStoppedEntry() *Entry {
+	if x != nil {
+		return x.StoppedEntry
+	}
+	return nil
+}
+
+// StreamEntryRequest is an empty message and unused. It is here as a
+// placeholder for potential future use.
+type StreamEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamEntryRequest) Reset() {
+	*x = StreamEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEntryRequest) ProtoMessage() {}
+
+func (x *StreamEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEntryRequest.ProtoReflect.Descriptor instead.
+func (*StreamEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{28}
+}
+
+// StreamEntryResponse is a entry event. A entry has been created, updated,
+// or deleted.
+type StreamEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Entry is the created, updated, or deleted entry.
+	Entry *Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// Event is the type of event.
+	Event Event `protobuf:"varint,2,opt,name=event,proto3,enum=dinkurapi.v1.Event" json:"event,omitempty"`
+}
+
+func (x *StreamEntryResponse) Reset() {
+	*x = StreamEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEntryResponse) ProtoMessage() {}
+
+func (x *StreamEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEntryResponse.ProtoReflect.Descriptor instead.
+func (*StreamEntryResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StreamEntryResponse) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *StreamEntryResponse) GetEvent() Event {
+	if x != nil {
+		return x.Event
+	}
+	return Event_EVENT_UNSPECIFIED
+}
+
+// UndoRequest is an empty message and unused. It is here as a placeholder for
+// potential future use.
+type UndoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UndoRequest) Reset() {
+	*x = UndoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoRequest) ProtoMessage() {}
+
+func (x *UndoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoRequest.ProtoReflect.Descriptor instead.
+func (*UndoRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{30}
+}
+
+// UndoResponse holds the changes applied when undoing.
+type UndoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Action is the kind of change that was undone.
+	Action JournalAction `protobuf:"varint,1,opt,name=action,proto3,enum=dinkurapi.v1.JournalAction" json:"action,omitempty"`
+	// Changes are the changes applied to the entries when undoing, in order.
+	Changes []*EntryChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *UndoResponse) Reset() {
+	*x = UndoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoResponse) ProtoMessage() {}
+
+func (x *UndoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoResponse.ProtoReflect.Descriptor instead.
+func (*UndoResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UndoResponse) GetAction() JournalAction {
+	if x != nil {
+		return x.Action
+	}
+	return JournalAction_JOURNAL_ACTION_UNSPECIFIED
+}
+
+func (x *UndoResponse) GetChanges() []*EntryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// RedoRequest is an empty message and unused. It is here as a placeholder for
+// potential future use.
+type RedoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RedoRequest) Reset() {
+	*x = RedoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedoRequest) ProtoMessage() {}
+
+func (x *RedoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedoRequest.ProtoReflect.Descriptor instead.
+func (*RedoRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{32}
+}
+
+// RedoResponse holds the changes applied when redoing.
+type RedoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Action is the kind of change that was redone.
+	Action JournalAction `protobuf:"varint,1,opt,name=action,proto3,enum=dinkurapi.v1.JournalAction" json:"action,omitempty"`
+	// Changes are the changes applied to the entries when redoing, in order.
+	Changes []*EntryChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *RedoResponse) Reset() {
+	*x = RedoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedoResponse) ProtoMessage() {}
+
+func (x *RedoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedoResponse.ProtoReflect.Descriptor instead.
+func (*RedoResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RedoResponse) GetAction() JournalAction {
+	if x != nil {
+		return x.Action
+	}
+	return JournalAction_JOURNAL_ACTION_UNSPECIFIED
+}
+
+func (x *RedoResponse) GetChanges() []*EntryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// GetTrashedEntryListRequest is an empty message and unused. It is here as a
+// placeholder for potential future use.
+type GetTrashedEntryListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetTrashedEntryListRequest) Reset() {
+	*x = GetTrashedEntryListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrashedEntryListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrashedEntryListRequest) ProtoMessage() {}
+
+func (x *GetTrashedEntryListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrashedEntryListRequest.ProtoReflect.Descriptor instead.
+func (*GetTrashedEntryListRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{34}
+}
+
+// GetTrashedEntryListResponse holds the entries in the trash.
+type GetTrashedEntryListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Entries is the list of removed entries, sorted by when they were removed.
+	Entries []*Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetTrashedEntryListResponse) Reset() {
+	*x = GetTrashedEntryListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrashedEntryListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrashedEntryListResponse) ProtoMessage() {}
+
+func (x *GetTrashedEntryListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrashedEntryListResponse.ProtoReflect.Descriptor instead.
+func (*GetTrashedEntryListResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetTrashedEntryListResponse) GetEntries() []*Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// RestoreEntryRequest holds the ID of the entry to restore.
+type RestoreEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the ID of the removed entry to restore.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreEntryRequest) Reset() {
+	*x = RestoreEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEntryRequest) ProtoMessage() {}
+
+func (x *RestoreEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEntryRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEntryRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RestoreEntryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// RestoreEntryResponse holds the entry that was restored.
+type RestoreEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RestoredEntry is the entry that was restored.
+	RestoredEntry *Entry `protobuf:"bytes,1,opt,name=restored_entry,json=restoredEntry,proto3" json:"restored_entry,omitempty"`
+}
+
+func (x *RestoreEntryResponse) Reset() {
+	*x = RestoreEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEntryResponse) ProtoMessage() {}
+
+func (x *RestoreEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEntryResponse.ProtoReflect.Descriptor instead.
+func (*RestoreEntryResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RestoreEntryResponse) GetRestoredEntry() *Entry {
+	if x != nil {
+		return x.RestoredEntry
+	}
+	return nil
+}
+
+// PurgeTrashedEntriesRequest holds fields used when purging the trash.
+type PurgeTrashedEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DeletedBefore is the timestamp that entries must have been removed
+	// before to be purged. If not set, all entries in the trash are purged.
+	DeletedBefore *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=deleted_before,json=deletedBefore,proto3" json:"deleted_before,omitempty"`
+}
+
+func (x *PurgeTrashedEntriesRequest) Reset() {
+	*x = PurgeTrashedEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeTrashedEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashedEntriesRequest) ProtoMessage() {}
+
+func (x *PurgeTrashedEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashedEntriesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTrashedEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PurgeTrashedEntriesRequest) GetDeletedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedBefore
+	}
+	return nil
+}
+
+// PurgeTrashedEntriesResponse holds the entries that were purged.
+type PurgeTrashedEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PurgedEntries is the list of permanently deleted entries.
+	PurgedEntries []*Entry `protobuf:"bytes,1,rep,name=purged_entries,json=purgedEntries,proto3" json:"purged_entries,omitempty"`
+}
+
+func (x *PurgeTrashedEntriesResponse) Reset() {
+	*x = PurgeTrashedEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeTrashedEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashedEntriesResponse) ProtoMessage() {}
+
+func (x *PurgeTrashedEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashedEntriesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTrashedEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PurgeTrashedEntriesResponse) GetPurgedEntries() []*Entry {
+	if x != nil {
+		return x.PurgedEntries
+	}
+	return nil
+}
+
+// GetEntryOverlapsRequest holds fields used when finding overlapping entries.
+type GetEntryOverlapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Search is the query used to find the entries to check for overlaps. The
+	// name highlighting fields are ignored.
+	Search *GetEntryListRequest `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+}
+
+func (x *GetEntryOverlapsRequest) Reset() {
+	*x = GetEntryOverlapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntryOverlapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntryOverlapsRequest) ProtoMessage() {}
+
+func (x *GetEntryOverlapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,45 +3006,46 @@ func (x *DeleteEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEntryRequest) Descriptor() ([]byte, []int) {
-	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetEntryOverlapsRequest.ProtoReflect.Descriptor instead.
+func (*GetEntryOverlapsRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DeleteEntryRequest) GetId() uint64 {
+func (x *GetEntryOverlapsRequest) GetSearch() *GetEntryListRequest {
 	if x != nil {
-		return x.Id
+		return x.Search
 	}
-	return 0
+	return nil
 }
 
-// DeleteEntryResponse holds the entry that was deleted.
-type DeleteEntryResponse struct {
+// GetEntryOverlapsResponse holds the overlapping entries.
+type GetEntryOverlapsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// DeletedEntry is the entry that was deleted.
-	DeletedEntry *Entry `protobuf:"bytes,1,opt,name=deleted_entry,json=deletedEntry,proto3" json:"deleted_entry,omitempty"`
+	// Overlaps is the list of overlapping pairs of entries, sorted by start
+	// timestamp.
+	Overlaps []*EntryOverlap `protobuf:"bytes,1,rep,name=overlaps,proto3" json:"overlaps,omitempty"`
 }
 
-func (x *DeleteEntryResponse) Reset() {
-	*x = DeleteEntryResponse{}
+func (x *GetEntryOverlapsResponse) Reset() {
+	*x = GetEntryOverlapsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[13]
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteEntryResponse) String() string {
+func (x *GetEntryOverlapsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntryResponse) ProtoMessage() {}
+func (*GetEntryOverlapsResponse) ProtoMessage() {}
 
-func (x *DeleteEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[13]
+func (x *GetEntryOverlapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,47 +3056,50 @@ func (x *DeleteEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntryResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEntryResponse) Descriptor() ([]byte, []int) {
-	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetEntryOverlapsResponse.ProtoReflect.Descriptor instead.
+func (*GetEntryOverlapsResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DeleteEntryResponse) GetDeletedEntry() *Entry {
+func (x *GetEntryOverlapsResponse) GetOverlaps() []*EntryOverlap {
 	if x != nil {
-		return x.DeletedEntry
+		return x.Overlaps
 	}
 	return nil
 }
 
-// StopActiveEntryRequest holds fields used when stopping the currently active
-// entry.
-type StopActiveEntryRequest struct {
+// ResolveEntryOverlapsRequest holds fields used when resolving overlapping
+// entries.
+type ResolveEntryOverlapsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// End allows changing the end timestamp of the active entry to stop. If not
-	// set, the current timestamp is used instead.
-	End *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=end,proto3" json:"end,omitempty"`
+	// Search is the query used to find the entries to resolve overlaps between.
+	// The name highlighting fields are ignored.
+	Search *GetEntryListRequest `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	// Policy decides how the overlaps are resolved. Must be either
+	// OVERLAP_POLICY_TRIM or OVERLAP_POLICY_SPLIT.
+	Policy OverlapPolicy `protobuf:"varint,2,opt,name=policy,proto3,enum=dinkurapi.v1.OverlapPolicy" json:"policy,omitempty"`
 }
 
-func (x *StopActiveEntryRequest) Reset() {
-	*x = StopActiveEntryRequest{}
+func (x *ResolveEntryOverlapsRequest) Reset() {
+	*x = ResolveEntryOverlapsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[14]
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopActiveEntryRequest) String() string {
+func (x *ResolveEntryOverlapsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopActiveEntryRequest) ProtoMessage() {}
+func (*ResolveEntryOverlapsRequest) ProtoMessage() {}
 
-func (x *StopActiveEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[14]
+func (x *ResolveEntryOverlapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,45 +3110,53 @@ func (x *StopActiveEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopActiveEntryRequest.ProtoReflect.Descriptor instead.
-func (*StopActiveEntryRequest) Descriptor() ([]byte, []int) {
-	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ResolveEntryOverlapsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveEntryOverlapsRequest) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *StopActiveEntryRequest) GetEnd() *timestamppb.Timestamp {
+func (x *ResolveEntryOverlapsRequest) GetSearch() *GetEntryListRequest {
 	if x != nil {
-		return x.End
+		return x.Search
 	}
 	return nil
 }
 
-// StopActiveEntryResponse holds the entry that was stopped (if any).
-type StopActiveEntryResponse struct {
+func (x *ResolveEntryOverlapsRequest) GetPolicy() OverlapPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED
+}
+
+// ResolveEntryOverlapsResponse holds the changes applied when resolving
+// overlapping entries.
+type ResolveEntryOverlapsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// StoppedEntry is the entry that was stopped (if any).
-	StoppedEntry *Entry `protobuf:"bytes,1,opt,name=stopped_entry,json=stoppedEntry,proto3" json:"stopped_entry,omitempty"`
+	// Changes are the changes applied to the entries, in order.
+	Changes []*EntryChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *StopActiveEntryResponse) Reset() {
-	*x = StopActiveEntryResponse{}
+func (x *ResolveEntryOverlapsResponse) Reset() {
+	*x = ResolveEntryOverlapsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[15]
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopActiveEntryResponse) String() string {
+func (x *ResolveEntryOverlapsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopActiveEntryResponse) ProtoMessage() {}
+func (*ResolveEntryOverlapsResponse) ProtoMessage() {}
 
-func (x *StopActiveEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[15]
+func (x *ResolveEntryOverlapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,43 +3167,49 @@ func (x *StopActiveEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopActiveEntryResponse.ProtoReflect.Descriptor instead.
-func (*StopActiveEntryResponse) Descriptor() ([]byte, []int) {
-	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ResolveEntryOverlapsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveEntryOverlapsResponse) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *StopActiveEntryResponse) GetStoppedEntry() *Entry {
+func (x *ResolveEntryOverlapsResponse) GetChanges() []*EntryChange {
 	if x != nil {
-		return x.StoppedEntry
+		return x.Changes
 	}
 	return nil
 }
 
-// StreamEntryRequest is an empty message and unused. It is here as a
-// placeholder for potential future use.
-type StreamEntryRequest struct {
+// EntryOverlap is a pair of entries whose time spans overlap.
+type EntryOverlap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// First is the entry that starts before or at the same time as the second
+	// entry.
+	First *Entry `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	// Second is the entry that starts after or at the same time as the first
+	// entry.
+	Second *Entry `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
 }
 
-func (x *StreamEntryRequest) Reset() {
-	*x = StreamEntryRequest{}
+func (x *EntryOverlap) Reset() {
+	*x = EntryOverlap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[16]
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamEntryRequest) String() string {
+func (x *EntryOverlap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamEntryRequest) ProtoMessage() {}
+func (*EntryOverlap) ProtoMessage() {}
 
-func (x *StreamEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[16]
+func (x *EntryOverlap) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,41 +3220,56 @@ func (x *StreamEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamEntryRequest.ProtoReflect.Descriptor instead.
-func (*StreamEntryRequest) Descriptor() ([]byte, []int) {
-	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use EntryOverlap.ProtoReflect.Descriptor instead.
+func (*EntryOverlap) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{44}
 }
 
-// StreamEntryResponse is a entry event. A entry has been created, updated,
-// or deleted.
-type StreamEntryResponse struct {
+func (x *EntryOverlap) GetFirst() *Entry {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *EntryOverlap) GetSecond() *Entry {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+// EntryChange holds the state of an entry before and after a change.
+type EntryChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Entry is the created, updated, or deleted entry.
-	Entry *Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
-	// Event is the type of event.
-	Event Event `protobuf:"varint,2,opt,name=event,proto3,enum=dinkurapi.v1.Event" json:"event,omitempty"`
+	// Before is the state of the entry before the change, or is left unset if
+	// the entry was created by the change.
+	Before *Entry `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	// After is the state of the entry after the change, or is left unset if the
+	// entry was removed by the change.
+	After *Entry `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
 }
 
-func (x *StreamEntryResponse) Reset() {
-	*x = StreamEntryResponse{}
+func (x *EntryChange) Reset() {
+	*x = EntryChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[17]
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamEntryResponse) String() string {
+func (x *EntryChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamEntryResponse) ProtoMessage() {}
+func (*EntryChange) ProtoMessage() {}
 
-func (x *StreamEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[17]
+func (x *EntryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1211,23 +3280,23 @@ func (x *StreamEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamEntryResponse.ProtoReflect.Descriptor instead.
-func (*StreamEntryResponse) Descriptor() ([]byte, []int) {
-	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use EntryChange.ProtoReflect.Descriptor instead.
+func (*EntryChange) Descriptor() ([]byte, []int) {
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *StreamEntryResponse) GetEntry() *Entry {
+func (x *EntryChange) GetBefore() *Entry {
 	if x != nil {
-		return x.Entry
+		return x.Before
 	}
 	return nil
 }
 
-func (x *StreamEntryResponse) GetEvent() Event {
+func (x *EntryChange) GetAfter() *Entry {
 	if x != nil {
-		return x.Event
+		return x.After
 	}
-	return Event_EVENT_UNSPECIFIED
+	return nil
 }
 
 // Entry is a Dinkur entry.
@@ -1253,12 +3322,23 @@ type Entry struct {
 	// End is the ending timestamp of this entry, as specified by the user, or
 	// is left unset if the entry is currently active.
 	End *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	// Tags is the sorted list of tags attached to this entry.
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Project is the project this entry references, or is left unset if the
+	// entry does not reference any project.
+	Project *Project `protobuf:"bytes,8,opt,name=project,proto3" json:"project,omitempty"`
+	// Deleted is a timestamp of when the entry was moved to the trash, or is
+	// left unset if the entry is not in the trash.
+	Deleted *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Note is the longer free-text description of this entry, as specified by
+	// the user, or empty if the entry has no note.
+	Note string `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
 }
 
 func (x *Entry) Reset() {
 	*x = Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[18]
+		mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1271,7 +3351,7 @@ func (x *Entry) String() string {
 func (*Entry) ProtoMessage() {}
 
 func (x *Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[18]
+	mi := &file_api_dinkurapi_v1_entries_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,7 +3364,7 @@ func (x *Entry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Entry.ProtoReflect.Descriptor instead.
 func (*Entry) Descriptor() ([]byte, []int) {
-	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{18}
+	return file_api_dinkurapi_v1_entries_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *Entry) GetId() uint64 {
@@ -1329,6 +3409,34 @@ func (x *Entry) GetEnd() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Entry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Entry) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *Entry) GetDeleted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *Entry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
 var File_api_dinkurapi_v1_entries_proto protoreflect.FileDescriptor
 
 var file_api_dinkurapi_v1_entries_proto_rawDesc = []byte{
@@ -1336,218 +3444,574 @@ var file_api_dinkurapi_v1_entries_proto_rawDesc = []byte{
 	0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x0c, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1c,
 	0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0d, 0x0a,
-	0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c,
-	0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x3d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x17,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x36, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xcd, 0x04, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74,
-	0x68, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x64, 0x69, 0x6e,
-	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68,
-	0x6f, 0x72, 0x74, 0x68, 0x61, 0x6e, 0x64, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x68, 0x61,
-	0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x66, 0x75, 0x7a, 0x7a, 0x79,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x75, 0x7a, 0x7a,
-	0x79, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x6e, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x68, 0x69, 0x67, 0x68,
-	0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x6e, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x45, 0x6e,
-	0x64, 0x22, 0xf5, 0x01, 0x0a, 0x09, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x68, 0x61, 0x6e, 0x64, 0x12,
-	0x19, 0x0a, 0x15, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x48,
-	0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x14,
-	0x0a, 0x10, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x46, 0x55, 0x54, 0x55,
-	0x52, 0x45, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e,
-	0x44, 0x5f, 0x54, 0x48, 0x49, 0x53, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19,
-	0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x48, 0x49, 0x53, 0x5f, 0x4d,
-	0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x55, 0x4e, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x53,
-	0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x5f, 0x44, 0x41,
-	0x59, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44,
-	0x5f, 0x50, 0x52, 0x45, 0x56, 0x5f, 0x4d, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x55, 0x4e,
-	0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f,
-	0x4e, 0x45, 0x58, 0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x07, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48,
-	0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x4d, 0x4f, 0x4e,
-	0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x55, 0x4e, 0x10, 0x08, 0x22, 0x45, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x22, 0x98, 0x02, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a,
-	0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x16, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72,
-	0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12,
-	0x30, 0x0a, 0x15, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64,
-	0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
-	0x65, 0x6e, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72,
-	0x6f, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
-	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x13,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x65,
-	0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e,
-	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x4b, 0x0a,
-	0x17, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x5f, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x15, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x95, 0x03, 0x0a, 0x12, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x61, 0x70,
+	0x69, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0d,
+	0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a,
+	0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x3d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22,
+	0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75,
+	0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xb1, 0x05, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x64, 0x69,
+	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x68, 0x61, 0x6e, 0x64, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x68,
+	0x61, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x66, 0x75, 0x7a, 0x7a,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x75, 0x7a,
+	0x7a, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x68, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x68, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x6e, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x45,
+	0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x22, 0xf5, 0x01, 0x0a, 0x09, 0x53, 0x68, 0x6f, 0x72, 0x74,
+	0x68, 0x61, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e,
+	0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x53,
+	0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44,
+	0x5f, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x48, 0x4f,
+	0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x48, 0x49, 0x53, 0x5f, 0x44, 0x41, 0x59, 0x10,
+	0x03, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x54,
+	0x48, 0x49, 0x53, 0x5f, 0x4d, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x55, 0x4e, 0x10, 0x04,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x52,
+	0x45, 0x56, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x4f, 0x52,
+	0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x5f, 0x4d, 0x4f, 0x4e, 0x5f, 0x54,
+	0x4f, 0x5f, 0x53, 0x55, 0x4e, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x48, 0x4f, 0x52, 0x54,
+	0x48, 0x41, 0x4e, 0x44, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x07, 0x12,
+	0x1d, 0x0a, 0x19, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x4e, 0x45, 0x58,
+	0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x55, 0x4e, 0x10, 0x08, 0x22, 0x45,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x80, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x12, 0x49, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x68, 0x61, 0x6e, 0x64,
+	0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x68, 0x61, 0x6e, 0x64, 0x12, 0x47, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e,
+	0x65, 0x22, 0x55, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x10, 0x0a, 0x0c,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10,
+	0x01, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x4d, 0x4f,
+	0x4e, 0x54, 0x48, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42,
+	0x59, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x03, 0x22, 0x53, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x09, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22, 0xd1, 0x01,
+	0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xd5, 0x03, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x47, 0x61,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x49, 0x0a, 0x09, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x68, 0x61, 0x6e, 0x64, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x68, 0x61, 0x6e, 0x64, 0x12, 0x43, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x68, 0x6f, 0x75,
+	0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x77, 0x6f,
+	0x72, 0x6b, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x45, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x09, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x61, 0x79, 0x73, 0x12, 0x3c,
+	0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x42, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x47, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x04, 0x67, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x47, 0x61, 0x70, 0x52, 0x04, 0x67, 0x61, 0x70, 0x73, 0x22, 0xc4, 0x01,
+	0x0a, 0x08, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x47, 0x61, 0x70, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04,
+	0x6e, 0x65, 0x78, 0x74, 0x22, 0xb7, 0x03, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12,
+	0x32, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x49, 0x64, 0x4f, 0x72, 0x5a,
+	0x65, 0x72, 0x6f, 0x12, 0x30, 0x0a, 0x15, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x4f,
+	0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x9c,
+	0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x4b, 0x0a, 0x17, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x6c, 0x79, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x6c, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x52, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x54, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x69,
+	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xf4, 0x04, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x0a, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x69, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x32, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x49, 0x64, 0x4f,
+	0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x30, 0x0a, 0x15, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49,
+	0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x75, 0x7a, 0x7a, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x46, 0x75, 0x7a,
+	0x7a, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72,
+	0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x22, 0x6d,
+	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x24, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x22, 0x83, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a,
+	0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x6c, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x4f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x4e, 0x65, 0x77,
+	0x12, 0x2f, 0x0a, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x4c, 0x0a, 0x15, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x6a, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x39, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x22, 0x55, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x16, 0x53,
+	0x74, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03,
-	0x65, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66,
-	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x30, 0x0a, 0x15, 0x65, 0x6e, 0x64, 0x5f,
-	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65, 0x72,
-	0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x66, 0x6f,
-	0x72, 0x65, 0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72,
-	0x4c, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x66, 0x75,
-	0x7a, 0x7a, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x46, 0x75, 0x7a, 0x7a, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x66, 0x75, 0x7a,
-	0x7a, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x46, 0x75, 0x7a,
-	0x7a, 0x79, 0x22, 0x6d, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x62, 0x65, 0x66,
-	0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
-	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
-	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65,
-	0x72, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x6e, 0x64, 0x22, 0x53, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76,
 	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
-	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x46, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64,
-	0x22, 0x53, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x73,
-	0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b,
+	0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x29, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x55,
+	0x6e, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x0c, 0x55, 0x6e,
+	0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x69, 0x6e,
+	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x33, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x22, 0x0d, 0x0a, 0x0b, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x1c, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69,
+	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x13, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x52, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x22, 0x5f, 0x0a, 0x1a, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x72, 0x61,
+	0x73, 0x68, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x1b, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x72,
+	0x61, 0x73, 0x68, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0d, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x54, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x69,
+	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x52, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70,
+	0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x1b, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x6c,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x69, 0x6e,
+	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x53, 0x0a, 0x1c, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x69,
+	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22,
+	0x66, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x12,
+	0x29, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e,
+	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x65, 0x0a, 0x0b, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x13, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x29, 0x0a,
-	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x64,
-	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xf7, 0x01, 0x0a, 0x05, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x86,
+	0x03, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65,
-	0x6e, 0x64, 0x32, 0xf9, 0x05, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3d,
-	0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
-	0x08, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
-	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75,
-	0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x64, 0x69, 0x6e,
-	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75,
-	0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x2f, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x34, 0x0a,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x2a, 0x9d, 0x01, 0x0a, 0x07, 0x57, 0x65, 0x65, 0x6b,
+	0x64, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f, 0x53,
+	0x55, 0x4e, 0x44, 0x41, 0x59, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x45, 0x45, 0x4b, 0x44,
+	0x41, 0x59, 0x5f, 0x4d, 0x4f, 0x4e, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x57,
+	0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f, 0x54, 0x55, 0x45, 0x53, 0x44, 0x41, 0x59, 0x10, 0x02,
+	0x12, 0x15, 0x0a, 0x11, 0x57, 0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f, 0x57, 0x45, 0x44, 0x4e,
+	0x45, 0x53, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x45, 0x45, 0x4b, 0x44,
+	0x41, 0x59, 0x5f, 0x54, 0x48, 0x55, 0x52, 0x53, 0x44, 0x41, 0x59, 0x10, 0x04, 0x12, 0x12, 0x0a,
+	0x0e, 0x57, 0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f, 0x46, 0x52, 0x49, 0x44, 0x41, 0x59, 0x10,
+	0x05, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x5f, 0x53, 0x41, 0x54,
+	0x55, 0x52, 0x44, 0x41, 0x59, 0x10, 0x06, 0x2a, 0x97, 0x01, 0x0a, 0x0d, 0x4f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x56, 0x45,
+	0x52, 0x4c, 0x41, 0x50, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x56, 0x45,
+	0x52, 0x4c, 0x41, 0x50, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f,
+	0x57, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x54, 0x52, 0x49, 0x4d, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x56, 0x45, 0x52, 0x4c,
+	0x41, 0x50, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10,
+	0x04, 0x2a, 0xb5, 0x01, 0x0a, 0x0d, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x19,
+	0x0a, 0x15, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4a, 0x4f, 0x55,
+	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4c, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x04, 0x12, 0x1a, 0x0a,
+	0x16, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x05, 0x32, 0xbd, 0x0e, 0x0a, 0x07, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75,
+	0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x1d, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x23, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x47,
+	0x61, 0x70, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x47, 0x61, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x47, 0x61,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x69,
 	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x22, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69,
+	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
 	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x52, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x58, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x22, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x64, 0x69,
+	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f,
+	0x70, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e,
 	0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x64, 0x69,
-	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
-	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x2b,
-	0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e,
-	0x6b, 0x75, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64,
-	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x04, 0x55, 0x6e,
+	0x64, 0x6f, 0x12, 0x19, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x64,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x52, 0x65, 0x64,
+	0x6f, 0x12, 0x19, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x28, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x69, 0x6e, 0x6b,
+	0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73,
+	0x68, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x28, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x12, 0x25, 0x2e, 0x64, 0x69,
+	0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x70, 0x73, 0x12, 0x29, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x64,
+	0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1562,79 +4026,183 @@ func file_api_dinkurapi_v1_entries_proto_rawDescGZIP() []byte {
 	return file_api_dinkurapi_v1_entries_proto_rawDescData
 }
 
-var file_api_dinkurapi_v1_entries_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_dinkurapi_v1_entries_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_api_dinkurapi_v1_entries_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_api_dinkurapi_v1_entries_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_api_dinkurapi_v1_entries_proto_goTypes = []interface{}{
-	(GetEntryListRequest_Shorthand)(0), // 0: dinkurapi.v1.GetEntryListRequest.Shorthand
-	(*PingRequest)(nil),                // 1: dinkurapi.v1.PingRequest
-	(*PingResponse)(nil),               // 2: dinkurapi.v1.PingResponse
-	(*GetEntryRequest)(nil),            // 3: dinkurapi.v1.GetEntryRequest
-	(*GetEntryResponse)(nil),           // 4: dinkurapi.v1.GetEntryResponse
-	(*GetActiveEntryRequest)(nil),      // 5: dinkurapi.v1.GetActiveEntryRequest
-	(*GetActiveEntryResponse)(nil),     // 6: dinkurapi.v1.GetActiveEntryResponse
-	(*GetEntryListRequest)(nil),        // 7: dinkurapi.v1.GetEntryListRequest
-	(*GetEntryListResponse)(nil),       // 8: dinkurapi.v1.GetEntryListResponse
-	(*CreateEntryRequest)(nil),         // 9: dinkurapi.v1.CreateEntryRequest
-	(*CreateEntryResponse)(nil),        // 10: dinkurapi.v1.CreateEntryResponse
-	(*UpdateEntryRequest)(nil),         // 11: dinkurapi.v1.UpdateEntryRequest
-	(*UpdateEntryResponse)(nil),        // 12: dinkurapi.v1.UpdateEntryResponse
-	(*DeleteEntryRequest)(nil),         // 13: dinkurapi.v1.DeleteEntryRequest
-	(*DeleteEntryResponse)(nil),        // 14: dinkurapi.v1.DeleteEntryResponse
-	(*StopActiveEntryRequest)(nil),     // 15: dinkurapi.v1.StopActiveEntryRequest
-	(*StopActiveEntryResponse)(nil),    // 16: dinkurapi.v1.StopActiveEntryResponse
-	(*StreamEntryRequest)(nil),         // 17: dinkurapi.v1.StreamEntryRequest
-	(*StreamEntryResponse)(nil),        // 18: dinkurapi.v1.StreamEntryResponse
-	(*Entry)(nil),                      // 19: dinkurapi.v1.Entry
-	(*timestamppb.Timestamp)(nil),      // 20: google.protobuf.Timestamp
-	(Event)(0),                         // 21: dinkurapi.v1.Event
+	(Weekday)(0),                         // 0: dinkurapi.v1.Weekday
+	(OverlapPolicy)(0),                   // 1: dinkurapi.v1.OverlapPolicy
+	(JournalAction)(0),                   // 2: dinkurapi.v1.JournalAction
+	(GetEntryListRequest_Shorthand)(0),   // 3: dinkurapi.v1.GetEntryListRequest.Shorthand
+	(GetEntrySummaryRequest_GroupBy)(0),  // 4: dinkurapi.v1.GetEntrySummaryRequest.GroupBy
+	(*PingRequest)(nil),                  // 5: dinkurapi.v1.PingRequest
+	(*PingResponse)(nil),                 // 6: dinkurapi.v1.PingResponse
+	(*GetEntryRequest)(nil),              // 7: dinkurapi.v1.GetEntryRequest
+	(*GetEntryResponse)(nil),             // 8: dinkurapi.v1.GetEntryResponse
+	(*GetActiveEntryRequest)(nil),        // 9: dinkurapi.v1.GetActiveEntryRequest
+	(*GetActiveEntryResponse)(nil),       // 10: dinkurapi.v1.GetActiveEntryResponse
+	(*GetEntryListRequest)(nil),          // 11: dinkurapi.v1.GetEntryListRequest
+	(*GetEntryListResponse)(nil),         // 12: dinkurapi.v1.GetEntryListResponse
+	(*GetEntrySummaryRequest)(nil),       // 13: dinkurapi.v1.GetEntrySummaryRequest
+	(*GetEntrySummaryResponse)(nil),      // 14: dinkurapi.v1.GetEntrySummaryResponse
+	(*EntrySummary)(nil),                 // 15: dinkurapi.v1.EntrySummary
+	(*GetEntryGapsRequest)(nil),          // 16: dinkurapi.v1.GetEntryGapsRequest
+	(*GetEntryGapsResponse)(nil),         // 17: dinkurapi.v1.GetEntryGapsResponse
+	(*EntryGap)(nil),                     // 18: dinkurapi.v1.EntryGap
+	(*CreateEntryRequest)(nil),           // 19: dinkurapi.v1.CreateEntryRequest
+	(*CreateEntryResponse)(nil),          // 20: dinkurapi.v1.CreateEntryResponse
+	(*CreateEntriesRequest)(nil),         // 21: dinkurapi.v1.CreateEntriesRequest
+	(*CreateEntriesResponse)(nil),        // 22: dinkurapi.v1.CreateEntriesResponse
+	(*UpdateEntryRequest)(nil),           // 23: dinkurapi.v1.UpdateEntryRequest
+	(*UpdateEntryResponse)(nil),          // 24: dinkurapi.v1.UpdateEntryResponse
+	(*DeleteEntryRequest)(nil),           // 25: dinkurapi.v1.DeleteEntryRequest
+	(*DeleteEntryResponse)(nil),          // 26: dinkurapi.v1.DeleteEntryResponse
+	(*UpdateEntriesRequest)(nil),         // 27: dinkurapi.v1.UpdateEntriesRequest
+	(*UpdateEntriesResponse)(nil),        // 28: dinkurapi.v1.UpdateEntriesResponse
+	(*DeleteEntriesRequest)(nil),         // 29: dinkurapi.v1.DeleteEntriesRequest
+	(*DeleteEntriesResponse)(nil),        // 30: dinkurapi.v1.DeleteEntriesResponse
+	(*StopActiveEntryRequest)(nil),       // 31: dinkurapi.v1.StopActiveEntryRequest
+	(*StopActiveEntryResponse)(nil),      // 32: dinkurapi.v1.StopActiveEntryResponse
+	(*StreamEntryRequest)(nil),           // 33: dinkurapi.v1.StreamEntryRequest
+	(*StreamEntryResponse)(nil),          // 34: dinkurapi.v1.StreamEntryResponse
+	(*UndoRequest)(nil),                  // 35: dinkurapi.v1.UndoRequest
+	(*UndoResponse)(nil),                 // 36: dinkurapi.v1.UndoResponse
+	(*RedoRequest)(nil),                  // 37: dinkurapi.v1.RedoRequest
+	(*RedoResponse)(nil),                 // 38: dinkurapi.v1.RedoResponse
+	(*GetTrashedEntryListRequest)(nil),   // 39: dinkurapi.v1.GetTrashedEntryListRequest
+	(*GetTrashedEntryListResponse)(nil),  // 40: dinkurapi.v1.GetTrashedEntryListResponse
+	(*RestoreEntryRequest)(nil),          // 41: dinkurapi.v1.RestoreEntryRequest
+	(*RestoreEntryResponse)(nil),         // 42: dinkurapi.v1.RestoreEntryResponse
+	(*PurgeTrashedEntriesRequest)(nil),   // 43: dinkurapi.v1.PurgeTrashedEntriesRequest
+	(*PurgeTrashedEntriesResponse)(nil),  // 44: dinkurapi.v1.PurgeTrashedEntriesResponse
+	(*GetEntryOverlapsRequest)(nil),      // 45: dinkurapi.v1.GetEntryOverlapsRequest
+	(*GetEntryOverlapsResponse)(nil),     // 46: dinkurapi.v1.GetEntryOverlapsResponse
+	(*ResolveEntryOverlapsRequest)(nil),  // 47: dinkurapi.v1.ResolveEntryOverlapsRequest
+	(*ResolveEntryOverlapsResponse)(nil), // 48: dinkurapi.v1.ResolveEntryOverlapsResponse
+	(*EntryOverlap)(nil),                 // 49: dinkurapi.v1.EntryOverlap
+	(*EntryChange)(nil),                  // 50: dinkurapi.v1.EntryChange
+	(*Entry)(nil),                        // 51: dinkurapi.v1.Entry
+	(*timestamppb.Timestamp)(nil),        // 52: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 53: google.protobuf.Duration
+	(Event)(0),                           // 54: dinkurapi.v1.Event
+	(*Project)(nil),                      // 55: dinkurapi.v1.Project
 }
 var file_api_dinkurapi_v1_entries_proto_depIdxs = []int32{
-	19, // 0: dinkurapi.v1.GetEntryResponse.entry:type_name -> dinkurapi.v1.Entry
-	19, // 1: dinkurapi.v1.GetActiveEntryResponse.active_entry:type_name -> dinkurapi.v1.Entry
-	20, // 2: dinkurapi.v1.GetEntryListRequest.start:type_name -> google.protobuf.Timestamp
-	20, // 3: dinkurapi.v1.GetEntryListRequest.end:type_name -> google.protobuf.Timestamp
-	0,  // 4: dinkurapi.v1.GetEntryListRequest.shorthand:type_name -> dinkurapi.v1.GetEntryListRequest.Shorthand
-	19, // 5: dinkurapi.v1.GetEntryListResponse.entries:type_name -> dinkurapi.v1.Entry
-	20, // 6: dinkurapi.v1.CreateEntryRequest.start:type_name -> google.protobuf.Timestamp
-	20, // 7: dinkurapi.v1.CreateEntryRequest.end:type_name -> google.protobuf.Timestamp
-	19, // 8: dinkurapi.v1.CreateEntryResponse.created_entry:type_name -> dinkurapi.v1.Entry
-	19, // 9: dinkurapi.v1.CreateEntryResponse.previously_active_entry:type_name -> dinkurapi.v1.Entry
-	20, // 10: dinkurapi.v1.UpdateEntryRequest.start:type_name -> google.protobuf.Timestamp
-	20, // 11: dinkurapi.v1.UpdateEntryRequest.end:type_name -> google.protobuf.Timestamp
-	19, // 12: dinkurapi.v1.UpdateEntryResponse.before:type_name -> dinkurapi.v1.Entry
-	19, // 13: dinkurapi.v1.UpdateEntryResponse.after:type_name -> dinkurapi.v1.Entry
-	19, // 14: dinkurapi.v1.DeleteEntryResponse.deleted_entry:type_name -> dinkurapi.v1.Entry
-	20, // 15: dinkurapi.v1.StopActiveEntryRequest.end:type_name -> google.protobuf.Timestamp
-	19, // 16: dinkurapi.v1.StopActiveEntryResponse.stopped_entry:type_name -> dinkurapi.v1.Entry
-	19, // 17: dinkurapi.v1.StreamEntryResponse.entry:type_name -> dinkurapi.v1.Entry
-	21, // 18: dinkurapi.v1.StreamEntryResponse.event:type_name -> dinkurapi.v1.Event
-	20, // 19: dinkurapi.v1.Entry.created:type_name -> google.protobuf.Timestamp
-	20, // 20: dinkurapi.v1.Entry.updated:type_name -> google.protobuf.Timestamp
-	20, // 21: dinkurapi.v1.Entry.start:type_name -> google.protobuf.Timestamp
-	20, // 22: dinkurapi.v1.Entry.end:type_name -> google.protobuf.Timestamp
-	1,  // 23: dinkurapi.v1.Entries.Ping:input_type -> dinkurapi.v1.PingRequest
-	3,  // 24: dinkurapi.v1.Entries.GetEntry:input_type -> dinkurapi.v1.GetEntryRequest
-	5,  // 25: dinkurapi.v1.Entries.GetActiveEntry:input_type -> dinkurapi.v1.GetActiveEntryRequest
-	7,  // 26: dinkurapi.v1.Entries.GetEntryList:input_type -> dinkurapi.v1.GetEntryListRequest
-	9,  // 27: dinkurapi.v1.Entries.CreateEntry:input_type -> dinkurapi.v1.CreateEntryRequest
-	11, // 28: dinkurapi.v1.Entries.UpdateEntry:input_type -> dinkurapi.v1.UpdateEntryRequest
-	13, // 29: dinkurapi.v1.Entries.DeleteEntry:input_type -> dinkurapi.v1.DeleteEntryRequest
-	15, // 30: dinkurapi.v1.Entries.StopActiveEntry:input_type -> dinkurapi.v1.StopActiveEntryRequest
-	17, // 31: dinkurapi.v1.Entries.StreamEntry:input_type -> dinkurapi.v1.StreamEntryRequest
-	2,  // 32: dinkurapi.v1.Entries.Ping:output_type -> dinkurapi.v1.PingResponse
-	4,  // 33: dinkurapi.v1.Entries.GetEntry:output_type -> dinkurapi.v1.GetEntryResponse
-	6,  // 34: dinkurapi.v1.Entries.GetActiveEntry:output_type -> dinkurapi.v1.GetActiveEntryResponse
-	8,  // 35: dinkurapi.v1.Entries.GetEntryList:output_type -> dinkurapi.v1.GetEntryListResponse
-	10, // 36: dinkurapi.v1.Entries.CreateEntry:output_type -> dinkurapi.v1.CreateEntryResponse
-	12, // 37: dinkurapi.v1.Entries.UpdateEntry:output_type -> dinkurapi.v1.UpdateEntryResponse
-	14, // 38: dinkurapi.v1.Entries.DeleteEntry:output_type -> dinkurapi.v1.DeleteEntryResponse
-	16, // 39: dinkurapi.v1.Entries.StopActiveEntry:output_type -> dinkurapi.v1.StopActiveEntryResponse
-	18, // 40: dinkurapi.v1.Entries.StreamEntry:output_type -> dinkurapi.v1.StreamEntryResponse
-	32, // [32:41] is the sub-list for method output_type
-	23, // [23:32] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	51, // 0: dinkurapi.v1.GetEntryResponse.entry:type_name -> dinkurapi.v1.Entry
+	51, // 1: dinkurapi.v1.GetActiveEntryResponse.active_entry:type_name -> dinkurapi.v1.Entry
+	52, // 2: dinkurapi.v1.GetEntryListRequest.start:type_name -> google.protobuf.Timestamp
+	52, // 3: dinkurapi.v1.GetEntryListRequest.end:type_name -> google.protobuf.Timestamp
+	3,  // 4: dinkurapi.v1.GetEntryListRequest.shorthand:type_name -> dinkurapi.v1.GetEntryListRequest.Shorthand
+	51, // 5: dinkurapi.v1.GetEntryListResponse.entries:type_name -> dinkurapi.v1.Entry
+	52, // 6: dinkurapi.v1.GetEntrySummaryRequest.start:type_name -> google.protobuf.Timestamp
+	52, // 7: dinkurapi.v1.GetEntrySummaryRequest.end:type_name -> google.protobuf.Timestamp
+	3,  // 8: dinkurapi.v1.GetEntrySummaryRequest.shorthand:type_name -> dinkurapi.v1.GetEntryListRequest.Shorthand
+	4,  // 9: dinkurapi.v1.GetEntrySummaryRequest.group_by:type_name -> dinkurapi.v1.GetEntrySummaryRequest.GroupBy
+	15, // 10: dinkurapi.v1.GetEntrySummaryResponse.summaries:type_name -> dinkurapi.v1.EntrySummary
+	52, // 11: dinkurapi.v1.EntrySummary.start:type_name -> google.protobuf.Timestamp
+	52, // 12: dinkurapi.v1.EntrySummary.end:type_name -> google.protobuf.Timestamp
+	53, // 13: dinkurapi.v1.EntrySummary.duration:type_name -> google.protobuf.Duration
+	52, // 14: dinkurapi.v1.GetEntryGapsRequest.start:type_name -> google.protobuf.Timestamp
+	52, // 15: dinkurapi.v1.GetEntryGapsRequest.end:type_name -> google.protobuf.Timestamp
+	3,  // 16: dinkurapi.v1.GetEntryGapsRequest.shorthand:type_name -> dinkurapi.v1.GetEntryListRequest.Shorthand
+	53, // 17: dinkurapi.v1.GetEntryGapsRequest.work_hours_start:type_name -> google.protobuf.Duration
+	53, // 18: dinkurapi.v1.GetEntryGapsRequest.work_hours_end:type_name -> google.protobuf.Duration
+	0,  // 19: dinkurapi.v1.GetEntryGapsRequest.work_days:type_name -> dinkurapi.v1.Weekday
+	53, // 20: dinkurapi.v1.GetEntryGapsRequest.min_duration:type_name -> google.protobuf.Duration
+	18, // 21: dinkurapi.v1.GetEntryGapsResponse.gaps:type_name -> dinkurapi.v1.EntryGap
+	52, // 22: dinkurapi.v1.EntryGap.start:type_name -> google.protobuf.Timestamp
+	52, // 23: dinkurapi.v1.EntryGap.end:type_name -> google.protobuf.Timestamp
+	51, // 24: dinkurapi.v1.EntryGap.previous:type_name -> dinkurapi.v1.Entry
+	51, // 25: dinkurapi.v1.EntryGap.next:type_name -> dinkurapi.v1.Entry
+	52, // 26: dinkurapi.v1.CreateEntryRequest.start:type_name -> google.protobuf.Timestamp
+	52, // 27: dinkurapi.v1.CreateEntryRequest.end:type_name -> google.protobuf.Timestamp
+	51, // 28: dinkurapi.v1.CreateEntryResponse.created_entry:type_name -> dinkurapi.v1.Entry
+	51, // 29: dinkurapi.v1.CreateEntryResponse.previously_active_entry:type_name -> dinkurapi.v1.Entry
+	19, // 30: dinkurapi.v1.CreateEntriesRequest.entries:type_name -> dinkurapi.v1.CreateEntryRequest
+	20, // 31: dinkurapi.v1.CreateEntriesResponse.entries:type_name -> dinkurapi.v1.CreateEntryResponse
+	52, // 32: dinkurapi.v1.UpdateEntryRequest.start:type_name -> google.protobuf.Timestamp
+	52, // 33: dinkurapi.v1.UpdateEntryRequest.end:type_name -> google.protobuf.Timestamp
+	51, // 34: dinkurapi.v1.UpdateEntryResponse.before:type_name -> dinkurapi.v1.Entry
+	51, // 35: dinkurapi.v1.UpdateEntryResponse.after:type_name -> dinkurapi.v1.Entry
+	51, // 36: dinkurapi.v1.DeleteEntryResponse.deleted_entry:type_name -> dinkurapi.v1.Entry
+	11, // 37: dinkurapi.v1.UpdateEntriesRequest.search:type_name -> dinkurapi.v1.GetEntryListRequest
+	53, // 38: dinkurapi.v1.UpdateEntriesRequest.shift:type_name -> google.protobuf.Duration
+	50, // 39: dinkurapi.v1.UpdateEntriesResponse.updated:type_name -> dinkurapi.v1.EntryChange
+	11, // 40: dinkurapi.v1.DeleteEntriesRequest.search:type_name -> dinkurapi.v1.GetEntryListRequest
+	51, // 41: dinkurapi.v1.DeleteEntriesResponse.deleted_entries:type_name -> dinkurapi.v1.Entry
+	52, // 42: dinkurapi.v1.StopActiveEntryRequest.end:type_name -> google.protobuf.Timestamp
+	51, // 43: dinkurapi.v1.StopActiveEntryResponse.stopped_entry:type_name -> dinkurapi.v1.Entry
+	51, // 44: dinkurapi.v1.StreamEntryResponse.entry:type_name -> dinkurapi.v1.Entry
+	54, // 45: dinkurapi.v1.StreamEntryResponse.event:type_name -> dinkurapi.v1.Event
+	2,  // 46: dinkurapi.v1.UndoResponse.action:type_name -> dinkurapi.v1.JournalAction
+	50, // 47: dinkurapi.v1.UndoResponse.changes:type_name -> dinkurapi.v1.EntryChange
+	2,  // 48: dinkurapi.v1.RedoResponse.action:type_name -> dinkurapi.v1.JournalAction
+	50, // 49: dinkurapi.v1.RedoResponse.changes:type_name -> dinkurapi.v1.EntryChange
+	51, // 50: dinkurapi.v1.GetTrashedEntryListResponse.entries:type_name -> dinkurapi.v1.Entry
+	51, // 51: dinkurapi.v1.RestoreEntryResponse.restored_entry:type_name -> dinkurapi.v1.Entry
+	52, // 52: dinkurapi.v1.PurgeTrashedEntriesRequest.deleted_before:type_name -> google.protobuf.Timestamp
+	51, // 53: dinkurapi.v1.PurgeTrashedEntriesResponse.purged_entries:type_name -> dinkurapi.v1.Entry
+	11, // 54: dinkurapi.v1.GetEntryOverlapsRequest.search:type_name -> dinkurapi.v1.GetEntryListRequest
+	49, // 55: dinkurapi.v1.GetEntryOverlapsResponse.overlaps:type_name -> dinkurapi.v1.EntryOverlap
+	11, // 56: dinkurapi.v1.ResolveEntryOverlapsRequest.search:type_name -> dinkurapi.v1.GetEntryListRequest
+	1,  // 57: dinkurapi.v1.ResolveEntryOverlapsRequest.policy:type_name -> dinkurapi.v1.OverlapPolicy
+	50, // 58: dinkurapi.v1.ResolveEntryOverlapsResponse.changes:type_name -> dinkurapi.v1.EntryChange
+	51, // 59: dinkurapi.v1.EntryOverlap.first:type_name -> dinkurapi.v1.Entry
+	51, // 60: dinkurapi.v1.EntryOverlap.second:type_name -> dinkurapi.v1.Entry
+	51, // 61: dinkurapi.v1.EntryChange.before:type_name -> dinkurapi.v1.Entry
+	51, // 62: dinkurapi.v1.EntryChange.after:type_name -> dinkurapi.v1.Entry
+	52, // 63: dinkurapi.v1.Entry.created:type_name -> google.protobuf.Timestamp
+	52, // 64: dinkurapi.v1.Entry.updated:type_name -> google.protobuf.Timestamp
+	52, // 65: dinkurapi.v1.Entry.start:type_name -> google.protobuf.Timestamp
+	52, // 66: dinkurapi.v1.Entry.end:type_name -> google.protobuf.Timestamp
+	55, // 67: dinkurapi.v1.Entry.project:type_name -> dinkurapi.v1.Project
+	52, // 68: dinkurapi.v1.Entry.deleted:type_name -> google.protobuf.Timestamp
+	5,  // 69: dinkurapi.v1.Entries.Ping:input_type -> dinkurapi.v1.PingRequest
+	7,  // 70: dinkurapi.v1.Entries.GetEntry:input_type -> dinkurapi.v1.GetEntryRequest
+	9,  // 71: dinkurapi.v1.Entries.GetActiveEntry:input_type -> dinkurapi.v1.GetActiveEntryRequest
+	11, // 72: dinkurapi.v1.Entries.GetEntryList:input_type -> dinkurapi.v1.GetEntryListRequest
+	13, // 73: dinkurapi.v1.Entries.GetEntrySummary:input_type -> dinkurapi.v1.GetEntrySummaryRequest
+	16, // 74: dinkurapi.v1.Entries.GetEntryGaps:input_type -> dinkurapi.v1.GetEntryGapsRequest
+	19, // 75: dinkurapi.v1.Entries.CreateEntry:input_type -> dinkurapi.v1.CreateEntryRequest
+	21, // 76: dinkurapi.v1.Entries.CreateEntries:input_type -> dinkurapi.v1.CreateEntriesRequest
+	23, // 77: dinkurapi.v1.Entries.UpdateEntry:input_type -> dinkurapi.v1.UpdateEntryRequest
+	25, // 78: dinkurapi.v1.Entries.DeleteEntry:input_type -> dinkurapi.v1.DeleteEntryRequest
+	27, // 79: dinkurapi.v1.Entries.UpdateEntries:input_type -> dinkurapi.v1.UpdateEntriesRequest
+	29, // 80: dinkurapi.v1.Entries.DeleteEntries:input_type -> dinkurapi.v1.DeleteEntriesRequest
+	31, // 81: dinkurapi.v1.Entries.StopActiveEntry:input_type -> dinkurapi.v1.StopActiveEntryRequest
+	33, // 82: dinkurapi.v1.Entries.StreamEntry:input_type -> dinkurapi.v1.StreamEntryRequest
+	35, // 83: dinkurapi.v1.Entries.Undo:input_type -> dinkurapi.v1.UndoRequest
+	37, // 84: dinkurapi.v1.Entries.Redo:input_type -> dinkurapi.v1.RedoRequest
+	39, // 85: dinkurapi.v1.Entries.GetTrashedEntryList:input_type -> dinkurapi.v1.GetTrashedEntryListRequest
+	41, // 86: dinkurapi.v1.Entries.RestoreEntry:input_type -> dinkurapi.v1.RestoreEntryRequest
+	43, // 87: dinkurapi.v1.Entries.PurgeTrashedEntries:input_type -> dinkurapi.v1.PurgeTrashedEntriesRequest
+	45, // 88: dinkurapi.v1.Entries.GetEntryOverlaps:input_type -> dinkurapi.v1.GetEntryOverlapsRequest
+	47, // 89: dinkurapi.v1.Entries.ResolveEntryOverlaps:input_type -> dinkurapi.v1.ResolveEntryOverlapsRequest
+	6,  // 90: dinkurapi.v1.Entries.Ping:output_type -> dinkurapi.v1.PingResponse
+	8,  // 91: dinkurapi.v1.Entries.GetEntry:output_type -> dinkurapi.v1.GetEntryResponse
+	10, // 92: dinkurapi.v1.Entries.GetActiveEntry:output_type -> dinkurapi.v1.GetActiveEntryResponse
+	12, // 93: dinkurapi.v1.Entries.GetEntryList:output_type -> dinkurapi.v1.GetEntryListResponse
+	14, // 94: dinkurapi.v1.Entries.GetEntrySummary:output_type -> dinkurapi.v1.GetEntrySummaryResponse
+	17, // 95: dinkurapi.v1.Entries.GetEntryGaps:output_type -> dinkurapi.v1.GetEntryGapsResponse
+	20, // 96: dinkurapi.v1.Entries.CreateEntry:output_type -> dinkurapi.v1.CreateEntryResponse
+	22, // 97: dinkurapi.v1.Entries.CreateEntries:output_type -> dinkurapi.v1.CreateEntriesResponse
+	24, // 98: dinkurapi.v1.Entries.UpdateEntry:output_type -> dinkurapi.v1.UpdateEntryResponse
+	26, // 99: dinkurapi.v1.Entries.DeleteEntry:output_type -> dinkurapi.v1.DeleteEntryResponse
+	28, // 100: dinkurapi.v1.Entries.UpdateEntries:output_type -> dinkurapi.v1.UpdateEntriesResponse
+	30, // 101: dinkurapi.v1.Entries.DeleteEntries:output_type -> dinkurapi.v1.DeleteEntriesResponse
+	32, // 102: dinkurapi.v1.Entries.StopActiveEntry:output_type -> dinkurapi.v1.StopActiveEntryResponse
+	34, // 103: dinkurapi.v1.Entries.StreamEntry:output_type -> dinkurapi.v1.StreamEntryResponse
+	36, // 104: dinkurapi.v1.Entries.Undo:output_type -> dinkurapi.v1.UndoResponse
+	38, // 105: dinkurapi.v1.Entries.Redo:output_type -> dinkurapi.v1.RedoResponse
+	40, // 106: dinkurapi.v1.Entries.GetTrashedEntryList:output_type -> dinkurapi.v1.GetTrashedEntryListResponse
+	42, // 107: dinkurapi.v1.Entries.RestoreEntry:output_type -> dinkurapi.v1.RestoreEntryResponse
+	44, // 108: dinkurapi.v1.Entries.PurgeTrashedEntries:output_type -> dinkurapi.v1.PurgeTrashedEntriesResponse
+	46, // 109: dinkurapi.v1.Entries.GetEntryOverlaps:output_type -> dinkurapi.v1.GetEntryOverlapsResponse
+	48, // 110: dinkurapi.v1.Entries.ResolveEntryOverlaps:output_type -> dinkurapi.v1.ResolveEntryOverlapsResponse
+	90, // [90:111] is the sub-list for method output_type
+	69, // [69:90] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
 }
 
 func init() { file_api_dinkurapi_v1_entries_proto_init() }
@@ -1643,6 +4211,7 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 		return
 	}
 	file_api_dinkurapi_v1_event_proto_init()
+	file_api_dinkurapi_v1_projects_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_api_dinkurapi_v1_entries_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PingRequest); i {
@@ -1656,8 +4225,92 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingResponse); i {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActiveEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActiveEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntryListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntryListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntrySummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1668,8 +4321,8 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEntryRequest); i {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntrySummaryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1680,8 +4333,8 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEntryResponse); i {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntrySummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1692,8 +4345,8 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetActiveEntryRequest); i {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntryGapsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1704,8 +4357,8 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetActiveEntryResponse); i {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntryGapsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1716,8 +4369,8 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEntryListRequest); i {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntryGap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1728,8 +4381,8 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEntryListResponse); i {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEntryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1740,8 +4393,8 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEntryRequest); i {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEntryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1752,8 +4405,8 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEntryResponse); i {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEntriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1764,7 +4417,19 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateEntryRequest); i {
 			case 0:
 				return &v.state
@@ -1776,7 +4441,7 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateEntryResponse); i {
 			case 0:
 				return &v.state
@@ -1788,7 +4453,7 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteEntryRequest); i {
 			case 0:
 				return &v.state
@@ -1800,7 +4465,7 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteEntryResponse); i {
 			case 0:
 				return &v.state
@@ -1812,7 +4477,55 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StopActiveEntryRequest); i {
 			case 0:
 				return &v.state
@@ -1824,7 +4537,7 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StopActiveEntryResponse); i {
 			case 0:
 				return &v.state
@@ -1836,7 +4549,7 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamEntryRequest); i {
 			case 0:
 				return &v.state
@@ -1848,7 +4561,7 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamEntryResponse); i {
 			case 0:
 				return &v.state
@@ -1860,7 +4573,199 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 				return nil
 			}
 		}
-		file_api_dinkurapi_v1_entries_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_api_dinkurapi_v1_entries_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrashedEntryListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrashedEntryListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeTrashedEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeTrashedEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntryOverlapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntryOverlapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveEntryOverlapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveEntryOverlapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntryOverlap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntryChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_dinkurapi_v1_entries_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Entry); i {
 			case 0:
 				return &v.state
@@ -1878,8 +4783,8 @@ func file_api_dinkurapi_v1_entries_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_dinkurapi_v1_entries_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   19,
+			NumEnums:      5,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,21 +28,66 @@ type EntriesClient interface {
 	GetActiveEntry(ctx context.Context, in *GetActiveEntryRequest, opts ...grpc.CallOption) (*GetActiveEntryResponse, error)
 	// GetEntryList queries for a list of entries.
 	GetEntryList(ctx context.Context, in *GetEntryListRequest, opts ...grpc.CallOption) (*GetEntryListResponse, error)
+	// GetEntrySummary returns the summed up durations of entries, bucketed by
+	// day, week, month, or entry name. Active entries are counted up until now.
+	GetEntrySummary(ctx context.Context, in *GetEntrySummaryRequest, opts ...grpc.CallOption) (*GetEntrySummaryResponse, error)
+	// GetEntryGaps returns the gaps of unaccounted time between entries, within
+	// working hours.
+	GetEntryGaps(ctx context.Context, in *GetEntryGapsRequest, opts ...grpc.CallOption) (*GetEntryGapsResponse, error)
 	// CreateEntry creates a new entry and stops any currently active entries, and
 	// returns the stopped previously active entry (if any) and the newly created
 	// entry.
 	CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*CreateEntryResponse, error)
+	// CreateEntries creates multiple new entries inside a single transaction,
+	// where either all or none of the entries are created. Each entry is created
+	// in order the same way as with CreateEntry, except that any currently
+	// active entry is only stopped by new entries that do not have an end
+	// timestamp. This makes it suitable for importing entries.
+	CreateEntries(ctx context.Context, in *CreateEntriesRequest, opts ...grpc.CallOption) (*CreateEntriesResponse, error)
 	// UpdateEntry alters a entry by ID and returns the entry's before and after
 	// state. Status 5 "NOT_FOUND" is reported if no entry was found by that ID.
 	UpdateEntry(ctx context.Context, in *UpdateEntryRequest, opts ...grpc.CallOption) (*UpdateEntryResponse, error)
 	// DeleteEntry removes a entry by ID. Status 5 "NOT_FOUND" is=
 	// reported if no entry was found by that ID.
 	DeleteEntry(ctx context.Context, in *DeleteEntryRequest, opts ...grpc.CallOption) (*DeleteEntryResponse, error)
+	// UpdateEntries alters all entries matching a search query inside a single
+	// transaction, where either all or none of the entries are updated. Only
+	// the entries that were changed are returned.
+	UpdateEntries(ctx context.Context, in *UpdateEntriesRequest, opts ...grpc.CallOption) (*UpdateEntriesResponse, error)
+	// DeleteEntries removes all entries matching a search query inside a single
+	// transaction, where either all or none of the entries are removed.
+	DeleteEntries(ctx context.Context, in *DeleteEntriesRequest, opts ...grpc.CallOption) (*DeleteEntriesResponse, error)
 	// StopActiveEntry stops the currently active entry and returns that entry
 	// (if any).
 	StopActiveEntry(ctx context.Context, in *StopActiveEntryRequest, opts ...grpc.CallOption) (*StopActiveEntryResponse, error)
-	// StreamAlert streams entry change events: created, updated, deleted.
+	// StreamAlert streams entry change events: created, updated, deleted,
+	// restored.
 	StreamEntry(ctx context.Context, in *StreamEntryRequest, opts ...grpc.CallOption) (Entries_StreamEntryClient, error)
+	// Undo reverts the latest change to entries that has not already been
+	// undone. Status 9 "FAILED_PRECONDITION" is reported if there is nothing to
+	// undo.
+	Undo(ctx context.Context, in *UndoRequest, opts ...grpc.CallOption) (*UndoResponse, error)
+	// Redo reapplies the latest undone change to entries, as long as no other
+	// changes have been made since it was undone. Status 9
+	// "FAILED_PRECONDITION" is reported if there is nothing to redo.
+	Redo(ctx context.Context, in *RedoRequest, opts ...grpc.CallOption) (*RedoResponse, error)
+	// GetTrashedEntryList returns all removed entries that are still in the
+	// trash, sorted by when they were removed.
+	GetTrashedEntryList(ctx context.Context, in *GetTrashedEntryListRequest, opts ...grpc.CallOption) (*GetTrashedEntryListResponse, error)
+	// RestoreEntry moves a removed entry out of the trash. Status 5
+	// "NOT_FOUND" is reported if no entry was found by that ID in the trash.
+	RestoreEntry(ctx context.Context, in *RestoreEntryRequest, opts ...grpc.CallOption) (*RestoreEntryResponse, error)
+	// PurgeTrashedEntries permanently deletes entries in the trash that were
+	// removed before a given timestamp. Purged entries are also removed from
+	// the undo/redo history.
+	PurgeTrashedEntries(ctx context.Context, in *PurgeTrashedEntriesRequest, opts ...grpc.CallOption) (*PurgeTrashedEntriesResponse, error)
+	// GetEntryOverlaps returns each pair of overlapping entries among the
+	// entries matching a search query.
+	GetEntryOverlaps(ctx context.Context, in *GetEntryOverlapsRequest, opts ...grpc.CallOption) (*GetEntryOverlapsResponse, error)
+	// ResolveEntryOverlaps changes the entries matching a search query so they
+	// no longer overlap. Status 3 "INVALID_ARGUMENT" is reported if the policy
+	// is not "trim" nor "split".
+	ResolveEntryOverlaps(ctx context.Context, in *ResolveEntryOverlapsRequest, opts ...grpc.CallOption) (*ResolveEntryOverlapsResponse, error)
 }
 
 type entriesClient struct {
@@ -89,6 +134,24 @@ func (c *entriesClient) GetEntryList(ctx context.Context, in *GetEntryListReques
 	return out, nil
 }
 
+func (c *entriesClient) GetEntrySummary(ctx context.Context, in *GetEntrySummaryRequest, opts ...grpc.CallOption) (*GetEntrySummaryResponse, error) {
+	out := new(GetEntrySummaryResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/GetEntrySummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entriesClient) GetEntryGaps(ctx context.Context, in *GetEntryGapsRequest, opts ...grpc.CallOption) (*GetEntryGapsResponse, error) {
+	out := new(GetEntryGapsResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/GetEntryGaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *entriesClient) CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*CreateEntryResponse, error) {
 	out := new(CreateEntryResponse)
 	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/CreateEntry", in, out, opts...)
@@ -98,6 +161,15 @@ func (c *entriesClient) CreateEntry(ctx context.Context, in *CreateEntryRequest,
 	return out, nil
 }
 
+func (c *entriesClient) CreateEntries(ctx context.Context, in *CreateEntriesRequest, opts ...grpc.CallOption) (*CreateEntriesResponse, error) {
+	out := new(CreateEntriesResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/CreateEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *entriesClient) UpdateEntry(ctx context.Context, in *UpdateEntryRequest, opts ...grpc.CallOption) (*UpdateEntryResponse, error) {
 	out := new(UpdateEntryResponse)
 	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/UpdateEntry", in, out, opts...)
@@ -116,6 +188,24 @@ func (c *entriesClient) DeleteEntry(ctx context.Context, in *DeleteEntryRequest,
 	return out, nil
 }
 
+func (c *entriesClient) UpdateEntries(ctx context.Context, in *UpdateEntriesRequest, opts ...grpc.CallOption) (*UpdateEntriesResponse, error) {
+	out := new(UpdateEntriesResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/UpdateEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entriesClient) DeleteEntries(ctx context.Context, in *DeleteEntriesRequest, opts ...grpc.CallOption) (*DeleteEntriesResponse, error) {
+	out := new(DeleteEntriesResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/DeleteEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *entriesClient) StopActiveEntry(ctx context.Context, in *StopActiveEntryRequest, opts ...grpc.CallOption) (*StopActiveEntryResponse, error) {
 	out := new(StopActiveEntryResponse)
 	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/StopActiveEntry", in, out, opts...)
@@ -157,6 +247,69 @@ func (x *entriesStreamEntryClient) Recv() (*StreamEntryResponse, error) {
 	return m, nil
 }
 
+func (c *entriesClient) Undo(ctx context.Context, in *UndoRequest, opts ...grpc.CallOption) (*UndoResponse, error) {
+	out := new(UndoResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/Undo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entriesClient) Redo(ctx context.Context, in *RedoRequest, opts ...grpc.CallOption) (*RedoResponse, error) {
+	out := new(RedoResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/Redo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entriesClient) GetTrashedEntryList(ctx context.Context, in *GetTrashedEntryListRequest, opts ...grpc.CallOption) (*GetTrashedEntryListResponse, error) {
+	out := new(GetTrashedEntryListResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/GetTrashedEntryList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entriesClient) RestoreEntry(ctx context.Context, in *RestoreEntryRequest, opts ...grpc.CallOption) (*RestoreEntryResponse, error) {
+	out := new(RestoreEntryResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/RestoreEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entriesClient) PurgeTrashedEntries(ctx context.Context, in *PurgeTrashedEntriesRequest, opts ...grpc.CallOption) (*PurgeTrashedEntriesResponse, error) {
+	out := new(PurgeTrashedEntriesResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/PurgeTrashedEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entriesClient) GetEntryOverlaps(ctx context.Context, in *GetEntryOverlapsRequest, opts ...grpc.CallOption) (*GetEntryOverlapsResponse, error) {
+	out := new(GetEntryOverlapsResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/GetEntryOverlaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entriesClient) ResolveEntryOverlaps(ctx context.Context, in *ResolveEntryOverlapsRequest, opts ...grpc.CallOption) (*ResolveEntryOverlapsResponse, error) {
+	out := new(ResolveEntryOverlapsResponse)
+	err := c.cc.Invoke(ctx, "/dinkurapi.v1.Entries/ResolveEntryOverlaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EntriesServer is the server API for Entries service.
 // All implementations must embed UnimplementedEntriesServer
 // for forward compatibility
@@ -171,21 +324,66 @@ type EntriesServer interface {
 	GetActiveEntry(context.Context, *GetActiveEntryRequest) (*GetActiveEntryResponse, error)
 	// GetEntryList queries for a list of entries.
 	GetEntryList(context.Context, *GetEntryListRequest) (*GetEntryListResponse, error)
+	// GetEntrySummary returns the summed up durations of entries, bucketed by
+	// day, week, month, or entry name. Active entries are counted up until now.
+	GetEntrySummary(context.Context, *GetEntrySummaryRequest) (*GetEntrySummaryResponse, error)
+	// GetEntryGaps returns the gaps of unaccounted time between entries, within
+	// working hours.
+	GetEntryGaps(context.Context, *GetEntryGapsRequest) (*GetEntryGapsResponse, error)
 	// CreateEntry creates a new entry and stops any currently active entries, and
 	// returns the stopped previously active entry (if any) and the newly created
 	// entry.
 	CreateEntry(context.Context, *CreateEntryRequest) (*CreateEntryResponse, error)
+	// CreateEntries creates multiple new entries inside a single transaction,
+	// where either all or none of the entries are created. Each entry is created
+	// in order the same way as with CreateEntry, except that any currently
+	// active entry is only stopped by new entries that do not have an end
+	// timestamp. This makes it suitable for importing entries.
+	CreateEntries(context.Context, *CreateEntriesRequest) (*CreateEntriesResponse, error)
 	// UpdateEntry alters a entry by ID and returns the entry's before and after
 	// state. Status 5 "NOT_FOUND" is reported if no entry was found by that ID.
 	UpdateEntry(context.Context, *UpdateEntryRequest) (*UpdateEntryResponse, error)
 	// DeleteEntry removes a entry by ID. Status 5 "NOT_FOUND" is=
 	// reported if no entry was found by that ID.
 	DeleteEntry(context.Context, *DeleteEntryRequest) (*DeleteEntryResponse, error)
+	// UpdateEntries alters all entries matching a search query inside a single
+	// transaction, where either all or none of the entries are updated. Only
+	// the entries that were changed are returned.
+	UpdateEntries(context.Context, *UpdateEntriesRequest) (*UpdateEntriesResponse, error)
+	// DeleteEntries removes all entries matching a search query inside a single
+	// transaction, where either all or none of the entries are removed.
+	DeleteEntries(context.Context, *DeleteEntriesRequest) (*DeleteEntriesResponse, error)
 	// StopActiveEntry stops the currently active entry and returns that entry
 	// (if any).
 	StopActiveEntry(context.Context, *StopActiveEntryRequest) (*StopActiveEntryResponse, error)
-	// StreamAlert streams entry change events: created, updated, deleted.
+	// StreamAlert streams entry change events: created, updated, deleted,
+	// restored.
 	StreamEntry(*StreamEntryRequest, Entries_StreamEntryServer) error
+	// Undo reverts the latest change to entries that has not already been
+	// undone. Status 9 "FAILED_PRECONDITION" is reported if there is nothing to
+	// undo.
+	Undo(context.Context, *UndoRequest) (*UndoResponse, error)
+	// Redo reapplies the latest undone change to entries, as long as no other
+	// changes have been made since it was undone. Status 9
+	// "FAILED_PRECONDITION" is reported if there is nothing to redo.
+	Redo(context.Context, *RedoRequest) (*RedoResponse, error)
+	// GetTrashedEntryList returns all removed entries that are still in the
+	// trash, sorted by when they were removed.
+	GetTrashedEntryList(context.Context, *GetTrashedEntryListRequest) (*GetTrashedEntryListResponse, error)
+	// RestoreEntry moves a removed entry out of the trash. Status 5
+	// "NOT_FOUND" is reported if no entry was found by that ID in the trash.
+	RestoreEntry(context.Context, *RestoreEntryRequest) (*RestoreEntryResponse, error)
+	// PurgeTrashedEntries permanently deletes entries in the trash that were
+	// removed before a given timestamp. Purged entries are also removed from
+	// the undo/redo history.
+	PurgeTrashedEntries(context.Context, *PurgeTrashedEntriesRequest) (*PurgeTrashedEntriesResponse, error)
+	// GetEntryOverlaps returns each pair of overlapping entries among the
+	// entries matching a search query.
+	GetEntryOverlaps(context.Context, *GetEntryOverlapsRequest) (*GetEntryOverlapsResponse, error)
+	// ResolveEntryOverlaps changes the entries matching a search query so they
+	// no longer overlap. Status 3 "INVALID_ARGUMENT" is reported if the policy
+	// is not "trim" nor "split".
+	ResolveEntryOverlaps(context.Context, *ResolveEntryOverlapsRequest) (*ResolveEntryOverlapsResponse, error)
 	mustEmbedUnimplementedEntriesServer()
 }
 
@@ -205,21 +403,57 @@ func (UnimplementedEntriesServer) GetActiveEntry(context.Context, *GetActiveEntr
 func (UnimplementedEntriesServer) GetEntryList(context.Context, *GetEntryListRequest) (*GetEntryListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEntryList not implemented")
 }
+func (UnimplementedEntriesServer) GetEntrySummary(context.Context, *GetEntrySummaryRequest) (*GetEntrySummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntrySummary not implemented")
+}
+func (UnimplementedEntriesServer) GetEntryGaps(context.Context, *GetEntryGapsRequest) (*GetEntryGapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntryGaps not implemented")
+}
 func (UnimplementedEntriesServer) CreateEntry(context.Context, *CreateEntryRequest) (*CreateEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEntry not implemented")
 }
+func (UnimplementedEntriesServer) CreateEntries(context.Context, *CreateEntriesRequest) (*CreateEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEntries not implemented")
+}
 func (UnimplementedEntriesServer) UpdateEntry(context.Context, *UpdateEntryRequest) (*UpdateEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntry not implemented")
 }
 func (UnimplementedEntriesServer) DeleteEntry(context.Context, *DeleteEntryRequest) (*DeleteEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntry not implemented")
 }
+func (UnimplementedEntriesServer) UpdateEntries(context.Context, *UpdateEntriesRequest) (*UpdateEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntries not implemented")
+}
+func (UnimplementedEntriesServer) DeleteEntries(context.Context, *DeleteEntriesRequest) (*DeleteEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntries not implemented")
+}
 func (UnimplementedEntriesServer) StopActiveEntry(context.Context, *StopActiveEntryRequest) (*StopActiveEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopActiveEntry not implemented")
 }
 func (UnimplementedEntriesServer) StreamEntry(*StreamEntryRequest, Entries_StreamEntryServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamEntry not implemented")
 }
+func (UnimplementedEntriesServer) Undo(context.Context, *UndoRequest) (*UndoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undo not implemented")
+}
+func (UnimplementedEntriesServer) Redo(context.Context, *RedoRequest) (*RedoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redo not implemented")
+}
+func (UnimplementedEntriesServer) GetTrashedEntryList(context.Context, *GetTrashedEntryListRequest) (*GetTrashedEntryListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrashedEntryList not implemented")
+}
+func (UnimplementedEntriesServer) RestoreEntry(context.Context, *RestoreEntryRequest) (*RestoreEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEntry not implemented")
+}
+func (UnimplementedEntriesServer) PurgeTrashedEntries(context.Context, *PurgeTrashedEntriesRequest) (*PurgeTrashedEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTrashedEntries not implemented")
+}
+func (UnimplementedEntriesServer) GetEntryOverlaps(context.Context, *GetEntryOverlapsRequest) (*GetEntryOverlapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntryOverlaps not implemented")
+}
+func (UnimplementedEntriesServer) ResolveEntryOverlaps(context.Context, *ResolveEntryOverlapsRequest) (*ResolveEntryOverlapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveEntryOverlaps not implemented")
+}
 func (UnimplementedEntriesServer) mustEmbedUnimplementedEntriesServer() {}
 
 // UnsafeEntriesServer may be embedded to opt out of forward compatibility for this service.
@@ -305,6 +539,42 @@ func _Entries_GetEntryList_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Entries_GetEntrySummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntrySummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).GetEntrySummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/GetEntrySummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).GetEntrySummary(ctx, req.(*GetEntrySummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entries_GetEntryGaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntryGapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).GetEntryGaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/GetEntryGaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).GetEntryGaps(ctx, req.(*GetEntryGapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Entries_CreateEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateEntryRequest)
 	if err := dec(in); err != nil {
@@ -323,6 +593,24 @@ func _Entries_CreateEntry_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Entries_CreateEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).CreateEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/CreateEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).CreateEntries(ctx, req.(*CreateEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Entries_UpdateEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateEntryRequest)
 	if err := dec(in); err != nil {
@@ -359,6 +647,42 @@ func _Entries_DeleteEntry_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Entries_UpdateEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).UpdateEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/UpdateEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).UpdateEntries(ctx, req.(*UpdateEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entries_DeleteEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).DeleteEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/DeleteEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).DeleteEntries(ctx, req.(*DeleteEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Entries_StopActiveEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopActiveEntryRequest)
 	if err := dec(in); err != nil {
@@ -398,6 +722,132 @@ func (x *entriesStreamEntryServer) Send(m *StreamEntryResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Entries_Undo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).Undo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/Undo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).Undo(ctx, req.(*UndoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entries_Redo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).Redo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/Redo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).Redo(ctx, req.(*RedoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entries_GetTrashedEntryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrashedEntryListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).GetTrashedEntryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/GetTrashedEntryList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).GetTrashedEntryList(ctx, req.(*GetTrashedEntryListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entries_RestoreEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).RestoreEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/RestoreEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).RestoreEntry(ctx, req.(*RestoreEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entries_PurgeTrashedEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTrashedEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).PurgeTrashedEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/PurgeTrashedEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).PurgeTrashedEntries(ctx, req.(*PurgeTrashedEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entries_GetEntryOverlaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntryOverlapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).GetEntryOverlaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/GetEntryOverlaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).GetEntryOverlaps(ctx, req.(*GetEntryOverlapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entries_ResolveEntryOverlaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveEntryOverlapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntriesServer).ResolveEntryOverlaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dinkurapi.v1.Entries/ResolveEntryOverlaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntriesServer).ResolveEntryOverlaps(ctx, req.(*ResolveEntryOverlapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Entries_ServiceDesc is the grpc.ServiceDesc for Entries service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -421,10 +871,22 @@ var Entries_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEntryList",
 			Handler:    _Entries_GetEntryList_Handler,
 		},
+		{
+			MethodName: "GetEntrySummary",
+			Handler:    _Entries_GetEntrySummary_Handler,
+		},
+		{
+			MethodName: "GetEntryGaps",
+			Handler:    _Entries_GetEntryGaps_Handler,
+		},
 		{
 			MethodName: "CreateEntry",
 			Handler:    _Entries_CreateEntry_Handler,
 		},
+		{
+			MethodName: "CreateEntries",
+			Handler:    _Entries_CreateEntries_Handler,
+		},
 		{
 			MethodName: "UpdateEntry",
 			Handler:    _Entries_UpdateEntry_Handler,
@@ -433,10 +895,46 @@ var Entries_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEntry",
 			Handler:    _Entries_DeleteEntry_Handler,
 		},
+		{
+			MethodName: "UpdateEntries",
+			Handler:    _Entries_UpdateEntries_Handler,
+		},
+		{
+			MethodName: "DeleteEntries",
+			Handler:    _Entries_DeleteEntries_Handler,
+		},
 		{
 			MethodName: "StopActiveEntry",
 			Handler:    _Entries_StopActiveEntry_Handler,
 		},
+		{
+			MethodName: "Undo",
+			Handler:    _Entries_Undo_Handler,
+		},
+		{
+			MethodName: "Redo",
+			Handler:    _Entries_Redo_Handler,
+		},
+		{
+			MethodName: "GetTrashedEntryList",
+			Handler:    _Entries_GetTrashedEntryList_Handler,
+		},
+		{
+			MethodName: "RestoreEntry",
+			Handler:    _Entries_RestoreEntry_Handler,
+		},
+		{
+			MethodName: "PurgeTrashedEntries",
+			Handler:    _Entries_PurgeTrashedEntries_Handler,
+		},
+		{
+			MethodName: "GetEntryOverlaps",
+			Handler:    _Entries_GetEntryOverlaps_Handler,
+		},
+		{
+			MethodName: "ResolveEntryOverlaps",
+			Handler:    _Entries_ResolveEntryOverlaps_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
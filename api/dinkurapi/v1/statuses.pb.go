@@ -336,6 +336,13 @@ type Status struct {
 	// BackSince is set whenever the user has returned from being AFK, but has not
 	// yet resolved their AFK status.
 	BackSince *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=back_since,json=backSince,proto3" json:"back_since,omitempty"`
+	// LastSeenAlive is the most recent heartbeat of the Dinkur daemon, and is
+	// used to detect if the computer was rebooted while an entry was active.
+	LastSeenAlive *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_seen_alive,json=lastSeenAlive,proto3" json:"last_seen_alive,omitempty"`
+	// AutoStoppedEntryIdOrZero is the ID of the entry that was automatically
+	// stopped by the Dinkur daemon after the user had been AFK for too long, or
+	// zero if no entry has been automatically stopped.
+	AutoStoppedEntryIdOrZero uint64 `protobuf:"varint,7,opt,name=auto_stopped_entry_id_or_zero,json=autoStoppedEntryIdOrZero,proto3" json:"auto_stopped_entry_id_or_zero,omitempty"`
 }
 
 func (x *Status) Reset() {
@@ -398,6 +405,20 @@ func (x *Status) GetBackSince() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Status) GetLastSeenAlive() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAlive
+	}
+	return nil
+}
+
+func (x *Status) GetAutoStoppedEntryIdOrZero() uint64 {
+	if x != nil {
+		return x.AutoStoppedEntryIdOrZero
+	}
+	return 0
+}
+
 var File_api_dinkurapi_v1_statuses_proto protoreflect.FileDescriptor
 
 var file_api_dinkurapi_v1_statuses_proto_rawDesc = []byte{
@@ -427,7 +448,7 @@ var file_api_dinkurapi_v1_statuses_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69,
 	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a,
+	0x75, 0x73, 0x22, 0xed, 0x02, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a,
 	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
@@ -441,27 +462,35 @@ var file_api_dinkurapi_v1_statuses_proto_rawDesc = []byte{
 	0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x32, 0xff, 0x01,
-	0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0c, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x69, 0x6e,
-	0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1e, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
-	0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69,
-	0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x70, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x42, 0x0a,
+	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x6c, 0x69, 0x76,
+	0x65, 0x12, 0x3f, 0x0a, 0x1d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x5f, 0x7a, 0x65,
+	0x72, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x74,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x4f, 0x72, 0x5a, 0x65,
+	0x72, 0x6f, 0x32, 0xff, 0x01, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12,
+	0x57, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x21, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x6e, 0x6b, 0x75, 0x72, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -496,17 +525,18 @@ var file_api_dinkurapi_v1_statuses_proto_depIdxs = []int32{
 	7,  // 5: dinkurapi.v1.Status.updated:type_name -> google.protobuf.Timestamp
 	7,  // 6: dinkurapi.v1.Status.afk_since:type_name -> google.protobuf.Timestamp
 	7,  // 7: dinkurapi.v1.Status.back_since:type_name -> google.protobuf.Timestamp
-	0,  // 8: dinkurapi.v1.Statuses.StreamStatus:input_type -> dinkurapi.v1.StreamStatusRequest
-	2,  // 9: dinkurapi.v1.Statuses.SetStatus:input_type -> dinkurapi.v1.SetStatusRequest
-	4,  // 10: dinkurapi.v1.Statuses.GetStatus:input_type -> dinkurapi.v1.GetStatusRequest
-	1,  // 11: dinkurapi.v1.Statuses.StreamStatus:output_type -> dinkurapi.v1.StreamStatusResponse
-	3,  // 12: dinkurapi.v1.Statuses.SetStatus:output_type -> dinkurapi.v1.SetStatusResponse
-	5,  // 13: dinkurapi.v1.Statuses.GetStatus:output_type -> dinkurapi.v1.GetStatusResponse
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	7,  // 8: dinkurapi.v1.Status.last_seen_alive:type_name -> google.protobuf.Timestamp
+	0,  // 9: dinkurapi.v1.Statuses.StreamStatus:input_type -> dinkurapi.v1.StreamStatusRequest
+	2,  // 10: dinkurapi.v1.Statuses.SetStatus:input_type -> dinkurapi.v1.SetStatusRequest
+	4,  // 11: dinkurapi.v1.Statuses.GetStatus:input_type -> dinkurapi.v1.GetStatusRequest
+	1,  // 12: dinkurapi.v1.Statuses.StreamStatus:output_type -> dinkurapi.v1.StreamStatusResponse
+	3,  // 13: dinkurapi.v1.Statuses.SetStatus:output_type -> dinkurapi.v1.SetStatusResponse
+	5,  // 14: dinkurapi.v1.Statuses.GetStatus:output_type -> dinkurapi.v1.GetStatusResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_api_dinkurapi_v1_statuses_proto_init() }
@@ -0,0 +1,79 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var backupCmd = &cobra.Command{
+		Use:   "backup [file]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Save a snapshot of the database to a file",
+		Long: fmt.Sprintf(`Saves a snapshot of all entries, projects, and statuses to a file, or to STDOUT
+if no file or "-" is given.
+
+The snapshot is an Sqlite3 database file, and can safely be taken while the
+Dinkur daemon is running. When using "--client grpc", the snapshot is pulled
+from the daemon, allowing you to back up a remote Dinkur daemon.
+
+	%[1]s backup dinkur-backup.db
+	%[1]s --client grpc backup > dinkur-backup.db
+
+Use the "restore" command to restore the snapshot.
+`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			if len(args) == 0 || args[0] == "-" {
+				if err := c.Backup(rootCtx, os.Stdout); err != nil {
+					console.PrintFatal("Error creating backup:", err)
+				}
+				return
+			}
+			fileName := args[0]
+			file, err := os.OpenFile(fileName, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+			if err != nil {
+				console.PrintFatal("Error creating file:", err)
+			}
+			if err := backupToFile(file); err != nil {
+				os.Remove(fileName)
+				console.PrintFatal("Error creating backup:", err)
+			}
+			console.PrintBackupLabel("Saved backup:", fileName)
+		},
+	}
+
+	RootCmd.AddCommand(backupCmd)
+}
+
+func backupToFile(file io.WriteCloser) error {
+	if err := c.Backup(rootCtx, file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
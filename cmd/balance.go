@@ -0,0 +1,295 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/config"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	var (
+		flagStart  = &pflagutil.Time{}
+		flagEnd    = &pflagutil.Time{}
+		flagPer    = "week"
+		flagOutput = "pretty"
+	)
+
+	var balanceCmd = &cobra.Command{
+		Use:     "balance",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"flex", "bal"},
+		Short:   "Show your overtime and flex time balance",
+		Long: fmt.Sprintf(`Shows the running flex time balance, being the time you have tracked minus
+the time you were expected to work, per week or per month.
+
+You are expected to work the workHours.dailyTarget config on each of the
+workHours.days, except on the dates in the workHours.holidays and
+workHours.vacationDays configs. The balance is accumulated from the date set in
+the workHours.balanceStart config, or from the --start flag, up until and
+including today, or the date of the --end flag.
+
+	%[1]s balance
+	%[1]s balance --per month
+	%[1]s balance --start 2024-01-01 --output json
+
+Today's expected hours are counted in full, so the balance will be negative
+until you have worked the full day. Active entries are counted up until now.
+`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			per, ok := balancePeriods[strings.ToLower(flagPer)]
+			if !ok {
+				console.PrintFatal("Error parsing --per:", fmt.Errorf(`invalid period: %q, must be "week" or "month"`, flagPer))
+			}
+			now := time.Now()
+			var start time.Time
+			switch {
+			case flagStart.TimePtr(now) != nil:
+				start = startOfDay(flagStart.Time(now))
+			case !cfg.WorkHours.BalanceStart.IsZero():
+				start = cfg.WorkHours.BalanceStart.Time(time.Local)
+			default:
+				console.PrintFatal("Error parsing flags:", errors.New("no start date, use the --start flag or set the workHours.balanceStart config"))
+			}
+			end := startOfDay(now).AddDate(0, 0, 1)
+			if flagEnd.TimePtr(now) != nil {
+				end = startOfDay(flagEnd.Time(now)).AddDate(0, 0, 1)
+			}
+			if !end.After(start) {
+				console.PrintFatal("Error parsing flags:", errors.New("the end date must not be before the start date"))
+			}
+			connectClientOrExit()
+			entries, err := c.GetEntryList(rootCtx, dinkur.SearchEntry{
+				Start: &start,
+				End:   &end,
+			})
+			if err != nil {
+				console.PrintFatal("Error getting list of entries:", err)
+			}
+			rows := newBalanceRows(per, cfg.WorkHours, entries, start, end, now)
+			total := sumBalanceRows(rows)
+			switch strings.ToLower(flagOutput) {
+			case "pretty":
+				console.PrintBalance(per.header, rows, total)
+			case "json":
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(newBalance(flagPer, rows, total)); err != nil {
+					console.PrintFatal("Error encoding balance as JSON:", err)
+				}
+			case "yaml":
+				enc := yaml.NewEncoder(os.Stdout)
+				enc.SetIndent(2)
+				if err := enc.Encode(newBalance(flagPer, rows, total)); err != nil {
+					console.PrintFatal("Error encoding balance as YAML:", err)
+				}
+			default:
+				console.PrintFatal("Error parsing --output:", fmt.Errorf("invalid output format: %q", flagOutput))
+			}
+		},
+	}
+
+	RootCmd.AddCommand(balanceCmd)
+
+	balanceCmd.Flags().VarP(flagStart, "start", "s", "accumulate balance from this date, instead of the workHours.balanceStart config")
+	balanceCmd.Flags().VarP(flagEnd, "end", "e", "accumulate balance until and including this date, instead of today")
+	balanceCmd.Flags().StringVarP(&flagPer, "per", "P", flagPer, `show balance per: "week", "month"`)
+	balanceCmd.RegisterFlagCompletionFunc("per", balancePerComplete)
+	balanceCmd.Flags().StringVarP(&flagOutput, "output", "o", flagOutput, `set output format: "pretty", "json", "yaml"`)
+}
+
+func balancePerComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return []string{
+		"week\tshow balance per ISO week (default)",
+		"month\tshow balance per month",
+	}, cobra.ShellCompDirectiveDefault
+}
+
+type balancePeriod struct {
+	header string
+	// next returns the start of the period following the one t is in.
+	next  func(t time.Time) time.Time
+	label func(t time.Time) string
+}
+
+var balancePeriods = map[string]balancePeriod{
+	"week": {
+		header: "WEEK",
+		next: func(t time.Time) time.Time {
+			return startOfDay(*timeutil.Week(t).End).AddDate(0, 0, 1)
+		},
+		label: func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		},
+	},
+	"month": {
+		header: "MONTH",
+		next: func(t time.Time) time.Time {
+			return time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		},
+		label: func(t time.Time) string {
+			return t.Format("2006-01")
+		},
+	},
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// newBalanceRows splits the time between start and end into periods, and
+// compares the tracked time of each period with the expected working time of
+// its days.
+func newBalanceRows(per balancePeriod, workHours config.WorkHours, entries []dinkur.Entry, start, end, now time.Time) []console.BalanceRow {
+	var rows []console.BalanceRow
+	var balance time.Duration
+	for periodStart := start; periodStart.Before(end); {
+		periodEnd := per.next(periodStart)
+		if periodEnd.After(end) {
+			periodEnd = end
+		}
+		row := console.BalanceRow{
+			Key:   per.label(periodStart),
+			Start: periodStart,
+			End:   periodEnd,
+		}
+		for day := periodStart; day.Before(periodEnd); day = day.AddDate(0, 0, 1) {
+			switch {
+			case workHours.IsHoliday(day):
+				row.Holidays++
+			case workHours.IsVacationDay(day):
+				row.VacationDays++
+			case workHours.IsWorkDay(day):
+				row.WorkDays++
+			}
+			row.Expected += workHours.ExpectedOn(day)
+		}
+		for _, entry := range entries {
+			row.Tracked += entryElapsedBetween(entry, periodStart, periodEnd, now)
+		}
+		balance += row.Tracked - row.Expected
+		row.Balance = balance
+		rows = append(rows, row)
+		periodStart = periodEnd
+	}
+	return rows
+}
+
+// entryElapsedBetween is the same as entryElapsedUntil, but only counts the
+// time of the entry that is within the start and end times.
+func entryElapsedBetween(entry dinkur.Entry, start, end, now time.Time) time.Duration {
+	entryStart := entry.Start
+	entryEnd := now
+	if entry.End != nil {
+		entryEnd = *entry.End
+	}
+	if entryStart.Before(start) {
+		entryStart = start
+	}
+	if entryEnd.After(end) {
+		entryEnd = end
+	}
+	if !entryEnd.After(entryStart) {
+		return 0
+	}
+	return entryEnd.Sub(entryStart)
+}
+
+func sumBalanceRows(rows []console.BalanceRow) console.BalanceRow {
+	var total console.BalanceRow
+	for _, row := range rows {
+		total.WorkDays += row.WorkDays
+		total.Holidays += row.Holidays
+		total.VacationDays += row.VacationDays
+		total.Expected += row.Expected
+		total.Tracked += row.Tracked
+	}
+	if len(rows) > 0 {
+		total.Start = rows[0].Start
+		total.End = rows[len(rows)-1].End
+		total.Balance = rows[len(rows)-1].Balance
+	}
+	return total
+}
+
+type balance struct {
+	Per   string       `json:"per" yaml:"per"`
+	Rows  []balanceRow `json:"rows" yaml:"rows"`
+	Total balanceRow   `json:"total" yaml:"total"`
+}
+
+type balanceRow struct {
+	Key             string    `json:"key,omitempty" yaml:"key,omitempty"`
+	Start           time.Time `json:"start" yaml:"start"`
+	End             time.Time `json:"end" yaml:"end"`
+	WorkDays        int       `json:"workDays" yaml:"workDays"`
+	Holidays        int       `json:"holidays" yaml:"holidays"`
+	VacationDays    int       `json:"vacationDays" yaml:"vacationDays"`
+	ExpectedSeconds int64     `json:"expectedSeconds" yaml:"expectedSeconds"`
+	ExpectedHours   float64   `json:"expectedHours" yaml:"expectedHours"`
+	TrackedSeconds  int64     `json:"trackedSeconds" yaml:"trackedSeconds"`
+	TrackedHours    float64   `json:"trackedHours" yaml:"trackedHours"`
+	BalanceSeconds  int64     `json:"balanceSeconds" yaml:"balanceSeconds"`
+	BalanceHours    float64   `json:"balanceHours" yaml:"balanceHours"`
+}
+
+func newBalance(per string, rows []console.BalanceRow, total console.BalanceRow) balance {
+	b := balance{
+		Per:   strings.ToLower(per),
+		Rows:  make([]balanceRow, len(rows)),
+		Total: newBalanceRow(total),
+	}
+	for i, row := range rows {
+		b.Rows[i] = newBalanceRow(row)
+	}
+	return b
+}
+
+func newBalanceRow(row console.BalanceRow) balanceRow {
+	return balanceRow{
+		Key:             row.Key,
+		Start:           row.Start,
+		End:             row.End,
+		WorkDays:        row.WorkDays,
+		Holidays:        row.Holidays,
+		VacationDays:    row.VacationDays,
+		ExpectedSeconds: int64(row.Expected.Seconds()),
+		ExpectedHours:   math.Round(row.Expected.Hours()*100) / 100,
+		TrackedSeconds:  int64(row.Tracked.Seconds()),
+		TrackedHours:    math.Round(row.Tracked.Hours()*100) / 100,
+		BalanceSeconds:  int64(row.Balance.Seconds()),
+		BalanceHours:    math.Round(row.Balance.Hours()*100) / 100,
+	}
+}
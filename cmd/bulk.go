@@ -0,0 +1,144 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/spf13/cobra"
+)
+
+// bulkCmd represents the bulk command
+var bulkCmd = &cobra.Command{
+	Use:   "bulk",
+	Args:  cobra.NoArgs,
+	Short: "Change multiple entries at once",
+	Long: fmt.Sprintf(`Changes or removes all entries matching a search at once.
+
+Entries are searched the same way as with the "list" command, using the
+--range, --start, --end, --tag, and --project flags, while the --search flag
+is used for name search terms. By default, only today's entries are changed.
+
+	%[1]s bulk rename -r week --search standup "Daily standup"
+	%[1]s bulk replace -r lastweek acme akme
+	%[1]s bulk shift -r yesterday --tag meeting -- -30m
+	%[1]s bulk remove -r all --tag draft
+
+A preview of the changes is shown and must be confirmed before anything is
+changed, unless the --yes flag is set. All changes are applied together, where
+either all or none of the entries are changed, and can be reverted using the
+"undo" command.
+`, RootCmd.Name()),
+}
+
+func init() {
+	RootCmd.AddCommand(bulkCmd)
+}
+
+// bulkFlags holds the flags shared by all bulk commands.
+type bulkFlags struct {
+	start     *pflagutil.Time
+	end       *pflagutil.Time
+	timeRange *pflagutil.TimeRange
+	search    string
+	tags      *pflagutil.Tags
+	project   string
+	yes       bool
+}
+
+func newBulkFlags(cmd *cobra.Command) *bulkFlags {
+	f := &bulkFlags{
+		start:     &pflagutil.Time{},
+		end:       &pflagutil.Time{},
+		timeRange: pflagutil.NewTimeRangePtr(timeutil.TimeSpanThisDay),
+		tags:      &pflagutil.Tags{},
+	}
+	cmd.Flags().VarP(f.start, "start", "s", "only change entries starting after or at date time")
+	cmd.Flags().VarP(f.end, "end", "e", "only change entries ending before or at date time")
+	cmd.Flags().VarP(f.timeRange, "range", "r", "baseline time range")
+	cmd.RegisterFlagCompletionFunc("range", pflagutil.TimeRangeCompletion)
+	cmd.Flags().StringVarP(&f.search, "search", "q", "", "only change entries with names matching search terms")
+	cmd.Flags().VarP(f.tags, "tag", "t", `only change entries with tag, or without tag if prefixed with a dash; can be repeated or comma-separated`)
+	cmd.Flags().StringVarP(&f.project, "project", "p", "", `only change entries of project, by ID, name, or "client/name"`)
+	cmd.RegisterFlagCompletionFunc("project", projectRefComplete)
+	cmd.Flags().BoolVarP(&f.yes, "yes", "y", false, "skip preview and confirmation prompt")
+	return f
+}
+
+func (f *bulkFlags) searchEntry() dinkur.SearchEntry {
+	now := time.Now()
+	return dinkur.SearchEntry{
+		Start:           f.start.TimePtr(now),
+		End:             f.end.TimePtr(now),
+		Shorthand:       f.timeRange.TimeSpanShorthand(),
+		NameFuzzy:       f.search,
+		Tags:            f.tags.Include(),
+		ExcludeTags:     f.tags.Exclude(),
+		ProjectIDOrZero: projectIDFromRefOrExit(f.project),
+	}
+}
+
+func runBulkEdit(f *bulkFlags, edit dinkur.BulkEditEntry) {
+	connectClientOrExit()
+	edit.Search = f.searchEntry()
+	if !f.yes {
+		edit.DryRun = true
+		preview, err := c.UpdateEntries(rootCtx, edit)
+		if err != nil {
+			console.PrintFatal("Error previewing entry changes:", err)
+		}
+		if len(preview) == 0 {
+			fmt.Println("No entries to update.")
+			return
+		}
+		console.PrintEntryEditPreview(preview)
+		fmt.Println()
+		if err := console.PromptBulkChange("update", len(preview)); err != nil {
+			console.PrintFatal("Prompt error:", err)
+		}
+		edit.DryRun = false
+	}
+	updates, err := c.UpdateEntries(rootCtx, edit)
+	if err != nil {
+		console.PrintFatal("Error updating entries:", err)
+	}
+	if f.yes {
+		for _, update := range updates {
+			console.PrintEntryEdit(update)
+		}
+	}
+	fmt.Printf("Updated %d entries.\n", len(updates))
+	printBulkUndoHint(len(updates))
+}
+
+func printBulkUndoHint(count int) {
+	if count == 0 {
+		return
+	}
+	fmt.Println()
+	fmt.Println("If this was a mistake, you can revert all of it with:")
+	fmt.Printf("  $ %s undo\n", RootCmd.Name())
+}
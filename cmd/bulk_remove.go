@@ -0,0 +1,78 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var flags *bulkFlags
+
+	var bulkRemoveCmd = &cobra.Command{
+		Use:     "remove",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"rm", "r"},
+		Short:   "Remove multiple entries",
+		Long: `Removes multiple entries by moving them to the trash, from where they can be
+restored using the "undo" or "trash restore" commands.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			del := dinkur.BulkDeleteEntry{
+				Search: flags.searchEntry(),
+			}
+			if !flags.yes {
+				del.DryRun = true
+				preview, err := c.DeleteEntries(rootCtx, del)
+				if err != nil {
+					console.PrintFatal("Error previewing entry removals:", err)
+				}
+				if len(preview) == 0 {
+					fmt.Println("No entries to remove.")
+					return
+				}
+				console.PrintEntryList(preview)
+				fmt.Println()
+				if err := console.PromptBulkChange("remove", len(preview)); err != nil {
+					console.PrintFatal("Prompt error:", err)
+				}
+				del.DryRun = false
+			}
+			removedEntries, err := c.DeleteEntries(rootCtx, del)
+			if err != nil {
+				console.PrintFatal("Error removing entries:", err)
+			}
+			if flags.yes {
+				console.PrintEntryList(removedEntries)
+			}
+			fmt.Printf("Removed %d entries.\n", len(removedEntries))
+			printBulkUndoHint(len(removedEntries))
+		},
+	}
+
+	bulkCmd.AddCommand(bulkRemoveCmd)
+
+	flags = newBulkFlags(bulkRemoveCmd)
+}
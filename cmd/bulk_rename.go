@@ -0,0 +1,48 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var flags *bulkFlags
+
+	var bulkRenameCmd = &cobra.Command{
+		Use:   "rename <new name of entries>",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Rename multiple entries",
+		Run: func(cmd *cobra.Command, args []string) {
+			name := strings.Join(args, " ")
+			runBulkEdit(flags, dinkur.BulkEditEntry{
+				Name: &name,
+			})
+		},
+	}
+
+	bulkCmd.AddCommand(bulkRenameCmd)
+
+	flags = newBulkFlags(bulkRenameCmd)
+}
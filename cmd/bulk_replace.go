@@ -0,0 +1,49 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var flags *bulkFlags
+
+	var bulkReplaceCmd = &cobra.Command{
+		Use:   "replace <old text> <new text>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Find and replace text in the names of multiple entries",
+		Long: `Replaces all occurrences of the old text with the new text in the names of
+multiple entries. The search is case sensitive. Entries whose names does not
+contain the old text are left as-is.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			runBulkEdit(flags, dinkur.BulkEditEntry{
+				ReplaceNameOld: args[0],
+				ReplaceNameNew: args[1],
+			})
+		},
+	}
+
+	bulkCmd.AddCommand(bulkReplaceCmd)
+
+	flags = newBulkFlags(bulkReplaceCmd)
+}
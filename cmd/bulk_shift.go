@@ -0,0 +1,69 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var flags *bulkFlags
+
+	var bulkShiftCmd = &cobra.Command{
+		Use:   "shift <duration>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Move multiple entries back or forth in time",
+		Long: fmt.Sprintf(`Adds a duration to both the start and end times of multiple entries.
+Durations are written the same way as "1h30m", with the additional units "d"
+for days and "w" for weeks. Prefix the duration with a dash to move entries
+back in time, which requires separating it from the flags using "--":
+
+	%[1]s bulk shift -r yesterday 15m     # 15 minutes later
+	%[1]s bulk shift -r yesterday -- -1h  # 1 hour earlier
+
+Other entries that the shifted entries then overlap are changed according to
+the "entries.overlapPolicy" config, the same way as when editing a single entry.
+`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			s, negative := strings.CutPrefix(args[0], "-")
+			shift, err := pflagutil.ParseDuration(strings.TrimPrefix(s, "+"))
+			if err != nil {
+				console.PrintFatal("Error parsing duration:", err)
+			}
+			if negative {
+				shift = -shift
+			}
+			runBulkEdit(flags, dinkur.BulkEditEntry{
+				Shift: shift,
+			})
+		},
+	}
+
+	bulkCmd.AddCommand(bulkShiftCmd)
+
+	flags = newBulkFlags(bulkShiftCmd)
+}
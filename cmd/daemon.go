@@ -22,13 +22,18 @@ package cmd
 
 import (
 	"context"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/afkdetect"
+	"github.com/dinkur/dinkur/pkg/config"
 	"github.com/dinkur/dinkur/pkg/dinkurd"
 	"github.com/spf13/cobra"
+	"gopkg.in/typ.v4/slices"
 )
 
 // daemonCmd represents the daemon command
@@ -41,14 +46,74 @@ This daemon is used by Dinkur clients, and allows more features such as the
 "away detection", which is not available when only using the Dinkur CLI.
 
 Information about the daemon, such as which port was selected and what
-authentication token can be used, is outputted to the console.`,
+authentication token can be used, is outputted to the console.
+
+All gRPC requests must be authenticated with a bearer token. A random token is
+generated on the first start of the daemon and written to the file set by the
+"daemon.tokenFile" config. Clients on the same machine read the token from the
+same file by default, while remote clients can set the "grpc.token" or
+"grpc.tokenFile" configs.
+
+The gRPC API is served over TLS when the "daemon.tlsCertFile" and
+"daemon.tlsKeyFile" configs are set. With "daemon.tlsSelfSigned" enabled, a
+self-signed certificate is generated into those files on the first start.
+Clients then need "grpc.tls" enabled, as well as either "grpc.tlsCaFile" set
+to the daemon's certificate file or "grpc.tlsTrustOnFirstUse" enabled to pin
+the daemon's certificate on the first connection.
+
+With "daemon.materializeRecurring" enabled, entries from recurring templates
+are added when their scheduled start time is reached. See "dinkur template".
+
+The "away detection" uses the AFK-detectors set by the "afk.detectors" config.
+Available detectors are "gnome", "logind", "screensaver", "idle-command",
+"idle-file", and "windows", where the "idle-command" and "idle-file" detectors
+get the idle time from the "afk.idleCommand" command or the "afk.idleFile"
+file. Detectors can also be turned off individually using the "afk.enabled"
+configs. You are considered AFK after being idle for the duration set by the
+"afk.threshold" config. When multiple detectors are used, you are considered
+AFK as soon as any of them says so, and are only considered to have returned
+once all of them agree that you are no longer AFK.
+
+With "afk.autoStopAfter" set, the active entry is stopped at the time you went
+AFK once you have been AFK for that long. With "afk.autoRestart" enabled, a new
+entry with the same name, note, tags, and project is then started when you
+return. Otherwise you are asked how to resolve the time you were away, the same
+way as when the entry had not been stopped.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		dbClient, err := connectToDBClient(false)
 		if err != nil {
 			console.PrintFatal("Error connecting to database for daemon:", err)
 		}
+		token, err := dinkurd.ReadOrCreateTokenFile(cfg.Daemon.TokenFile)
+		if err != nil {
+			console.PrintFatal("Error reading daemon token:", err)
+		}
+		if cfg.Daemon.TLSSelfSigned {
+			createSelfSignedCertOrExit()
+		}
 		opt := dinkurd.DefaultOptions
 		opt.BindAddress = cfg.Daemon.BindAddress
+		opt.Token = token
+		opt.TLSCertFile = cfg.Daemon.TLSCertFile
+		opt.TLSKeyFile = cfg.Daemon.TLSKeyFile
+		opt.MaterializeRecurring = cfg.Daemon.MaterializeRecurring
+		opt.AutoStopAfterAFK = time.Duration(cfg.AFK.AutoStopAfter)
+		opt.AutoRestartAfterAFK = cfg.AFK.AutoRestart
+		opt.AFK = afkdetect.Options{
+			Detectors: slices.Map(cfg.AFK.Detectors, config.AFKDetector.String),
+			Enabled: map[string]bool{
+				afkdetect.DetectorGNOME:       cfg.AFK.Enabled.Gnome,
+				afkdetect.DetectorLogind:      cfg.AFK.Enabled.Logind,
+				afkdetect.DetectorScreenSaver: cfg.AFK.Enabled.ScreenSaver,
+				afkdetect.DetectorIdleCommand: cfg.AFK.Enabled.IdleCommand,
+				afkdetect.DetectorIdleFile:    cfg.AFK.Enabled.IdleFile,
+				afkdetect.DetectorWindows:     cfg.AFK.Enabled.Windows,
+			},
+			PollInterval: time.Duration(cfg.AFK.PollInterval),
+			Threshold:    time.Duration(cfg.AFK.Threshold),
+			IdleCommand:  cfg.AFK.IdleCommand,
+			IdleFile:     cfg.AFK.IdleFile,
+		}
 		d := dinkurd.NewDaemon(dbClient, opt)
 		defer d.Close()
 		if err := d.Serve(contextWithOSInterrupt(rootCtx)); err != nil {
@@ -61,8 +126,23 @@ func init() {
 	RootCmd.AddCommand(daemonCmd)
 }
 
+func createSelfSignedCertOrExit() {
+	if cfg.Daemon.TLSCertFile == "" || cfg.Daemon.TLSKeyFile == "" {
+		console.PrintFatal("Error creating self-signed certificate:",
+			"both daemon.tlsCertFile and daemon.tlsKeyFile must be set")
+	}
+	var hosts []string
+	if host, _, err := net.SplitHostPort(cfg.Daemon.BindAddress); err == nil {
+		hosts = append(hosts, host)
+	}
+	_, err := dinkurd.CreateSelfSignedCertFiles(cfg.Daemon.TLSCertFile, cfg.Daemon.TLSKeyFile, hosts...)
+	if err != nil {
+		console.PrintFatal("Error creating self-signed certificate:", err)
+	}
+}
+
 func contextWithOSInterrupt(ctx context.Context) context.Context {
-	c := make(chan os.Signal)
+	c := make(chan os.Signal, 1)
 	newCtx, done := context.WithCancel(ctx)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	go func() {
@@ -70,10 +150,9 @@ func contextWithOSInterrupt(ctx context.Context) context.Context {
 		case <-c:
 			log.Debug().Message("Detected Ctrl+C, attempting graceful hault.")
 			done()
-			close(c)
 		case <-ctx.Done():
-			close(c)
 		}
+		signal.Stop(c)
 	}()
 	return newCtx
 }
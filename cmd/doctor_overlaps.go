@@ -0,0 +1,131 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/config"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagStart  = &pflagutil.Time{}
+		flagEnd    = &pflagutil.Time{}
+		flagRange  = pflagutil.NewTimeRangePtr(timeutil.TimeSpanThisWeek)
+		flagFix    bool
+		flagPolicy = config.OverlapPolicy(dinkur.OverlapPolicyTrim)
+		flagYes    bool
+	)
+
+	var doctorOverlapsCmd = &cobra.Command{
+		Use:   "overlaps",
+		Args:  cobra.NoArgs,
+		Short: "List and fix entries that overlap in time",
+		Long: fmt.Sprintf(`Lists all pairs of entries whose time spans overlap, such as from entries
+added before the entries.overlapPolicy config was set, or while it was set to
+"allow". By default, this week's entries are checked. The --range, --start,
+and --end flags works the same as for the "list" command.
+
+With the --fix flag, the overlaps are resolved by letting the entry that
+started last take precedence. The entries it overlaps are trimmed to end when
+it starts, or to start when it ends, and are removed if they are entirely
+covered by it. With --policy split, an entry that covers the whole of another
+entry is instead split in two around it.
+
+	%[1]s doctor overlaps -r all
+	%[1]s doctor overlaps -r lastweek --fix
+	%[1]s doctor overlaps --fix --policy split
+
+All changes are applied together, and can be reverted using the "undo" command.
+`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			if flagFix {
+				policy := dinkur.OverlapPolicy(flagPolicy)
+				if policy != dinkur.OverlapPolicyTrim && policy != dinkur.OverlapPolicySplit {
+					console.PrintFatal("Error parsing --policy:", `must be either "trim" or "split" when fixing overlaps`)
+				}
+			}
+			connectClientOrExit()
+			now := time.Now()
+			search := dinkur.SearchEntry{
+				Start:     flagStart.TimePtr(now),
+				End:       flagEnd.TimePtr(now),
+				Shorthand: flagRange.TimeSpanShorthand(),
+			}
+			overlaps, err := c.GetEntryOverlaps(rootCtx, search)
+			if err != nil {
+				console.PrintFatal("Error getting overlapping entries:", err)
+			}
+			if len(overlaps) == 0 {
+				fmt.Println("No overlapping entries found.")
+				return
+			}
+			console.PrintEntryOverlapList(overlaps)
+			if !flagFix {
+				fmt.Println()
+				fmt.Println("To resolve the overlaps, run the same command with the --fix flag.")
+				return
+			}
+			if !flagYes {
+				fmt.Println()
+				if err := console.PromptEntryOverlapFix(len(overlaps)); err != nil {
+					console.PrintFatal("Prompt error:", err)
+				}
+			}
+			changes, err := c.ResolveEntryOverlaps(rootCtx, dinkur.ResolveEntryOverlaps{
+				Search: search,
+				Policy: dinkur.OverlapPolicy(flagPolicy),
+			})
+			if err != nil {
+				console.PrintFatal("Error resolving overlapping entries:", err)
+			}
+			printEntryChanges(changes)
+			fmt.Println()
+			fmt.Printf("Resolved %d overlaps with %d changes to entries.\n", len(overlaps), len(changes))
+			printBulkUndoHint(len(changes))
+		},
+	}
+
+	doctorCmd.AddCommand(doctorOverlapsCmd)
+
+	doctorOverlapsCmd.Flags().VarP(flagStart, "start", "s", "only check entries starting after or at date time")
+	doctorOverlapsCmd.Flags().VarP(flagEnd, "end", "e", "only check entries ending before or at date time")
+	doctorOverlapsCmd.Flags().VarP(flagRange, "range", "r", "baseline time range")
+	doctorOverlapsCmd.RegisterFlagCompletionFunc("range", pflagutil.TimeRangeCompletion)
+	doctorOverlapsCmd.Flags().BoolVar(&flagFix, "fix", false, "resolve the overlaps by trimming, splitting, or removing entries")
+	doctorOverlapsCmd.Flags().Var(&flagPolicy, "policy", `how to resolve overlaps when fixing: "trim" or "split"`)
+	doctorOverlapsCmd.RegisterFlagCompletionFunc("policy", overlapPolicyComplete)
+	doctorOverlapsCmd.Flags().BoolVarP(&flagYes, "yes", "y", false, "skip confirmation prompt when fixing")
+}
+
+func overlapPolicyComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return []string{
+		"trim\tshorten overlapped entries (default)",
+		"split\tsplit entries in two around entries they cover",
+	}, cobra.ShellCompDirectiveDefault
+}
@@ -22,6 +22,7 @@ package cmd
 
 import (
 	"strings"
+	"time"
 
 	"github.com/dinkur/dinkur/internal/console"
 	"github.com/dinkur/dinkur/internal/pflagutil"
@@ -31,13 +32,20 @@ import (
 
 func init() {
 	var (
-		flagID        uint
-		flagAppend    bool
-		flagStart     = &pflagutil.Time{}
-		flagEnd       = &pflagutil.Time{}
-		flagAfterID   uint
-		flagAfterLast bool
-		flagBeforeID  uint
+		flagID         uint
+		flagAppend     bool
+		flagStart      = &pflagutil.Time{}
+		flagEnd        = &pflagutil.Time{}
+		flagAfterID    uint
+		flagAfterLast  bool
+		flagBeforeID   uint
+		flagTags       = &pflagutil.Tags{}
+		flagProject    string
+		flagNoProject  bool
+		flagNote       string
+		flagEditNote   bool
+		flagAppendNote bool
+		flagNoNote     bool
 	)
 
 	var editCmd = &cobra.Command{
@@ -46,8 +54,32 @@ func init() {
 		Aliases: []string{"e"},
 		Short:   "Edit the latest or a specific entry",
 		Long: `Applies changes to the currently active entry, or the latest entry, or
-a specific entry using the --id or -i flag.`,
+a specific entry using the --id or -i flag.
+
+Tags are added using the --tag or -t flag, and are removed by prefixing the tag
+with a dash:
+
+	dinkur edit --tag meeting         # adds the "meeting" tag
+	dinkur edit --tag -meeting        # removes the "meeting" tag
+	dinkur edit --tag=proj-x,-draft   # adds "proj-x" and removes "draft"
+
+The project is changed using the --project or -p flag, and is removed using
+the --no-project flag.
+
+The note is changed using the --note or -n flag, or written in your $EDITOR
+using the --edit-note or -E flag, which is prefilled with the existing note:
+
+	dinkur edit --note "https://example.com/issue/123"
+	dinkur edit --append-note --note "Also fixed the tests."
+	dinkur edit -E --id 123   # edit note of entry #123 in your $EDITOR
+	dinkur edit --no-note     # removes the note`,
 		Run: func(cmd *cobra.Command, args []string) {
+			if flagNoProject && flagProject != "" {
+				console.PrintFatal("Error parsing flags:", "cannot use --project and --no-project together")
+			}
+			if flagNoNote && (flagNote != "" || flagEditNote || flagAppendNote) {
+				console.PrintFatal("Error parsing flags:", "cannot use --no-note together with --note, --edit-note, or --append-note")
+			}
 			connectClientOrExit()
 			log.Debug().
 				WithStringer("start", flagStart).
@@ -62,11 +94,35 @@ a specific entry using the --id or -i flag.`,
 				StartAfterIDOrZero: flagAfterID,
 				EndBeforeIDOrZero:  flagBeforeID,
 				StartAfterLast:     flagAfterLast,
+				Tags:               flagTags.Include(),
+				RemoveTags:         flagTags.Exclude(),
+				ProjectIDOrZero:    projectIDFromRefOrExit(flagProject),
+				RemoveProject:      flagNoProject,
 			}
 			if len(args) > 0 {
 				name := strings.Join(args, " ")
 				edit.Name = &name
 			}
+			switch {
+			case flagNoNote:
+				edit.Note = new(string)
+			case flagEditNote:
+				note := flagNote
+				if note == "" && !flagAppendNote {
+					entry := getEntryToEditOrExit(flagID)
+					edit.IDOrZero = entry.ID
+					note = entry.Note
+				}
+				note, err := console.PromptEntryNote(note)
+				if err != nil {
+					console.PrintFatal("Prompt error:", err)
+				}
+				edit.Note = &note
+				edit.AppendNote = flagAppendNote
+			case flagNote != "":
+				edit.Note = &flagNote
+				edit.AppendNote = flagAppendNote
+			}
 			update, err := c.UpdateEntry(rootCtx, edit)
 			if err != nil {
 				console.PrintFatal("Error editing entry:", err)
@@ -87,4 +143,41 @@ a specific entry using the --id or -i flag.`,
 	editCmd.Flags().BoolVarP(&flagAfterLast, "after-last", "L", false, `sets --start time to the end time of latest entry`)
 	editCmd.Flags().UintVarP(&flagBeforeID, "before-id", "b", 0, `sets --end time to the start time of entry with ID`)
 	editCmd.RegisterFlagCompletionFunc("before-id", entryIDComplete)
+	editCmd.Flags().VarP(flagTags, "tag", "t", `tag to add, or remove if prefixed with a dash; can be repeated or comma-separated`)
+	editCmd.Flags().StringVarP(&flagProject, "project", "p", "", `project of the entry, by ID, name, or "client/name"`)
+	editCmd.RegisterFlagCompletionFunc("project", projectRefComplete)
+	editCmd.Flags().BoolVar(&flagNoProject, "no-project", false, `make the entry no longer reference any project`)
+	editCmd.Flags().StringVarP(&flagNote, "note", "n", "", `longer description of the entry, such as a ticket link`)
+	editCmd.Flags().BoolVarP(&flagEditNote, "edit-note", "E", false, `write the note in your $EDITOR, prefilled with the existing note`)
+	editCmd.Flags().BoolVar(&flagAppendNote, "append-note", false, `add note to the end of the existing note on a new line, instead of replacing it`)
+	editCmd.Flags().BoolVar(&flagNoNote, "no-note", false, `remove the note of the entry`)
+}
+
+func getEntryToEditOrExit(idOrZero uint) dinkur.Entry {
+	if idOrZero != 0 {
+		entry, err := c.GetEntry(rootCtx, idOrZero)
+		if err != nil {
+			console.PrintFatal("Error getting entry:", err)
+		}
+		return entry
+	}
+	activeEntry, err := c.GetActiveEntry(rootCtx)
+	if err != nil {
+		console.PrintFatal("Error getting active entry:", err)
+	}
+	if activeEntry != nil {
+		return *activeEntry
+	}
+	now := time.Now()
+	entries, err := c.GetEntryList(rootCtx, dinkur.SearchEntry{
+		Limit: 1,
+		End:   &now,
+	})
+	if err != nil {
+		console.PrintFatal("Error getting latest entry:", err)
+	}
+	if len(entries) == 0 {
+		console.PrintFatal("Error getting latest entry:", dinkur.ErrNotFound)
+	}
+	return entries[0]
 }
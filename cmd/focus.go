@@ -0,0 +1,63 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/config"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+// focusCmd represents the focus command
+var focusCmd = &cobra.Command{
+	Use:     "focus",
+	Args:    cobra.NoArgs,
+	Aliases: []string{"pomodoro"},
+	Short:   "Run a focus timer of work intervals and breaks",
+	Long: fmt.Sprintf(`Run a focus timer, also known as the Pomodoro technique.
+
+The focus timer starts a work entry, stops it when the work interval is over,
+and then starts a new work entry after a break, until all cycles are done:
+
+	%[1]s focus start --work 25m --break 5m --cycles 4 "Write report"
+
+The timer is run by the Dinkur daemon, so this command requires --client to be
+set to "grpc", and a daemon to be running. See "%[1]s daemon --help".`, RootCmd.Name()),
+}
+
+func init() {
+	RootCmd.AddCommand(focusCmd)
+}
+
+func connectFocuserOrExit() dinkur.Focuser {
+	if cfg.Client != config.ClientTypeGRPC {
+		console.PrintFatal("Error connecting to focus timer:", `--client must be set to "grpc", as the focus timer is run by the daemon`)
+	}
+	connectClientOrExit()
+	focuser, ok := c.(dinkur.Focuser)
+	if !ok {
+		console.PrintFatal("Error connecting to focus timer:", "client does not support focus timers")
+	}
+	return focuser
+}
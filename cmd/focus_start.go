@@ -0,0 +1,86 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagWork      = pflagutil.NewDuration(25 * time.Minute)
+		flagBreak     = pflagutil.NewDuration(5 * time.Minute)
+		flagCycles    uint
+		flagLogBreaks bool
+		flagBreakName string
+		flagTags      = &pflagutil.Tags{}
+		flagProject   string
+	)
+
+	var focusStartCmd = &cobra.Command{
+		Use:     "start <entry name>",
+		Args:    cobra.MinimumNArgs(1),
+		Aliases: []string{"in", "s"},
+		Short:   "Start a new focus timer",
+		Long: `Starts a new focus timer, and its first work entry. The currently active
+entry is stopped, if any.
+
+Each work entry is stopped when its work interval is over. No entry is active
+during the breaks, unless --log-breaks is set.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(flagTags.Exclude()) > 0 {
+				console.PrintFatal("Error parsing --tag:", "cannot exclude tags when starting a focus timer")
+			}
+			focuser := connectFocuserOrExit()
+			focus, err := focuser.StartFocus(rootCtx, dinkur.NewFocus{
+				Name:            strings.Join(args, " "),
+				Tags:            flagTags.Include(),
+				ProjectIDOrZero: projectIDFromRefOrExit(flagProject),
+				Work:            flagWork.Duration(),
+				Break:           flagBreak.Duration(),
+				Cycles:          flagCycles,
+				LogBreaks:       flagLogBreaks,
+				BreakName:       flagBreakName,
+			})
+			if err != nil {
+				console.PrintFatal("Error starting focus timer:", err)
+			}
+			console.PrintFocusLabel("Started focus:", focus)
+		},
+	}
+
+	focusCmd.AddCommand(focusStartCmd)
+
+	focusStartCmd.Flags().VarP(flagWork, "work", "w", `duration of each work interval`)
+	focusStartCmd.Flags().VarP(flagBreak, "break", "b", `duration of each break between work intervals`)
+	focusStartCmd.Flags().UintVarP(&flagCycles, "cycles", "c", 4, `number of work intervals`)
+	focusStartCmd.Flags().BoolVarP(&flagLogBreaks, "log-breaks", "L", false, `add an entry for each break`)
+	focusStartCmd.Flags().StringVar(&flagBreakName, "break-name", "Break", `name of the break entries; only used with --log-breaks`)
+	focusStartCmd.Flags().VarP(flagTags, "tag", "t", `tag to attach to the work entries; can be repeated or comma-separated`)
+	focusStartCmd.Flags().StringVarP(&flagProject, "project", "p", "", `project of the work entries, by ID, name, or "client/name"`)
+	focusStartCmd.RegisterFlagCompletionFunc("project", projectRefComplete)
+}
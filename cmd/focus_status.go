@@ -0,0 +1,69 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var flagWatch bool
+
+	var focusStatusCmd = &cobra.Command{
+		Use:     "status",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"st"},
+		Short:   "Show the state of the focus timer",
+		Long: `Shows the current phase of the focus timer, and the time remaining of it.
+
+With --watch, the state is printed again every time the focus timer changes
+phase, until interrupted.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			focuser := connectFocuserOrExit()
+			var focusChan <-chan dinkur.StreamedFocus
+			if flagWatch {
+				// subscribe before getting the state, to not miss any changes
+				var err error
+				focusChan, err = focuser.StreamFocus(rootCtx)
+				if err != nil {
+					console.PrintFatal("Error streaming focus timer:", err)
+				}
+			}
+			focus, err := focuser.GetFocus(rootCtx)
+			if err != nil {
+				console.PrintFatal("Error getting focus timer:", err)
+			}
+			console.PrintFocusLabel("Current focus:", focus)
+			if focusChan == nil {
+				return
+			}
+			for ev := range focusChan {
+				console.PrintFocusLabel("Current focus:", ev.Focus)
+			}
+		},
+	}
+
+	focusCmd.AddCommand(focusStatusCmd)
+
+	focusStatusCmd.Flags().BoolVarP(&flagWatch, "watch", "w", false, `keep printing the state as the focus timer changes phase`)
+}
@@ -0,0 +1,47 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var focusStopCmd = &cobra.Command{
+		Use:     "stop",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"out", "o"},
+		Short:   "Stop the running focus timer",
+		Long: `Stops the running focus timer, as well as its active work or break entry.
+Entries started by other means are left as-is.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			focuser := connectFocuserOrExit()
+			focus, err := focuser.StopFocus(rootCtx)
+			if err != nil {
+				console.PrintFatal("Error stopping focus timer:", err)
+			}
+			console.PrintFocusLabel("Stopped focus:", focus)
+		},
+	}
+
+	focusCmd.AddCommand(focusStopCmd)
+}
@@ -0,0 +1,168 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	var (
+		flagStart       = &pflagutil.Time{}
+		flagEnd         = &pflagutil.Time{}
+		flagRange       = pflagutil.NewTimeRangePtr(timeutil.TimeSpanThisDay)
+		flagMinDuration = pflagutil.NewDuration(time.Minute)
+		flagAllHours    bool
+		flagFill        bool
+		flagOutput      = "pretty"
+	)
+
+	var gapsCmd = &cobra.Command{
+		Use:   "gaps",
+		Args:  cobra.NoArgs,
+		Short: "List and fill unaccounted time between entries",
+		Long: fmt.Sprintf(`Lists the gaps of time between consecutive entries, such as time that was
+forgotten to be tracked. The time from the start of the range until the first
+entry, and from the last entry until the end of the range or until now, are
+also listed as gaps. By default, today's gaps are listed. The --range,
+--start, and --end flags works the same as for the "list" command.
+
+Only time within the working hours is considered, as configured by the
+workHours config, which defaults to 08:00-17:00 on Monday to Friday. Use the
+--all-hours flag to ignore the working hours.
+
+With the --fill flag, you are asked for each gap whether to assign it to the
+previous entry, to the next entry, to a new entry, or to skip it.
+
+	%[1]s gaps
+	%[1]s gaps -r week
+	%[1]s gaps -r yesterday --fill
+`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			now := time.Now()
+			search := dinkur.SearchEntryGaps{
+				Start:       flagStart.TimePtr(now),
+				End:         flagEnd.TimePtr(now),
+				Shorthand:   flagRange.TimeSpanShorthand(),
+				MinDuration: flagMinDuration.Duration(),
+			}
+			if !flagAllHours {
+				search.WorkHoursStart = time.Duration(cfg.WorkHours.Start)
+				search.WorkHoursEnd = time.Duration(cfg.WorkHours.End)
+				if search.WorkHoursEnd == 24*time.Hour {
+					search.WorkHoursEnd = 0
+				}
+				for _, day := range cfg.WorkHours.Days {
+					search.WorkDays = append(search.WorkDays, time.Weekday(day))
+				}
+			}
+			gaps, err := c.GetEntryGaps(rootCtx, search)
+			if err != nil {
+				console.PrintFatal("Error getting gaps between entries:", err)
+			}
+			if flagFill {
+				fillEntryGaps(gaps)
+				return
+			}
+			switch strings.ToLower(flagOutput) {
+			case "pretty":
+				console.PrintEntryGapList(gaps)
+			case "json":
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(gaps); err != nil {
+					console.PrintFatal("Error encoding gaps as JSON:", err)
+				}
+			case "yaml":
+				enc := yaml.NewEncoder(os.Stdout)
+				enc.SetIndent(2)
+				if err := enc.Encode(gaps); err != nil {
+					console.PrintFatal("Error encoding gaps as YAML:", err)
+				}
+			default:
+				console.PrintFatal("Error parsing --output:", fmt.Errorf("invalid output format: %q", flagOutput))
+			}
+		},
+	}
+
+	RootCmd.AddCommand(gapsCmd)
+
+	gapsCmd.Flags().VarP(flagStart, "start", "s", "only list gaps after or at date time")
+	gapsCmd.Flags().VarP(flagEnd, "end", "e", "only list gaps before or at date time")
+	gapsCmd.Flags().VarP(flagRange, "range", "r", "baseline time range")
+	gapsCmd.RegisterFlagCompletionFunc("range", pflagutil.TimeRangeCompletion)
+	gapsCmd.Flags().Var(flagMinDuration, "min-duration", "ignore gaps shorter than this duration")
+	gapsCmd.Flags().BoolVar(&flagAllHours, "all-hours", false, "include gaps outside the configured working hours")
+	gapsCmd.Flags().BoolVar(&flagFill, "fill", false, "interactively fill each gap")
+	gapsCmd.Flags().StringVarP(&flagOutput, "output", "o", flagOutput, `set output format: "pretty", "json", "yaml"`)
+}
+
+func fillEntryGaps(gaps []dinkur.EntryGap) {
+	if len(gaps) == 0 {
+		fmt.Println("No gaps between entries found.")
+		return
+	}
+	var filled int
+	for i, gap := range gaps {
+		if i > 0 {
+			fmt.Println()
+		}
+		res, err := console.PromptGapResolution(gap)
+		if err != nil {
+			console.PrintFatal("Prompt error:", err)
+		}
+		switch {
+		case res.Edit != nil:
+			update, err := c.UpdateEntry(rootCtx, *res.Edit)
+			if err != nil {
+				console.PrintFatal("Error editing entry:", err)
+			}
+			console.PrintEntryEdit(update)
+			filled++
+		case res.NewEntry != nil:
+			// CreateEntries is used instead of CreateEntry, as it does not
+			// stop the currently active entry when adding an ended entry
+			startedEntries, err := c.CreateEntries(rootCtx, []dinkur.NewEntry{*res.NewEntry})
+			if err != nil {
+				console.PrintFatal("Error adding entry:", err)
+			}
+			console.PrintEntryLabel(console.LabelledEntry{
+				Label: "Added entry:",
+				Entry: startedEntries[0].Started,
+			})
+			filled++
+		}
+	}
+	fmt.Println()
+	fmt.Printf("Filled %d of %d gaps.\n", filled, len(gaps))
+}
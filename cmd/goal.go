@@ -0,0 +1,93 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+// goalCmd represents the goal command
+var goalCmd = &cobra.Command{
+	Use:     "goal",
+	Args:    cobra.NoArgs,
+	Aliases: []string{"goals"},
+	Short:   "Manage daily and weekly goals",
+	Long: fmt.Sprintf(`Manage goals of how much time to track per day or per week.
+
+A goal either tracks all entries, or only the entries of a project:
+
+	%[1]s goal add 7.5h --per day
+	%[1]s goal add 32h --per week --project "Acme/Website"
+
+The progress towards today's and this week's goals is shown by
+"%[1]s goal progress", as well as by "%[1]s status".`, RootCmd.Name()),
+}
+
+func init() {
+	RootCmd.AddCommand(goalCmd)
+}
+
+func parseGoalPeriodOrExit(s string) dinkur.GoalPeriod {
+	switch strings.ToLower(s) {
+	case "day", "d", "daily":
+		return dinkur.GoalPeriodDay
+	case "week", "w", "weekly":
+		return dinkur.GoalPeriodWeek
+	default:
+		console.PrintFatal("Error parsing --per:", fmt.Errorf(`%w: %q, must be "day" or "week"`, dinkur.ErrGoalPeriodInvalid, s))
+		return 0
+	}
+}
+
+func parseGoalTargetOrExit(s string) time.Duration {
+	target, err := pflagutil.ParseDuration(s)
+	if err != nil {
+		console.PrintFatal("Error parsing target duration:", err)
+	}
+	return target
+}
+
+func goalIDComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	client, err := connectClient(true)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	goals, err := client.GetGoalList(rootCtx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	completions := make([]string, len(goals))
+	for i, goal := range goals {
+		desc := fmt.Sprintf("%s per %s", console.FormatDuration(goal.Target), goal.Period)
+		if goal.Project != nil {
+			desc += " on " + goal.Project.String()
+		}
+		completions[i] = fmt.Sprintf("%d\t%s", goal.ID, desc)
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,62 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagPer     = "day"
+		flagProject string
+	)
+
+	var goalAddCmd = &cobra.Command{
+		Use:     "add <target duration>",
+		Args:    cobra.ExactArgs(1),
+		Aliases: []string{"new", "a"},
+		Short:   "Add a new goal",
+		Long: `Adds a goal of how much time to track per day or per week, such as "7.5h"
+or "32h". Only one goal can be added per period and project.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			newGoal := dinkur.NewGoal{
+				Period: parseGoalPeriodOrExit(flagPer),
+				Target: parseGoalTargetOrExit(args[0]),
+			}
+			connectClientOrExit()
+			newGoal.ProjectIDOrZero = projectIDFromRefOrExit(flagProject)
+			goal, err := c.CreateGoal(rootCtx, newGoal)
+			if err != nil {
+				console.PrintFatal("Error adding goal:", err)
+			}
+			console.PrintGoalLabel("Added goal:", goal)
+		},
+	}
+
+	goalCmd.AddCommand(goalAddCmd)
+
+	goalAddCmd.Flags().StringVarP(&flagPer, "per", "P", flagPer, `period of the goal: "day" or "week"`)
+	goalAddCmd.Flags().StringVarP(&flagProject, "project", "p", "", `only track entries of this project, by ID, name, or "client/name"`)
+	goalAddCmd.RegisterFlagCompletionFunc("project", projectRefComplete)
+}
@@ -0,0 +1,78 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagID        uint
+		flagPer       string
+		flagProject   string
+		flagNoProject bool
+	)
+
+	var goalEditCmd = &cobra.Command{
+		Use:     "edit [new target duration]",
+		Args:    cobra.MaximumNArgs(1),
+		Aliases: []string{"e"},
+		Short:   "Edit a goal",
+		Long:    `Applies changes to a specific goal using the --id or -i flag.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if flagNoProject && flagProject != "" {
+				console.PrintFatal("Error parsing flags:", "cannot use --no-project together with --project")
+			}
+			edit := dinkur.EditGoal{
+				ID:            flagID,
+				RemoveProject: flagNoProject,
+			}
+			if len(args) > 0 {
+				target := parseGoalTargetOrExit(args[0])
+				edit.Target = &target
+			}
+			if flagPer != "" {
+				period := parseGoalPeriodOrExit(flagPer)
+				edit.Period = &period
+			}
+			connectClientOrExit()
+			edit.ProjectIDOrZero = projectIDFromRefOrExit(flagProject)
+			update, err := c.UpdateGoal(rootCtx, edit)
+			if err != nil {
+				console.PrintFatal("Error editing goal:", err)
+			}
+			console.PrintGoalEdit(update)
+		},
+	}
+
+	goalCmd.AddCommand(goalEditCmd)
+
+	goalEditCmd.Flags().UintVarP(&flagID, "id", "i", 0, "ID of goal to edit (required)")
+	goalEditCmd.MarkFlagRequired("id")
+	goalEditCmd.RegisterFlagCompletionFunc("id", goalIDComplete)
+	goalEditCmd.Flags().StringVarP(&flagPer, "per", "P", "", `new period of the goal: "day" or "week"`)
+	goalEditCmd.Flags().StringVarP(&flagProject, "project", "p", "", `only track entries of this project, by ID, name, or "client/name"`)
+	goalEditCmd.RegisterFlagCompletionFunc("project", projectRefComplete)
+	goalEditCmd.Flags().BoolVar(&flagNoProject, "no-project", false, `track all entries instead of only the entries of a project`)
+}
@@ -0,0 +1,74 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	var (
+		flagOutput = "pretty"
+	)
+
+	var goalListCmd = &cobra.Command{
+		Use:     "list",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls", "l"},
+		Short:   "List your goals",
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			goals, err := c.GetGoalList(rootCtx)
+			if err != nil {
+				console.PrintFatal("Error getting list of goals:", err)
+			}
+			switch strings.ToLower(flagOutput) {
+			case "pretty":
+				console.PrintGoalList(goals)
+			case "json":
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(goals); err != nil {
+					console.PrintFatal("Error encoding goals as JSON:", err)
+				}
+			case "yaml":
+				enc := yaml.NewEncoder(os.Stdout)
+				enc.SetIndent(2)
+				if err := enc.Encode(goals); err != nil {
+					console.PrintFatal("Error encoding goals as YAML:", err)
+				}
+			default:
+				console.PrintFatal("Error parsing --output:", fmt.Errorf("invalid output format: %q", flagOutput))
+			}
+		},
+	}
+
+	goalCmd.AddCommand(goalListCmd)
+
+	goalListCmd.Flags().StringVarP(&flagOutput, "output", "o", flagOutput, `set output format: "pretty", "json", "yaml"`)
+}
@@ -0,0 +1,83 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	var (
+		flagAt     = &pflagutil.Time{}
+		flagOutput = "pretty"
+	)
+
+	var goalProgressCmd = &cobra.Command{
+		Use:     "progress",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"p"},
+		Short:   "Show the progress towards your goals",
+		Long: `Shows how much time has been tracked towards each goal today and this
+week, or on the day and week of the --at date.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			progress, err := c.GetGoalProgress(rootCtx, dinkur.SearchGoalProgress{
+				At: flagAt.TimePtr(time.Now()),
+			})
+			if err != nil {
+				console.PrintFatal("Error getting goal progress:", err)
+			}
+			switch strings.ToLower(flagOutput) {
+			case "pretty":
+				console.PrintGoalProgress(progress)
+			case "json":
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(progress); err != nil {
+					console.PrintFatal("Error encoding goal progress as JSON:", err)
+				}
+			case "yaml":
+				enc := yaml.NewEncoder(os.Stdout)
+				enc.SetIndent(2)
+				if err := enc.Encode(progress); err != nil {
+					console.PrintFatal("Error encoding goal progress as YAML:", err)
+				}
+			default:
+				console.PrintFatal("Error parsing --output:", fmt.Errorf("invalid output format: %q", flagOutput))
+			}
+		},
+	}
+
+	goalCmd.AddCommand(goalProgressCmd)
+
+	goalProgressCmd.Flags().VarP(flagAt, "at", "a", `show the progress of the day and week of this date`)
+	goalProgressCmd.Flags().StringVarP(&flagOutput, "output", "o", flagOutput, `set output format: "pretty", "json", "yaml"`)
+}
@@ -0,0 +1,67 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagID  uint
+		flagYes bool
+	)
+
+	var goalRemoveCmd = &cobra.Command{
+		Use:     "remove",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"rm", "r"},
+		Short:   "Removes a goal",
+		Long: `Removes a goal from your entry data store.
+You must provide the flag --id to specify which goal to remove.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			if !flagYes {
+				goal, err := c.GetGoal(rootCtx, flagID)
+				if err != nil {
+					console.PrintFatal("Error getting goal:", err)
+				}
+				err = console.PromptGoalRemoval(goal)
+				if err != nil {
+					console.PrintFatal("Prompt error:", err)
+				}
+			}
+			removedGoal, err := c.DeleteGoal(rootCtx, flagID)
+			if err != nil {
+				console.PrintFatal("Error removing goal:", err)
+			}
+			console.PrintGoalLabel("Deleted goal:", removedGoal)
+		},
+	}
+
+	goalCmd.AddCommand(goalRemoveCmd)
+
+	goalRemoveCmd.Flags().UintVarP(&flagID, "id", "i", 0, "ID of goal to be removed (required)")
+	goalRemoveCmd.MarkFlagRequired("id")
+	goalRemoveCmd.RegisterFlagCompletionFunc("id", goalIDComplete)
+	goalRemoveCmd.Flags().BoolVarP(&flagYes, "yes", "y", false, "skip confirmation prompt")
+}
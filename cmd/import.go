@@ -0,0 +1,270 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/importer"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagFormat = string(importer.FormatAuto)
+		flagDryRun = false
+	)
+
+	var importCmd = &cobra.Command{
+		Use:   "import [file]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Import entries from a file",
+		Long: fmt.Sprintf(`Imports entries from a file, or from STDIN if no file or "-" is given.
+
+Supported formats are Dinkur's own "csv", "json", and "yaml" output formats
+from the "list" command, as well as Toggl Track's detailed report CSV export
+and Timewarrior's JSON export from "timew export". By default the format is
+detected from the file extension and content.
+
+	%[1]s list -r all -o csv-header > entries.csv
+	%[1]s import entries.csv
+	%[1]s import --format toggl-csv Toggl_time_entries.csv
+	timew export | %[1]s import --format timewarrior-json
+
+Entries that already exist with the same name, start, and end time are skipped,
+so importing the same file twice does not add any duplicates. Any projects
+referenced by the imported entries that does not already exist are created.
+The entries themselves are all imported in a single transaction, so either all
+or none of them are imported.
+
+Use the --dry-run flag to preview what would be imported without changing
+anything.
+`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			format, err := importer.ParseFormat(flagFormat)
+			if err != nil {
+				console.PrintFatal("Error parsing --format:", err)
+			}
+			var (
+				r        io.Reader = os.Stdin
+				fileName string
+			)
+			if len(args) > 0 && args[0] != "-" {
+				fileName = args[0]
+				file, err := os.Open(fileName)
+				if err != nil {
+					console.PrintFatal("Error opening file:", err)
+				}
+				defer file.Close()
+				r = file
+			}
+			entries, format, err := importer.Decode(r, fileName, format)
+			if err != nil {
+				console.PrintFatal(fmt.Sprintf("Error reading entries as %q:", format), err)
+			}
+			log.Debug().WithString("format", string(format)).WithInt("entries", len(entries)).Message("Read entries.")
+			sort.SliceStable(entries, func(i, j int) bool {
+				return entries[i].Start.Before(entries[j].Start)
+			})
+			connectClientOrExit()
+			entries, duplicates := splitImportDuplicates(entries)
+			missingProjects := missingImportProjects(entries)
+			if flagDryRun {
+				for _, project := range missingProjects {
+					console.PrintProjectLabel("Would add project:", project)
+				}
+				console.PrintEntryImport(entries, duplicates, true)
+				return
+			}
+			if len(entries) == 0 {
+				console.PrintEntryImport(nil, duplicates, false)
+				return
+			}
+			newEntries := make([]dinkur.NewEntry, len(entries))
+			for i, entry := range entries {
+				newEntries[i] = dinkur.NewEntry{
+					Name:  entry.Name,
+					Note:  entry.Note,
+					Tags:  entry.Tags,
+					Start: &entries[i].Start,
+					End:   entry.End,
+				}
+				if entry.Project != nil {
+					newEntries[i].Project = &dinkur.NewProject{
+						Name:   entry.Project.Name,
+						Client: entry.Project.Client,
+					}
+				}
+			}
+			startedEntries, err := c.CreateEntries(rootCtx, newEntries)
+			if err != nil {
+				console.PrintFatal("Error importing entries:", err)
+			}
+			imported := make([]dinkur.Entry, len(startedEntries))
+			for i, startedEntry := range startedEntries {
+				imported[i] = startedEntry.Started
+			}
+			printAddedImportProjects(missingProjects, imported)
+			console.PrintEntryImport(imported, duplicates, false)
+		},
+	}
+
+	RootCmd.AddCommand(importCmd)
+
+	importCmd.Flags().StringVarP(&flagFormat, "format", "f", flagFormat, `set input format: "auto", "csv", "json", "yaml", "toggl-csv", "timewarrior-json"`)
+	importCmd.RegisterFlagCompletionFunc("format", importFormatComplete)
+	importCmd.Flags().BoolVarP(&flagDryRun, "dry-run", "n", false, "only show what would be imported, without importing anything")
+}
+
+func importFormatComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return []string{
+		"auto\tdetect format from file extension and content (default)",
+		"csv\tDinkur's CSV format, with or without header row",
+		"json\tDinkur's JSON format, either as an array or one entry per line",
+		"yaml\tDinkur's YAML format",
+		"toggl-csv\tToggl Track's detailed report CSV export",
+		"timewarrior-json\tTimewarrior's JSON format from \"timew export\"",
+	}, cobra.ShellCompDirectiveDefault
+}
+
+type importDuplicateKey struct {
+	name  string
+	start int64
+	end   int64
+}
+
+// newImportDuplicateKey returns a key used to detect duplicate entries. The
+// timestamps are truncated to seconds, as that is the precision stored by
+// Sqlite.
+func newImportDuplicateKey(entry dinkur.Entry) importDuplicateKey {
+	key := importDuplicateKey{
+		name:  entry.Name,
+		start: entry.Start.Unix(),
+		end:   -1,
+	}
+	if entry.End != nil {
+		key.end = entry.End.Unix()
+	}
+	return key
+}
+
+// splitImportDuplicates separates the entries into the ones to import and the
+// ones that already exists, either in the database or earlier in the slice.
+func splitImportDuplicates(entries []dinkur.Entry) ([]dinkur.Entry, []dinkur.Entry) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	start := entries[0].Start
+	end := start
+	for _, entry := range entries {
+		entryEnd := time.Now()
+		if entry.End != nil {
+			entryEnd = *entry.End
+		}
+		if entryEnd.After(end) {
+			end = entryEnd
+		}
+	}
+	existing, err := c.GetEntryList(rootCtx, dinkur.SearchEntry{
+		Start: &start,
+		End:   &end,
+	})
+	if err != nil {
+		console.PrintFatal("Error getting list of existing entries:", err)
+	}
+	seen := make(map[importDuplicateKey]struct{}, len(existing)+len(entries))
+	for _, entry := range existing {
+		seen[newImportDuplicateKey(entry)] = struct{}{}
+	}
+	var unique, duplicates []dinkur.Entry
+	for _, entry := range entries {
+		key := newImportDuplicateKey(entry)
+		if _, ok := seen[key]; ok {
+			duplicates = append(duplicates, entry)
+			continue
+		}
+		seen[key] = struct{}{}
+		unique = append(unique, entry)
+	}
+	return unique, duplicates
+}
+
+func importProjectKey(project *dinkur.Project) string {
+	if project == nil {
+		return ""
+	}
+	return project.String()
+}
+
+// missingImportProjects returns the projects referenced by the entries that
+// does not already exist, and that will be created when importing the entries.
+func missingImportProjects(entries []dinkur.Entry) []dinkur.Project {
+	var missing []dinkur.Project
+	seen := map[string]struct{}{}
+	for _, entry := range entries {
+		key := importProjectKey(entry.Project)
+		if key == "" {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		projects, err := c.GetProjectList(rootCtx, dinkur.SearchProject{
+			Name:   entry.Project.Name,
+			Client: entry.Project.Client,
+		})
+		if err != nil {
+			console.PrintFatal("Error getting list of projects:", err)
+		}
+		exists := false
+		for _, p := range projects {
+			if p.Client == entry.Project.Client {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			missing = append(missing, *entry.Project)
+		}
+	}
+	return missing
+}
+
+// printAddedImportProjects prints the projects that were missing before the
+// import, using the imported entries to get the IDs of the created projects.
+func printAddedImportProjects(missing []dinkur.Project, imported []dinkur.Entry) {
+	for _, project := range missing {
+		key := importProjectKey(&project)
+		for _, entry := range imported {
+			if importProjectKey(entry.Project) == key {
+				console.PrintProjectLabel("Added project:", *entry.Project)
+				break
+			}
+		}
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/dinkur/dinkur/internal/pflagutil"
 	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/spf13/cobra"
+	"gopkg.in/typ.v4"
 )
 
 func init() {
@@ -38,6 +39,11 @@ func init() {
 		flagAfterID   uint
 		flagAfterLast bool
 		flagBeforeID  uint
+		flagTags      = &pflagutil.Tags{}
+		flagProject   string
+		flagNote      string
+		flagEditNote  bool
+		flagTemplate  string
 	)
 
 	var inCmd = &cobra.Command{
@@ -45,22 +51,60 @@ func init() {
 		Args:    cobra.ArbitraryArgs,
 		Aliases: []string{"i", "start", "new"},
 		Short:   "Check in/start tracking a new entry",
-		Long:    ``,
+		Long: `Starts tracking a new entry, and stops the currently active entry, if any.
+
+With --template, the entry name, tags, and duration are taken from the
+template. The entry name is only taken from the template if no name is given,
+and the tags are added to any tags set by --tag. The end time is set from the
+template's duration, unless the entry is made to end via --end or --before-id,
+or to start via --after-id or --after-last.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			if len(flagTags.Exclude()) > 0 {
+				console.PrintFatal("Error parsing --tag:", "cannot exclude tags when creating an entry")
+			}
 			connectClientOrExit()
 			newName := strings.Join(args, " ")
+			var template *dinkur.Template
+			if flagTemplate != "" {
+				t, err := findTemplateByRef(flagTemplate)
+				if err != nil {
+					console.PrintFatal("Error finding template:", err)
+				}
+				template = &t
+				if newName == "" {
+					newName = template.EntryName
+				}
+			}
 			if checkIfDuplicateEntry(newName) {
 				return
 			}
+			note := flagNote
+			if flagEditNote {
+				var err error
+				note, err = console.PromptEntryNote(note)
+				if err != nil {
+					console.PrintFatal("Prompt error:", err)
+				}
+			}
 			now := time.Now()
 			newEntry := dinkur.NewEntry{
 				Name:               newName,
+				Note:               note,
+				Tags:               flagTags.Include(),
+				ProjectIDOrZero:    projectIDFromRefOrExit(flagProject),
 				Start:              flagStart.TimePtr(now),
 				End:                flagEnd.TimePtr(now),
 				StartAfterIDOrZero: flagAfterID,
 				EndBeforeIDOrZero:  flagBeforeID,
 				StartAfterLast:     flagAfterLast,
 			}
+			if template != nil {
+				newEntry.Tags = append(newEntry.Tags, template.Tags...)
+				if template.Duration > 0 && newEntry.End == nil && flagBeforeID == 0 &&
+					flagAfterID == 0 && !flagAfterLast {
+					newEntry.End = typ.Ref(newEntry.Start.Add(template.Duration))
+				}
+			}
 			startedEntry, err := c.CreateEntry(rootCtx, newEntry)
 			if err != nil {
 				console.PrintFatal("Error starting entry:", err)
@@ -77,6 +121,13 @@ func init() {
 	inCmd.Flags().BoolVarP(&flagAfterLast, "after-last", "L", false, `sets --start time to the end time of latest entry`)
 	inCmd.Flags().UintVarP(&flagBeforeID, "before-id", "b", 0, `sets --end time to the start time of entry with ID`)
 	inCmd.RegisterFlagCompletionFunc("before-id", entryIDComplete)
+	inCmd.Flags().VarP(flagTags, "tag", "t", `tag to attach to the entry; can be repeated or comma-separated`)
+	inCmd.Flags().StringVarP(&flagProject, "project", "p", "", `project of the entry, by ID, name, or "client/name"`)
+	inCmd.RegisterFlagCompletionFunc("project", projectRefComplete)
+	inCmd.Flags().StringVarP(&flagNote, "note", "n", "", `longer description of the entry, such as a ticket link`)
+	inCmd.Flags().BoolVarP(&flagEditNote, "edit-note", "E", false, `write the note in your $EDITOR, prefilled with --note if set`)
+	inCmd.Flags().StringVarP(&flagTemplate, "template", "T", "", `template of the entry, by ID or name`)
+	inCmd.RegisterFlagCompletionFunc("template", templateRefComplete)
 }
 
 func checkIfDuplicateEntry(newName string) bool {
@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/ics"
 	"github.com/dinkur/dinkur/internal/pflagutil"
 	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/dinkur/dinkur/pkg/timeutil"
@@ -47,17 +48,22 @@ func init() {
 		flagRange            = pflagutil.NewTimeRangePtr(timeutil.TimeSpanThisDay)
 		flagOutput           = "pretty"
 		flagNoHighlight      = false
+		flagTags             = &pflagutil.Tags{}
+		flagProject     string
+		flagGroupBy     = "date"
+		flagSkipActive  = false
 	)
 
 	var listCmd = &cobra.Command{
-		Use:     `list [name search terms]`,
+		Use:     `list [search terms]`,
 		Args:    cobra.ArbitraryArgs,
 		Aliases: []string{"ls", "l"},
 		Short:   "List your entries",
 		Long: fmt.Sprintf(`Lists all your entries.
 
-Any non-flag arguments are used as entry name search terms. Due to technical
-limitation, terms shorter than 3 characters are ignored.
+Any non-flag arguments are used as search terms, matching on both the entry
+names and notes. Due to technical limitation, terms shorter than 3 characters
+are ignored.
 
 By default, this will only list today's entries. You can supply the --range flag
 to declare a different baseline range. The --start and --end flags will always
@@ -75,17 +81,47 @@ take precedence over the baseline range.
 
 Day baselines sets the range 00:00:00 - 24:59:59.
 Week baselines sets the range Monday 00:00:00 - Sunday 24:59:59.
+
+The --tag flag filters on entry tags. Entries must have all of the given tags,
+and must not have any of the tags prefixed with a dash.
+
+	%[1]s list --tag meeting           # list entries tagged "meeting".
+	%[1]s list --tag proj-x,-meeting   # list "proj-x" entries, except meetings.
+
+The --project flag filters on the entry's project, and the --group-by flag
+changes how entries are grouped in the "pretty" output:
+
+	%[1]s list --project acme/website  # list entries of a project.
+	%[1]s list --group-by project      # list entries grouped by project.
+
+The "ics" output format writes the entries as iCalendar events, which can be
+imported into or subscribed to by most calendar applications. Active entries
+are written as ending now, unless the --ics-skip-active flag is set.
+
+	%[1]s list -r lastweek -o ics > lastweek.ics
 `, RootCmd.Name()),
 		Run: func(cmd *cobra.Command, args []string) {
+			var printEntryList func([]dinkur.Entry, string, string)
+			switch strings.ToLower(flagGroupBy) {
+			case "date":
+				printEntryList = console.PrintEntryListSearched
+			case "project":
+				printEntryList = console.PrintEntryListByProject
+			default:
+				console.PrintFatal("Error parsing --group-by:", fmt.Errorf("invalid grouping: %q", flagGroupBy))
+			}
 			connectClientOrExit()
 			rand.Seed(time.Now().UnixMicro())
 			now := time.Now()
 			search := dinkur.SearchEntry{
-				Limit:     flagLimit,
-				Start:     flagStart.TimePtr(now),
-				End:       flagEnd.TimePtr(now),
-				Shorthand: flagRange.TimeSpanShorthand(),
-				NameFuzzy: strings.Join(args, " "),
+				Limit:           flagLimit,
+				Start:           flagStart.TimePtr(now),
+				End:             flagEnd.TimePtr(now),
+				Shorthand:       flagRange.TimeSpanShorthand(),
+				NameFuzzy:       strings.Join(args, " "),
+				Tags:            flagTags.Include(),
+				ExcludeTags:     flagTags.Exclude(),
+				ProjectIDOrZero: projectIDFromRefOrExit(flagProject),
 			}
 			if strings.EqualFold(flagOutput, "pretty") && !flagNoHighlight {
 				search.NameHighlightStart = fmt.Sprintf(">!@%d#>", rand.Intn(255))
@@ -106,7 +142,7 @@ Week baselines sets the range Monday 00:00:00 - Sunday 24:59:59.
 				if len(args) == 0 {
 					searchStart, searchEnd = "", ""
 				}
-				console.PrintEntryListSearched(entries, searchStart, searchEnd)
+				printEntryList(entries, searchStart, searchEnd)
 			case "json":
 				enc := json.NewEncoder(os.Stdout)
 				enc.SetIndent("", "  ")
@@ -160,6 +196,13 @@ Week baselines sets the range Monday 00:00:00 - Sunday 24:59:59.
 				if err := w.WriteAll(records); err != nil {
 					console.PrintFatal("Error encoding entries as CSV:", err)
 				}
+			case "ics":
+				enc := ics.NewEncoder(os.Stdout)
+				enc.SkipActive = flagSkipActive
+				enc.Now = now
+				if err := enc.Encode(entries); err != nil {
+					console.PrintFatal("Error encoding entries as iCalendar:", err)
+				}
 			default:
 				console.PrintFatal("Error parsing --output:", fmt.Errorf("invalid output format: %q", flagOutput))
 			}
@@ -173,9 +216,15 @@ Week baselines sets the range Monday 00:00:00 - Sunday 24:59:59.
 	listCmd.Flags().VarP(flagEnd, "end", "e", "list entries ending before or at date time")
 	listCmd.Flags().VarP(flagRange, "range", "r", "baseline time range")
 	listCmd.RegisterFlagCompletionFunc("range", pflagutil.TimeRangeCompletion)
-	listCmd.Flags().StringVarP(&flagOutput, "output", "o", flagOutput, `set output format: "pretty", "json", "json-line", "yaml", "xml", "xml-line", "csv", "csv-header"`)
+	listCmd.Flags().StringVarP(&flagOutput, "output", "o", flagOutput, `set output format: "pretty", "json", "json-line", "yaml", "xml", "xml-line", "csv", "csv-header", "ics"`)
 	listCmd.RegisterFlagCompletionFunc("output", outputFormatComplete)
+	listCmd.Flags().BoolVar(&flagSkipActive, "ics-skip-active", false, `skip active entries in "ics" output, instead of ending them at now`)
 	listCmd.Flags().BoolVar(&flagNoHighlight, "no-highlight", false, `disables search highlighting in "pretty" output`)
+	listCmd.Flags().VarP(flagTags, "tag", "t", `only list entries with tag, or without tag if prefixed with a dash; can be repeated or comma-separated`)
+	listCmd.Flags().StringVarP(&flagProject, "project", "p", "", `only list entries of project, by ID, name, or "client/name"`)
+	listCmd.RegisterFlagCompletionFunc("project", projectRefComplete)
+	listCmd.Flags().StringVarP(&flagGroupBy, "group-by", "g", flagGroupBy, `set grouping of "pretty" output: "date", "project"`)
+	listCmd.RegisterFlagCompletionFunc("group-by", groupByComplete)
 }
 
 func outputFormatComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
@@ -188,6 +237,14 @@ func outputFormatComplete(*cobra.Command, []string, string) ([]string, cobra.She
 		"xml-line\teach entry XML element on a separate line",
 		"csv\teach entry on a separate line with field as comma-separated-values",
 		"csv-header\tsame as --output=csv, but with additional header row",
+		"ics\tiCalendar with each entry as a calendar event",
+	}, cobra.ShellCompDirectiveDefault
+}
+
+func groupByComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return []string{
+		"date\tgroup entries by their start date (default)",
+		"project\tgroup entries by their project, with subtotals per project",
 	}, cobra.ShellCompDirectiveDefault
 }
 
@@ -199,6 +256,9 @@ func entryCSVHeaderRecord() []string {
 		"Name",
 		"Start",
 		"End",
+		"Tags",
+		"Project",
+		"Note",
 	}
 }
 
@@ -206,7 +266,11 @@ func convEntryCSVRecord(entry dinkur.Entry) []string {
 	const timeLayout = time.RFC3339Nano
 	endStr := ""
 	if entry.End != nil {
-		endStr = entry.End.String()
+		endStr = entry.End.Format(timeLayout)
+	}
+	projectStr := ""
+	if entry.Project != nil {
+		projectStr = entry.Project.String()
 	}
 	return []string{
 		strconv.FormatUint(uint64(entry.ID), 10),
@@ -215,5 +279,8 @@ func convEntryCSVRecord(entry dinkur.Entry) []string {
 		entry.Name,
 		entry.Start.Format(timeLayout),
 		endStr,
+		strings.Join(entry.Tags, ","),
+		projectStr,
+		entry.Note,
 	}
 }
@@ -0,0 +1,142 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+// projectCmd represents the project command
+var projectCmd = &cobra.Command{
+	Use:     "project",
+	Args:    cobra.NoArgs,
+	Aliases: []string{"projects", "proj"},
+	Short:   "Manage projects and clients",
+	Long: fmt.Sprintf(`Manage the projects that entries can be associated with.
+
+A project can optionally belong to a client. Projects are referenced either by
+their ID, by their name, or by their client and name delimited by a slash:
+
+	%[1]s in --project 12 Fix bug             # project with ID 12
+	%[1]s in --project website Fix bug        # project named "website"
+	%[1]s in --project acme/website Fix bug   # project "website" of client "acme"
+`, RootCmd.Name()),
+}
+
+func init() {
+	RootCmd.AddCommand(projectCmd)
+}
+
+var errProjectRefAmbiguous = errors.New(`multiple projects matched, use "client/name" or the project ID instead`)
+
+// findProjectByRef looks up a project either by its ID, optionally prefixed
+// with a hash sign, or by its name, optionally prefixed with its client name
+// and a slash.
+func findProjectByRef(ref string) (dinkur.Project, error) {
+	ref = strings.TrimSpace(ref)
+	if id, err := strconv.ParseUint(strings.TrimPrefix(ref, "#"), 10, 0); err == nil {
+		project, err := c.GetProject(rootCtx, uint(id))
+		if err != nil {
+			return dinkur.Project{}, fmt.Errorf("project #%d: %w", id, err)
+		}
+		return project, nil
+	}
+	client, name, hasClient := strings.Cut(ref, "/")
+	if !hasClient {
+		name, client = client, ""
+	}
+	projects, err := c.GetProjectList(rootCtx, dinkur.SearchProject{
+		Name:   name,
+		Client: client,
+	})
+	if err != nil {
+		return dinkur.Project{}, err
+	}
+	if !hasClient && len(projects) > 1 {
+		// prefer the project without any client, as "name" is also how such
+		// projects are formatted
+		for _, p := range projects {
+			if p.Client == "" {
+				return p, nil
+			}
+		}
+	}
+	switch len(projects) {
+	case 0:
+		return dinkur.Project{}, fmt.Errorf("project %q: %w", ref, dinkur.ErrNotFound)
+	case 1:
+		return projects[0], nil
+	default:
+		return dinkur.Project{}, fmt.Errorf("project %q: %w", ref, errProjectRefAmbiguous)
+	}
+}
+
+// projectIDFromRefOrExit returns the ID of the project referenced by the given
+// flag value, or zero if the flag value is empty.
+func projectIDFromRefOrExit(ref string) uint {
+	if ref == "" {
+		return 0
+	}
+	project, err := findProjectByRef(ref)
+	if err != nil {
+		console.PrintFatal("Error finding project:", err)
+	}
+	return project.ID
+}
+
+func projectRefComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	client, err := connectClient(true)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	projects, err := client.GetProjectList(rootCtx, dinkur.SearchProject{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	completions := make([]string, len(projects))
+	for i, project := range projects {
+		completions[i] = fmt.Sprintf("%s\tproject #%d", project, project.ID)
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+func projectIDComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	client, err := connectClient(true)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	projects, err := client.GetProjectList(rootCtx, dinkur.SearchProject{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	completions := make([]string, len(projects))
+	for i, project := range projects {
+		completions[i] = fmt.Sprintf("%d\tproject %s", project.ID, project)
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,76 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+	"gopkg.in/typ.v4"
+)
+
+func init() {
+	var (
+		flagID       uint
+		flagClient   string
+		flagNoClient bool
+	)
+
+	var projectEditCmd = &cobra.Command{
+		Use:     "edit [new name of project]",
+		Args:    cobra.MaximumNArgs(1),
+		Aliases: []string{"e"},
+		Short:   "Edit a project",
+		Long: `Applies changes to a specific project using the --id or -i flag.
+
+Entries referencing the project will reference the updated project.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if flagNoClient && cmd.Flags().Changed("client-name") {
+				console.PrintFatal("Error parsing flags:", "cannot use --client-name and --no-client-name together")
+			}
+			connectClientOrExit()
+			edit := dinkur.EditProject{
+				ID: flagID,
+			}
+			if len(args) > 0 {
+				edit.Name = &args[0]
+			}
+			if flagNoClient {
+				edit.Client = typ.Ref("")
+			} else if cmd.Flags().Changed("client-name") {
+				edit.Client = &flagClient
+			}
+			update, err := c.UpdateProject(rootCtx, edit)
+			if err != nil {
+				console.PrintFatal("Error editing project:", err)
+			}
+			console.PrintProjectEdit(update)
+		},
+	}
+
+	projectCmd.AddCommand(projectEditCmd)
+
+	projectEditCmd.Flags().UintVarP(&flagID, "id", "i", 0, "ID of project to edit (required)")
+	projectEditCmd.MarkFlagRequired("id")
+	projectEditCmd.RegisterFlagCompletionFunc("id", projectIDComplete)
+	projectEditCmd.Flags().StringVarP(&flagClient, "client-name", "c", "", "name of client the project belongs to")
+	projectEditCmd.Flags().BoolVar(&flagNoClient, "no-client-name", false, "make the project no longer belong to any client")
+}
@@ -0,0 +1,71 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagID  uint
+		flagYes bool
+	)
+
+	var projectRemoveCmd = &cobra.Command{
+		Use:     "remove",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"rm", "r"},
+		Short:   "Removes a project",
+		Long: `Removes a project from your entry data store.
+You must provide the flag --id to specify which project to remove.
+
+Entries referencing the project are not removed, but will no longer reference
+any project. A project that is tracked by any goals cannot be removed until
+those goals have been removed, using the "goal remove" command.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			if !flagYes {
+				project, err := c.GetProject(rootCtx, flagID)
+				if err != nil {
+					console.PrintFatal("Error getting project:", err)
+				}
+				err = console.PromptProjectRemoval(project)
+				if err != nil {
+					console.PrintFatal("Prompt error:", err)
+				}
+			}
+			removedProject, err := c.DeleteProject(rootCtx, flagID)
+			if err != nil {
+				console.PrintFatal("Error removing project:", err)
+			}
+			console.PrintProjectLabel("Deleted project:", removedProject)
+		},
+	}
+
+	projectCmd.AddCommand(projectRemoveCmd)
+
+	projectRemoveCmd.Flags().UintVarP(&flagID, "id", "i", 0, "ID of project to be removed (required)")
+	projectRemoveCmd.MarkFlagRequired("id")
+	projectRemoveCmd.RegisterFlagCompletionFunc("id", projectIDComplete)
+	projectRemoveCmd.Flags().BoolVarP(&flagYes, "yes", "y", false, "skip confirmation prompt")
+}
@@ -0,0 +1,51 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var redoCmd = &cobra.Command{
+		Use:   "redo",
+		Args:  cobra.NoArgs,
+		Short: "Redo the latest undone change to entries",
+		Long: fmt.Sprintf(`Reapplies the latest change to entries that was reverted by "%[1]s undo".
+
+Undone changes can only be redone as long as no other changes to entries have
+been made since, as any new change discards the undone changes.
+`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			result, err := c.Redo(rootCtx)
+			if err != nil {
+				console.PrintFatal("Error redoing change:", err)
+			}
+			printJournalResult("Redid", result)
+		},
+	}
+
+	RootCmd.AddCommand(redoCmd)
+}
@@ -22,7 +22,6 @@ package cmd
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/dinkur/dinkur/internal/console"
 	"github.com/spf13/cobra"
@@ -42,9 +41,10 @@ func init() {
 		Short:   "Removes a entry",
 		Long: `Removes a entry from your entry data store.
 You must provide the flag --id to specify which entry to remove.
-No bulk removal is supported.
+Use the "bulk remove" command to remove multiple entries at once.
 
-Warning: Removing a entry cannot be undone!`,
+A removed entry is moved to the trash, and can be added back in, with the same
+ID, using the "undo" or "trash restore" commands.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			connectClientOrExit()
 			if !flagYes {
@@ -67,16 +67,8 @@ Warning: Removing a entry cannot be undone!`,
 			})
 			fmt.Println()
 			fmt.Println("If this was a mistake, you can add it back in with:")
-			if removedEntry.End != nil {
-				fmt.Printf("  $ dinkur in --start %q --end %q %q\n",
-					removedEntry.Start.Format(time.RFC3339),
-					removedEntry.End.Format(time.RFC3339),
-					removedEntry.Name)
-			} else {
-				fmt.Printf("  $ dinkur in --start %q %q\n",
-					removedEntry.Start.Format(time.RFC3339),
-					removedEntry.Name)
-			}
+			fmt.Printf("  $ %s undo\n", RootCmd.Name())
+			fmt.Printf("  $ %s trash restore --id %d\n", RootCmd.Name(), removedEntry.ID)
 		},
 	}
 
@@ -0,0 +1,376 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	var (
+		flagStart   = &pflagutil.Time{}
+		flagEnd     = &pflagutil.Time{}
+		flagRange   = pflagutil.NewTimeRangePtr(timeutil.TimeSpanThisWeek)
+		flagOutput  = "pretty"
+		flagGroupBy = "day"
+		flagTags    = &pflagutil.Tags{}
+		flagProject string
+	)
+
+	var reportCmd = &cobra.Command{
+		Use:     "report",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"rep", "summary"},
+		Short:   "Summarize the time spent on your entries",
+		Long: fmt.Sprintf(`Summarizes the time spent on your entries, grouped by day, week, weekday,
+entry name, tag, or project.
+
+By default, this will only summarize this week's entries. The --range, --start,
+and --end flags work the same as for the "list" command.
+
+	%[1]s report                          # this week's time per day.
+	%[1]s report --group-by week -r all   # all time per ISO week.
+	%[1]s report --group-by weekday       # this week's time per weekday.
+	%[1]s report --group-by name          # this week's time per entry name.
+	%[1]s report --group-by tag -r lw     # last week's time per tag.
+	%[1]s report --group-by project       # this week's time per project.
+
+Entries are grouped by their start time, so an entry spanning past midnight
+is counted on the day it started. Active entries are counted up until now.
+
+When grouping by tag, an entry with multiple tags is counted once for each of
+its tags, while the total counts every entry only once.
+`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			grouping, ok := reportGroupings[strings.ToLower(flagGroupBy)]
+			if !ok {
+				console.PrintFatal("Error parsing --group-by:", fmt.Errorf("invalid grouping: %q", flagGroupBy))
+			}
+			connectClientOrExit()
+			now := time.Now()
+			search := dinkur.SearchEntry{
+				Start:           flagStart.TimePtr(now),
+				End:             flagEnd.TimePtr(now),
+				Shorthand:       flagRange.TimeSpanShorthand(),
+				Tags:            flagTags.Include(),
+				ExcludeTags:     flagTags.Exclude(),
+				ProjectIDOrZero: projectIDFromRefOrExit(flagProject),
+			}
+			log.Debug().
+				WithStringf("--start", "%v", search.Start).
+				WithStringf("--end", "%v", search.End).
+				WithStringf("--shorthand", "%v", search.Shorthand).
+				Message("Flags")
+			entries, err := c.GetEntryList(rootCtx, search)
+			if err != nil {
+				console.PrintFatal("Error getting list of entries:", err)
+			}
+			rows := grouping.aggregate(entries, now)
+			total := sumReportRow(entries, now)
+			switch strings.ToLower(flagOutput) {
+			case "pretty":
+				console.PrintReport(grouping.header, rows, total)
+			case "json":
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(newReport(flagGroupBy, rows, total)); err != nil {
+					console.PrintFatal("Error encoding report as JSON:", err)
+				}
+			case "yaml":
+				enc := yaml.NewEncoder(os.Stdout)
+				enc.SetIndent(2)
+				if err := enc.Encode(newReport(flagGroupBy, rows, total)); err != nil {
+					console.PrintFatal("Error encoding report as YAML:", err)
+				}
+			case "csv":
+				w := csv.NewWriter(os.Stdout)
+				var records [][]string
+				for _, row := range rows {
+					records = append(records, convReportCSVRecord(row))
+				}
+				if err := w.WriteAll(records); err != nil {
+					console.PrintFatal("Error encoding report as CSV:", err)
+				}
+			case "csv-header":
+				w := csv.NewWriter(os.Stdout)
+				records := [][]string{reportCSVHeaderRecord(grouping.csvHeader)}
+				for _, row := range rows {
+					records = append(records, convReportCSVRecord(row))
+				}
+				if err := w.WriteAll(records); err != nil {
+					console.PrintFatal("Error encoding report as CSV:", err)
+				}
+			default:
+				console.PrintFatal("Error parsing --output:", fmt.Errorf("invalid output format: %q", flagOutput))
+			}
+		},
+	}
+
+	RootCmd.AddCommand(reportCmd)
+
+	reportCmd.Flags().VarP(flagStart, "start", "s", "summarize entries starting after or at date time")
+	reportCmd.Flags().VarP(flagEnd, "end", "e", "summarize entries ending before or at date time")
+	reportCmd.Flags().VarP(flagRange, "range", "r", "baseline time range")
+	reportCmd.RegisterFlagCompletionFunc("range", pflagutil.TimeRangeCompletion)
+	reportCmd.Flags().StringVarP(&flagOutput, "output", "o", flagOutput, `set output format: "pretty", "json", "yaml", "csv", "csv-header"`)
+	reportCmd.RegisterFlagCompletionFunc("output", reportOutputFormatComplete)
+	reportCmd.Flags().StringVarP(&flagGroupBy, "group-by", "g", flagGroupBy, `set grouping: "day", "week", "weekday", "name", "tag", "project"`)
+	reportCmd.RegisterFlagCompletionFunc("group-by", reportGroupByComplete)
+	reportCmd.Flags().VarP(flagTags, "tag", "t", `only summarize entries with tag, or without tag if prefixed with a dash; can be repeated or comma-separated`)
+	reportCmd.Flags().StringVarP(&flagProject, "project", "p", "", `only summarize entries of project, by ID, name, or "client/name"`)
+	reportCmd.RegisterFlagCompletionFunc("project", projectRefComplete)
+}
+
+func reportOutputFormatComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return []string{
+		"pretty\thuman readable and colored table formatting (default)",
+		"json\ta single indented JSON object containing all rows and the total",
+		"yaml\tYAML object containing all rows and the total",
+		"csv\teach row on a separate line with fields as comma-separated-values",
+		"csv-header\tsame as --output=csv, but with additional header row",
+	}, cobra.ShellCompDirectiveDefault
+}
+
+func reportGroupByComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return []string{
+		"day\tsummarize per start date (default)",
+		"week\tsummarize per ISO week",
+		"weekday\tsummarize per day of the week, Monday to Sunday",
+		"name\tsummarize per entry name",
+		"tag\tsummarize per tag",
+		"project\tsummarize per project",
+	}, cobra.ShellCompDirectiveDefault
+}
+
+// reportKey is the grouping key of a report row. The order field is used when
+// sorting rows chronologically, and is ignored for groupings that instead sort
+// on duration.
+type reportKey struct {
+	label string
+	order string
+}
+
+type reportGrouping struct {
+	header    string
+	csvHeader string
+	keys      func(entry dinkur.Entry) []reportKey
+	// sortByKey sorts the rows on the key order, instead of on the longest
+	// duration first.
+	sortByKey bool
+}
+
+var reportGroupings = map[string]reportGrouping{
+	"day": {
+		header:    "DAY",
+		csvHeader: "Day",
+		sortByKey: true,
+		keys: func(entry dinkur.Entry) []reportKey {
+			day := entry.Start.Local().Format("2006-01-02")
+			return []reportKey{{label: day, order: day}}
+		},
+	},
+	"week": {
+		header:    "WEEK",
+		csvHeader: "Week",
+		sortByKey: true,
+		keys: func(entry dinkur.Entry) []reportKey {
+			year, week := entry.Start.Local().ISOWeek()
+			label := fmt.Sprintf("%d-W%02d", year, week)
+			return []reportKey{{label: label, order: label}}
+		},
+	},
+	"weekday": {
+		header:    "WEEKDAY",
+		csvHeader: "Weekday",
+		sortByKey: true,
+		keys: func(entry dinkur.Entry) []reportKey {
+			weekday := entry.Start.Local().Weekday()
+			return []reportKey{{
+				label: weekday.String(),
+				order: strconv.Itoa(timeutil.DaysSinceMonday(weekday)),
+			}}
+		},
+	},
+	"name": {
+		header:    "NAME",
+		csvHeader: "Name",
+		keys: func(entry dinkur.Entry) []reportKey {
+			return []reportKey{{label: entry.Name}}
+		},
+	},
+	"tag": {
+		header:    "TAG",
+		csvHeader: "Tag",
+		keys: func(entry dinkur.Entry) []reportKey {
+			if len(entry.Tags) == 0 {
+				return []reportKey{{}}
+			}
+			keys := make([]reportKey, len(entry.Tags))
+			for i, tag := range entry.Tags {
+				keys[i] = reportKey{label: tag}
+			}
+			return keys
+		},
+	},
+	"project": {
+		header:    "PROJECT",
+		csvHeader: "Project",
+		keys: func(entry dinkur.Entry) []reportKey {
+			if entry.Project == nil {
+				return []reportKey{{}}
+			}
+			return []reportKey{{label: entry.Project.String()}}
+		},
+	},
+}
+
+func (g reportGrouping) aggregate(entries []dinkur.Entry, now time.Time) []console.ReportRow {
+	rowIndexes := map[reportKey]int{}
+	var keys []reportKey
+	var rows []console.ReportRow
+	for _, entry := range entries {
+		elapsed := entryElapsedUntil(entry, now)
+		for _, key := range g.keys(entry) {
+			idx, ok := rowIndexes[key]
+			if !ok {
+				idx = len(rows)
+				rowIndexes[key] = idx
+				keys = append(keys, key)
+				rows = append(rows, console.ReportRow{Key: key.label})
+			}
+			rows[idx].Entries++
+			rows[idx].Duration += elapsed
+		}
+	}
+	sort.Sort(reportRowSorter{rows, keys, g.sortByKey})
+	return rows
+}
+
+type reportRowSorter struct {
+	rows      []console.ReportRow
+	keys      []reportKey
+	sortByKey bool
+}
+
+func (s reportRowSorter) Len() int { return len(s.rows) }
+
+func (s reportRowSorter) Swap(i, j int) {
+	s.rows[i], s.rows[j] = s.rows[j], s.rows[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+func (s reportRowSorter) Less(i, j int) bool {
+	if s.sortByKey {
+		return s.keys[i].order < s.keys[j].order
+	}
+	// rows without a key, such as entries without a project, are put last
+	if (s.keys[i].label == "") != (s.keys[j].label == "") {
+		return s.keys[j].label == ""
+	}
+	if s.rows[i].Duration != s.rows[j].Duration {
+		return s.rows[i].Duration > s.rows[j].Duration
+	}
+	return s.keys[i].label < s.keys[j].label
+}
+
+func sumReportRow(entries []dinkur.Entry, now time.Time) console.ReportRow {
+	total := console.ReportRow{Entries: len(entries)}
+	for _, entry := range entries {
+		total.Duration += entryElapsedUntil(entry, now)
+	}
+	return total
+}
+
+// entryElapsedUntil is the same as dinkur.Entry.Elapsed, but uses the same
+// "now" for all entries so the rows add up with the total.
+func entryElapsedUntil(entry dinkur.Entry, now time.Time) time.Duration {
+	if entry.End != nil {
+		return entry.End.Sub(entry.Start)
+	}
+	return now.Sub(entry.Start)
+}
+
+type report struct {
+	GroupBy string      `json:"groupBy" yaml:"groupBy"`
+	Rows    []reportRow `json:"rows" yaml:"rows"`
+	Total   reportRow   `json:"total" yaml:"total"`
+}
+
+type reportRow struct {
+	Key     string  `json:"key" yaml:"key"`
+	Entries int     `json:"entries" yaml:"entries"`
+	Seconds int64   `json:"seconds" yaml:"seconds"`
+	Hours   float64 `json:"hours" yaml:"hours"`
+}
+
+func newReport(groupBy string, rows []console.ReportRow, total console.ReportRow) report {
+	r := report{
+		GroupBy: strings.ToLower(groupBy),
+		Rows:    make([]reportRow, len(rows)),
+		Total:   newReportRow(total),
+	}
+	for i, row := range rows {
+		r.Rows[i] = newReportRow(row)
+	}
+	return r
+}
+
+func newReportRow(row console.ReportRow) reportRow {
+	return reportRow{
+		Key:     row.Key,
+		Entries: row.Entries,
+		Seconds: int64(row.Duration.Seconds()),
+		Hours:   math.Round(row.Duration.Hours()*100) / 100,
+	}
+}
+
+func reportCSVHeaderRecord(keyHeader string) []string {
+	return []string{
+		keyHeader,
+		"Entries",
+		"Duration",
+		"Hours",
+	}
+}
+
+func convReportCSVRecord(row console.ReportRow) []string {
+	return []string{
+		row.Key,
+		strconv.Itoa(row.Entries),
+		console.FormatDuration(row.Duration),
+		console.FormatHours(row.Duration),
+	}
+}
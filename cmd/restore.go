@@ -0,0 +1,82 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagYes bool
+	)
+
+	var restoreCmd = &cobra.Command{
+		Use:   "restore <file>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Replace the database with a snapshot from a file",
+		Long: fmt.Sprintf(`Replaces all entries, projects, and statuses with a snapshot previously saved
+by the "backup" command. Reads from STDIN if "-" is given.
+
+The snapshot is validated before anything is replaced. Snapshots saved by older
+versions of Dinkur are migrated to the current version before they are
+restored, while snapshots saved by newer versions of Dinkur are rejected.
+When using "--client grpc", the snapshot is uploaded to and restored by the
+daemon.
+
+	%[1]s restore dinkur-backup.db
+	%[1]s --client grpc restore --yes dinkur-backup.db
+`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			fileName := args[0]
+			var r io.Reader = os.Stdin
+			if fileName != "-" {
+				file, err := os.Open(fileName)
+				if err != nil {
+					console.PrintFatal("Error opening file:", err)
+				}
+				defer file.Close()
+				r = file
+			} else if !flagYes {
+				console.PrintFatal("Error restoring backup:", "the --yes flag is required when reading from STDIN")
+			}
+			connectClientOrExit()
+			if !flagYes {
+				if err := console.PromptRestore(fileName); err != nil {
+					console.PrintFatal("Prompt error:", err)
+				}
+			}
+			if err := c.Restore(rootCtx, r); err != nil {
+				console.PrintFatal("Error restoring backup:", err)
+			}
+			console.PrintBackupLabel("Restored backup:", fileName)
+		},
+	}
+
+	RootCmd.AddCommand(restoreCmd)
+
+	restoreCmd.Flags().BoolVarP(&flagYes, "yes", "y", false, "skip confirmation prompt")
+}
@@ -27,7 +27,6 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
-	"time"
 
 	"github.com/dinkur/dinkur/internal/console"
 	"github.com/dinkur/dinkur/internal/license"
@@ -224,7 +223,15 @@ func connectClient(skipMigrate bool) (dinkur.Client, error) {
 }
 
 func connectToGRPCClient() (dinkur.Client, error) {
-	c := dinkurclient.NewClient(cfg.GRPC.Address, dinkurclient.Options{})
+	c := dinkurclient.NewClient(cfg.GRPC.Address, dinkurclient.Options{
+		Token:              cfg.GRPC.Token,
+		TokenFile:          cfg.GRPC.TokenFile,
+		TLS:                cfg.GRPC.TLS,
+		TLSCAFile:          cfg.GRPC.TLSCAFile,
+		TLSServerName:      cfg.GRPC.TLSServerName,
+		TLSTrustOnFirstUse: cfg.GRPC.TLSTrustOnFirstUse,
+		TLSPinFile:         cfg.GRPC.TLSPinFile,
+	})
 	if err := c.Connect(rootCtx); err != nil {
 		return nil, err
 	}
@@ -236,49 +243,57 @@ func connectToGRPCClient() (dinkur.Client, error) {
 }
 
 func checkStatusForAFK(c dinkur.Client) {
-	status, err := c.GetStatus(rootCtx)
-	if err != nil {
-		console.PrintFatal("Error getting status:", err)
-	}
-	if status.AFKSince == nil || status.BackSince == nil {
-		return
-	}
-	activeEntry, err := c.GetActiveEntry(rootCtx)
+	pending, err := c.GetPendingAway(rootCtx)
 	if err != nil {
-		console.PrintFatal("Error getting active entry:", err)
+		console.PrintFatal("Error getting pending away time:", err)
 	}
-	if activeEntry == nil {
+	if pending == nil {
 		return
 	}
-	promptAFKResolution(c, *activeEntry, *status.AFKSince)
+	promptAFKResolution(c, *pending)
 }
 
-func promptAFKResolution(c dinkur.Client, activeEntry dinkur.Entry, afkSince time.Time) {
-	res, err := console.PromptAFKResolution(activeEntry, afkSince)
+func promptAFKResolution(c dinkur.Client, pending dinkur.PendingAway) {
+	resolve, err := console.PromptAFKResolution(pending)
 	fmt.Println()
 	if err != nil {
 		console.PrintFatal("Prompt error:", err)
 	}
-	if res.Edit != nil {
-		update, err := c.UpdateEntry(rootCtx, *res.Edit)
-		if err != nil {
-			console.PrintFatal("Error editing entry:", err)
-		}
-		console.PrintEntryEdit(update)
-		fmt.Println()
+	resolved, err := c.ResolveAway(rootCtx, resolve)
+	if err != nil {
+		console.PrintFatal("Error resolving away time:", err)
 	}
-	if res.NewEntry != nil {
-		startedEntry, err := c.CreateEntry(rootCtx, *res.NewEntry)
-		if err != nil {
-			console.PrintFatal("Error starting entry:", err)
+	printResolvedAway(resolved)
+	fmt.Println("Continuing with command...")
+	fmt.Println()
+}
+
+func printResolvedAway(resolved dinkur.ResolvedAway) {
+	var toPrint []console.LabelledEntry
+	if resolved.Stopped != nil {
+		toPrint = append(toPrint, console.LabelledEntry{
+			Label: "Stopped entry:",
+			Entry: *resolved.Stopped,
+		})
+	}
+	for _, entry := range resolved.Created {
+		if entry.End != nil {
+			toPrint = append(toPrint, console.LabelledEntry{
+				Label: "Added entry:",
+				Entry: entry,
+			})
+		} else {
+			toPrint = append(toPrint, console.LabelledEntry{
+				Label:      "Started entry:",
+				Entry:      entry,
+				NoDuration: true,
+			})
 		}
-		printStartedEntry(startedEntry)
-		fmt.Println()
 	}
-	if err := c.SetStatus(rootCtx, dinkur.EditStatus{}); err != nil {
-		console.PrintFatal("Error removing AFK status:", err)
+	if len(toPrint) == 0 {
+		return
 	}
-	fmt.Println("Continuing with command...")
+	console.PrintEntryLabelSlice(toPrint)
 	fmt.Println()
 }
 
@@ -287,6 +302,7 @@ func connectToDBClient(skipMigrate bool) (dinkur.Client, error) {
 		MkdirAll:             cfg.Sqlite.Mkdir,
 		DebugLogging:         flagVerbose,
 		SkipMigrateOnConnect: skipMigrate,
+		OverlapPolicy:        dinkur.OverlapPolicy(cfg.Entries.OverlapPolicy),
 	})
 	return c, c.Connect(rootCtx)
 }
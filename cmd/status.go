@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/spf13/cobra"
 )
 
@@ -33,7 +34,7 @@ var statusCmd = &cobra.Command{
 	Args:    cobra.NoArgs,
 	Aliases: []string{"s"},
 	Short:   "Show status of active entry",
-	Long:    ``,
+	Long:    `Shows the active entry, and the progress towards today's and this week's goals.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		connectClientOrExit()
 		activeEntry, err := c.GetActiveEntry(rootCtx)
@@ -47,10 +48,38 @@ var statusCmd = &cobra.Command{
 			})
 		} else {
 			fmt.Println("You have no active entry.")
+			printAutoStoppedEntry()
+		}
+		progress, err := c.GetGoalProgress(rootCtx, dinkur.SearchGoalProgress{})
+		if err != nil {
+			console.PrintFatal("Error getting goal progress:", err)
+		}
+		if len(progress) > 0 {
+			fmt.Println()
+			console.PrintGoalProgress(progress)
 		}
 	},
 }
 
+func printAutoStoppedEntry() {
+	status, err := c.GetStatus(rootCtx)
+	if err != nil {
+		console.PrintFatal("Error getting status:", err)
+	}
+	if status.AutoStoppedEntryIDOrZero == 0 {
+		return
+	}
+	entry, err := c.GetEntry(rootCtx, status.AutoStoppedEntryIDOrZero)
+	if err != nil {
+		return
+	}
+	fmt.Println()
+	console.PrintEntryLabel(console.LabelledEntry{
+		Label: "Automatically stopped after being AFK:",
+		Entry: entry,
+	})
+}
+
 func init() {
 	RootCmd.AddCommand(statusCmd)
 
@@ -0,0 +1,70 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// streamAwayCmd represents the test command
+var streamAwayCmd = &cobra.Command{
+	Use:   "away",
+	Args:  cobra.NoArgs,
+	Short: "Testing pending away time streaming",
+	Run: func(cmd *cobra.Command, args []string) {
+		if cfg.Client != config.ClientTypeGRPC {
+			console.PrintFatal("Error running test:", `--client must be set to "grpc"`)
+		}
+		connectClientOrExit()
+		ctx, cancel := context.WithTimeout(rootCtx, 60*time.Second)
+		awayChan, err := c.StreamPendingAway(ctx)
+		if err != nil {
+			cancel()
+			console.PrintFatal("Error streaming events:", err)
+		}
+		fmt.Println("Streaming pending away time...")
+		for {
+			ev, ok := <-awayChan
+			if !ok {
+				cancel()
+				fmt.Println("Channel was closed.")
+				os.Exit(0)
+			}
+			logEv := log.Info().WithStringer("event", ev.Event)
+			if ev.PendingAway != nil {
+				logEv = logEv.
+					WithTime("afkSince", ev.PendingAway.AFKSince).
+					WithTime("backSince", ev.PendingAway.BackSince).
+					WithUint("activeEntryId", ev.PendingAway.ActiveEntry.ID)
+			}
+			logEv.Message("Received pending away time.")
+			fmt.Println()
+		}
+	},
+}
+
+func init() {
+	streamCmd.AddCommand(streamAwayCmd)
+}
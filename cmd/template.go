@@ -0,0 +1,145 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/fuzzytime"
+	"github.com/dinkur/dinkur/internal/rrule"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/spf13/cobra"
+)
+
+// templateCmd represents the template command
+var templateCmd = &cobra.Command{
+	Use:     "template",
+	Args:    cobra.NoArgs,
+	Aliases: []string{"templates", "tpl"},
+	Short:   "Manage entry templates and recurring entries",
+	Long: fmt.Sprintf(`Manage templates of default values for new entries.
+
+A template holds an entry name, a duration, and tags. Templates are referenced
+either by their ID or by their name when starting new entries:
+
+	%[1]s in --template standup        # entry named after the template
+	%[1]s in --template 3 Planning     # template with ID 3, custom name
+
+Templates can optionally recur on a schedule, using the --repeat flag with
+either "daily", "weekly", "weekdays", or a subset of the iCalendar RRULE
+format, such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE". Only the FREQ, INTERVAL,
+BYDAY, COUNT, and UNTIL rule parts are supported, with the DAILY and WEEKLY
+frequencies.
+
+	%[1]s template add standup --duration 15m --repeat weekdays --at 09:30
+
+Entries from recurring templates are added by the daemon when their scheduled
+start time is reached, if the "daemon.materializeRecurring" config is enabled,
+or by running "%[1]s template materialize".`, RootCmd.Name()),
+}
+
+func init() {
+	RootCmd.AddCommand(templateCmd)
+}
+
+// findTemplateByRef looks up a template either by its ID, optionally prefixed
+// with a hash sign, or by its name.
+func findTemplateByRef(ref string) (dinkur.Template, error) {
+	ref = strings.TrimSpace(ref)
+	if id, err := strconv.ParseUint(strings.TrimPrefix(ref, "#"), 10, 0); err == nil {
+		template, err := c.GetTemplate(rootCtx, uint(id))
+		if err != nil {
+			return dinkur.Template{}, fmt.Errorf("template #%d: %w", id, err)
+		}
+		return template, nil
+	}
+	templates, err := c.GetTemplateList(rootCtx, dinkur.SearchTemplate{
+		Name: ref,
+	})
+	if err != nil {
+		return dinkur.Template{}, err
+	}
+	if len(templates) == 0 {
+		return dinkur.Template{}, fmt.Errorf("template %q: %w", ref, dinkur.ErrNotFound)
+	}
+	return templates[0], nil
+}
+
+// parseRecurrenceRuleOrExit validates the recurrence rule given by the
+// --repeat flag, and returns it in its RRULE form.
+func parseRecurrenceRuleOrExit(s string) string {
+	rule, err := rrule.Parse(s)
+	if err != nil {
+		console.PrintFatal("Error parsing --repeat:", err)
+	}
+	return rule.String()
+}
+
+// parseRecurrenceDatesOrExit parses the dates given by the --except flag.
+func parseRecurrenceDatesOrExit(values []string) []time.Time {
+	now := time.Now()
+	dates := make([]time.Time, len(values))
+	for i, value := range values {
+		date, err := fuzzytime.Parse(value, now)
+		if err != nil {
+			console.PrintFatal("Error parsing --except:", err)
+		}
+		dates[i] = *timeutil.Day(date).Start
+	}
+	return dates
+}
+
+func templateRefComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	client, err := connectClient(true)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	templates, err := client.GetTemplateList(rootCtx, dinkur.SearchTemplate{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	completions := make([]string, len(templates))
+	for i, template := range templates {
+		completions[i] = fmt.Sprintf("%s\ttemplate #%d", template.Name, template.ID)
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+func templateIDComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	client, err := connectClient(true)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	templates, err := client.GetTemplateList(rootCtx, dinkur.SearchTemplate{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	completions := make([]string, len(templates))
+	for i, template := range templates {
+		completions[i] = fmt.Sprintf("%d\ttemplate %s", template.ID, template.Name)
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
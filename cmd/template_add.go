@@ -0,0 +1,102 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/config"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagEntryName string
+		flagDuration  = pflagutil.NewDuration(0)
+		flagTags      = &pflagutil.Tags{}
+		flagRepeat    string
+		flagAt        config.TimeOfDay
+		flagSince     = &pflagutil.Time{}
+		flagExcept    []string
+	)
+
+	var templateAddCmd = &cobra.Command{
+		Use:     "add <template name>",
+		Args:    cobra.ExactArgs(1),
+		Aliases: []string{"new", "a"},
+		Short:   "Add a new template",
+		Long: `Adds a template of default values for new entries.
+
+Use --repeat to make the template recur on a schedule, starting at the time of
+day set by --at, which defaults to the start of the working hours.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(flagTags.Exclude()) > 0 {
+				console.PrintFatal("Error parsing --tag:", "cannot exclude tags when creating a template")
+			}
+			if flagRepeat == "" && (cmd.Flags().Changed("at") ||
+				cmd.Flags().Changed("since") || cmd.Flags().Changed("except")) {
+				console.PrintFatal("Error parsing flags:", "--at, --since, and --except require --repeat")
+			}
+			newTemplate := dinkur.NewTemplate{
+				Name:      args[0],
+				EntryName: flagEntryName,
+				Duration:  flagDuration.Duration(),
+				Tags:      flagTags.Include(),
+			}
+			if flagRepeat != "" {
+				startTime := time.Duration(cfg.WorkHours.Start)
+				if cmd.Flags().Changed("at") {
+					startTime = time.Duration(flagAt)
+				}
+				var since time.Time
+				if t := flagSince.TimePtr(time.Now()); t != nil {
+					since = *timeutil.Day(*t).Start
+				}
+				newTemplate.Recurrence = &dinkur.Recurrence{
+					Rule:       parseRecurrenceRuleOrExit(flagRepeat),
+					StartTime:  startTime,
+					Since:      since,
+					Exceptions: parseRecurrenceDatesOrExit(flagExcept),
+				}
+			}
+			connectClientOrExit()
+			template, err := c.CreateTemplate(rootCtx, newTemplate)
+			if err != nil {
+				console.PrintFatal("Error adding template:", err)
+			}
+			console.PrintTemplateLabel("Added template:", template)
+		},
+	}
+
+	templateCmd.AddCommand(templateAddCmd)
+
+	templateAddCmd.Flags().StringVarP(&flagEntryName, "entry-name", "n", "", `name of entries created from the template; defaults to the template name`)
+	templateAddCmd.Flags().VarP(flagDuration, "duration", "d", `duration of entries created from the template; entries are active if not set`)
+	templateAddCmd.Flags().VarP(flagTags, "tag", "t", `tag to attach to entries; can be repeated or comma-separated`)
+	templateAddCmd.Flags().StringVarP(&flagRepeat, "repeat", "r", "", `recurrence rule, such as "daily", "weekdays", or "FREQ=WEEKLY;BYDAY=MO,WE"`)
+	templateAddCmd.Flags().Var(&flagAt, "at", `time of day that recurring entries start at, such as "09:30"`)
+	templateAddCmd.Flags().Var(flagSince, "since", `first date that the template recurs on; defaults to today`)
+	templateAddCmd.Flags().StringSliceVarP(&flagExcept, "except", "x", nil, `date that the template does not recur on; can be repeated or comma-separated`)
+}
@@ -0,0 +1,139 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/dinkur/dinkur/pkg/config"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagID        uint
+		flagEntryName string
+		flagDuration  = pflagutil.NewDuration(0)
+		flagTags      = &pflagutil.Tags{}
+		flagRepeat    string
+		flagNoRepeat  bool
+		flagAt        config.TimeOfDay
+		flagSince     = &pflagutil.Time{}
+		flagExcept    []string
+		flagNoExcept  bool
+		scheduleFlags = []string{"repeat", "at", "since", "except", "no-except"}
+	)
+
+	var templateEditCmd = &cobra.Command{
+		Use:     "edit [new name of template]",
+		Args:    cobra.MaximumNArgs(1),
+		Aliases: []string{"e"},
+		Short:   "Edit a template",
+		Long: `Applies changes to a specific template using the --id or -i flag.
+
+The --at, --since, and --except flags change the schedule of a template that
+already recurs, while --repeat can also be used to make it start recurring.
+The --except flag adds to the existing exceptions, which can be cleared using
+--no-except, or replaced by using both flags together.
+
+Entries previously created from the template are not changed.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			anyScheduleFlag := false
+			for _, name := range scheduleFlags {
+				if cmd.Flags().Changed(name) {
+					anyScheduleFlag = true
+				}
+			}
+			if flagNoRepeat && anyScheduleFlag {
+				console.PrintFatal("Error parsing flags:", "cannot use --no-repeat together with --repeat, --at, --since, --except, or --no-except")
+			}
+			connectClientOrExit()
+			edit := dinkur.EditTemplate{
+				ID:               flagID,
+				Tags:             flagTags.Include(),
+				RemoveTags:       flagTags.Exclude(),
+				RemoveRecurrence: flagNoRepeat,
+			}
+			if len(args) > 0 {
+				edit.Name = &args[0]
+			}
+			if cmd.Flags().Changed("entry-name") {
+				edit.EntryName = &flagEntryName
+			}
+			if cmd.Flags().Changed("duration") {
+				duration := flagDuration.Duration()
+				edit.Duration = &duration
+			}
+			if anyScheduleFlag {
+				template, err := c.GetTemplate(rootCtx, flagID)
+				if err != nil {
+					console.PrintFatal("Error getting template:", err)
+				}
+				var recurrence dinkur.Recurrence
+				if template.Recurrence != nil {
+					recurrence = *template.Recurrence
+				} else if flagRepeat == "" {
+					console.PrintFatal("Error parsing flags:", "template does not recur, so --repeat must be set")
+				} else {
+					recurrence.StartTime = time.Duration(cfg.WorkHours.Start)
+				}
+				if flagRepeat != "" {
+					recurrence.Rule = parseRecurrenceRuleOrExit(flagRepeat)
+				}
+				if cmd.Flags().Changed("at") {
+					recurrence.StartTime = time.Duration(flagAt)
+				}
+				if t := flagSince.TimePtr(time.Now()); t != nil {
+					recurrence.Since = *timeutil.Day(*t).Start
+				}
+				if flagNoExcept {
+					recurrence.Exceptions = nil
+				}
+				recurrence.Exceptions = append(recurrence.Exceptions, parseRecurrenceDatesOrExit(flagExcept)...)
+				edit.Recurrence = &recurrence
+			}
+			update, err := c.UpdateTemplate(rootCtx, edit)
+			if err != nil {
+				console.PrintFatal("Error editing template:", err)
+			}
+			console.PrintTemplateEdit(update)
+		},
+	}
+
+	templateCmd.AddCommand(templateEditCmd)
+
+	templateEditCmd.Flags().UintVarP(&flagID, "id", "i", 0, "ID of template to edit (required)")
+	templateEditCmd.MarkFlagRequired("id")
+	templateEditCmd.RegisterFlagCompletionFunc("id", templateIDComplete)
+	templateEditCmd.Flags().StringVarP(&flagEntryName, "entry-name", "n", "", `name of entries created from the template; set to empty to use the template name`)
+	templateEditCmd.Flags().VarP(flagDuration, "duration", "d", `duration of entries created from the template; set to 0 to create active entries`)
+	templateEditCmd.Flags().VarP(flagTags, "tag", "t", `tag to add, or remove if prefixed with a dash; can be repeated or comma-separated`)
+	templateEditCmd.Flags().StringVarP(&flagRepeat, "repeat", "r", "", `recurrence rule, such as "daily", "weekdays", or "FREQ=WEEKLY;BYDAY=MO,WE"`)
+	templateEditCmd.Flags().BoolVar(&flagNoRepeat, "no-repeat", false, `make the template no longer recur`)
+	templateEditCmd.Flags().Var(&flagAt, "at", `time of day that recurring entries start at, such as "09:30"`)
+	templateEditCmd.Flags().Var(flagSince, "since", `first date that the template recurs on`)
+	templateEditCmd.Flags().StringSliceVarP(&flagExcept, "except", "x", nil, `date that the template does not recur on; can be repeated or comma-separated`)
+	templateEditCmd.Flags().BoolVar(&flagNoExcept, "no-except", false, `remove all dates that the template does not recur on`)
+}
@@ -0,0 +1,79 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	var (
+		flagOutput = "pretty"
+	)
+
+	var templateListCmd = &cobra.Command{
+		Use:     "list [name]",
+		Args:    cobra.MaximumNArgs(1),
+		Aliases: []string{"ls", "l"},
+		Short:   "List your templates",
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			var search dinkur.SearchTemplate
+			if len(args) > 0 {
+				search.Name = args[0]
+			}
+			templates, err := c.GetTemplateList(rootCtx, search)
+			if err != nil {
+				console.PrintFatal("Error getting list of templates:", err)
+			}
+			switch strings.ToLower(flagOutput) {
+			case "pretty":
+				console.PrintTemplateList(templates)
+			case "json":
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				if err := enc.Encode(templates); err != nil {
+					console.PrintFatal("Error encoding templates as JSON:", err)
+				}
+			case "yaml":
+				enc := yaml.NewEncoder(os.Stdout)
+				enc.SetIndent(2)
+				if err := enc.Encode(templates); err != nil {
+					console.PrintFatal("Error encoding templates as YAML:", err)
+				}
+			default:
+				console.PrintFatal("Error parsing --output:", fmt.Errorf("invalid output format: %q", flagOutput))
+			}
+		},
+	}
+
+	templateCmd.AddCommand(templateListCmd)
+
+	templateListCmd.Flags().StringVarP(&flagOutput, "output", "o", flagOutput, `set output format: "pretty", "json", "yaml"`)
+}
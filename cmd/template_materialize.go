@@ -0,0 +1,65 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var templateMaterializeCmd = &cobra.Command{
+		Use:     "materialize",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"sync"},
+		Short:   "Add entries from recurring templates",
+		Long: `Adds entries from all recurring templates that are scheduled to start
+since the last time entries were added, and up until now.
+
+The daemon does this automatically if the "daemon.materializeRecurring" config
+is enabled.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			entries, err := c.MaterializeRecurringEntries(rootCtx, time.Now())
+			if err != nil {
+				console.PrintFatal("Error adding recurring entries:", err)
+			}
+			if len(entries) == 0 {
+				fmt.Println("No recurring entries to add.")
+				return
+			}
+			toPrint := make([]console.LabelledEntry, len(entries))
+			for i, entry := range entries {
+				toPrint[i] = console.LabelledEntry{
+					Label:      "Added entry:",
+					Entry:      entry,
+					NoDuration: entry.End == nil,
+				}
+			}
+			console.PrintEntryLabelSlice(toPrint)
+		},
+	}
+
+	templateCmd.AddCommand(templateMaterializeCmd)
+}
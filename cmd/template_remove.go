@@ -0,0 +1,69 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagID  uint
+		flagYes bool
+	)
+
+	var templateRemoveCmd = &cobra.Command{
+		Use:     "remove",
+		Args:    cobra.NoArgs,
+		Aliases: []string{"rm", "r"},
+		Short:   "Removes a template",
+		Long: `Removes a template from your entry data store.
+You must provide the flag --id to specify which template to remove.
+
+Entries previously created from the template are not removed.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			if !flagYes {
+				template, err := c.GetTemplate(rootCtx, flagID)
+				if err != nil {
+					console.PrintFatal("Error getting template:", err)
+				}
+				err = console.PromptTemplateRemoval(template)
+				if err != nil {
+					console.PrintFatal("Prompt error:", err)
+				}
+			}
+			removedTemplate, err := c.DeleteTemplate(rootCtx, flagID)
+			if err != nil {
+				console.PrintFatal("Error removing template:", err)
+			}
+			console.PrintTemplateLabel("Deleted template:", removedTemplate)
+		},
+	}
+
+	templateCmd.AddCommand(templateRemoveCmd)
+
+	templateRemoveCmd.Flags().UintVarP(&flagID, "id", "i", 0, "ID of template to be removed (required)")
+	templateRemoveCmd.MarkFlagRequired("id")
+	templateRemoveCmd.RegisterFlagCompletionFunc("id", templateIDComplete)
+	templateRemoveCmd.Flags().BoolVarP(&flagYes, "yes", "y", false, "skip confirmation prompt")
+}
@@ -0,0 +1,63 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// trashCmd represents the trash command
+var trashCmd = &cobra.Command{
+	Use:   "trash",
+	Args:  cobra.NoArgs,
+	Short: "Manage removed entries",
+	Long: fmt.Sprintf(`Manage the entries that have been removed.
+
+Removed entries are moved to the trash, where they are kept until purged.
+Entries in the trash are not included when listing entries nor in reports.
+
+	%[1]s trash list                   # list removed entries
+	%[1]s trash restore --id 12        # restore entry with ID 12
+	%[1]s trash purge --older-than 30d # permanently delete old entries
+`, RootCmd.Name()),
+}
+
+func init() {
+	RootCmd.AddCommand(trashCmd)
+}
+
+func trashedEntryIDComplete(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	client, err := connectClient(true)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	entries, err := client.GetTrashedEntryList(rootCtx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	completions := make([]string, len(entries))
+	for i, entry := range entries {
+		completions[i] = fmt.Sprintf("%[1]d\tentry #%[1]d `%[2]s`", entry.ID, entry.Name)
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
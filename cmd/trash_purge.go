@@ -0,0 +1,81 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/internal/pflagutil"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagOlderThan = pflagutil.NewDuration(0)
+		flagYes       bool
+	)
+
+	var trashPurgeCmd = &cobra.Command{
+		Use:   "purge",
+		Args:  cobra.NoArgs,
+		Short: "Permanently delete removed entries",
+		Long: `Permanently deletes entries from the trash. Purged entries cannot be
+restored, and are also removed from the undo/redo history.
+
+By default all entries in the trash are purged. Use the --older-than flag to
+only purge entries that were removed a while ago. Durations are written the
+same way as "1h30m", with the additional units "d" for days and "w" for weeks.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			deletedBefore := time.Now().Add(-flagOlderThan.Duration())
+			if !flagYes {
+				entries, err := c.GetTrashedEntryList(rootCtx)
+				if err != nil {
+					console.PrintFatal("Error getting list of removed entries:", err)
+				}
+				var toPurge int
+				for _, entry := range entries {
+					if entry.DeletedAt != nil && entry.DeletedAt.Before(deletedBefore) {
+						toPurge++
+					}
+				}
+				if toPurge == 0 {
+					console.PrintTrashedEntryPurge(nil)
+					return
+				}
+				if err := console.PromptTrashPurge(toPurge); err != nil {
+					console.PrintFatal("Prompt error:", err)
+				}
+			}
+			purgedEntries, err := c.PurgeTrashedEntries(rootCtx, deletedBefore)
+			if err != nil {
+				console.PrintFatal("Error purging removed entries:", err)
+			}
+			console.PrintTrashedEntryPurge(purgedEntries)
+		},
+	}
+
+	trashCmd.AddCommand(trashPurgeCmd)
+
+	trashPurgeCmd.Flags().Var(flagOlderThan, "older-than", `only purge entries removed longer ago than this, such as "30d"`)
+	trashPurgeCmd.Flags().BoolVarP(&flagYes, "yes", "y", false, "skip confirmation prompt")
+}
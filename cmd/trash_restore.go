@@ -0,0 +1,58 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var (
+		flagID uint
+	)
+
+	var trashRestoreCmd = &cobra.Command{
+		Use:   "restore",
+		Args:  cobra.NoArgs,
+		Short: "Restore a removed entry",
+		Long: `Moves a removed entry out of the trash, with the same ID, tags, and project
+as before it was removed.
+You must provide the flag --id to specify which entry to restore.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			restoredEntry, err := c.RestoreEntry(rootCtx, flagID)
+			if err != nil {
+				console.PrintFatal("Error restoring entry:", err)
+			}
+			console.PrintEntryLabel(console.LabelledEntry{
+				Label: "Restored entry:",
+				Entry: restoredEntry,
+			})
+		},
+	}
+
+	trashCmd.AddCommand(trashRestoreCmd)
+
+	trashRestoreCmd.Flags().UintVarP(&flagID, "id", "i", 0, "ID of removed entry to be restored (required)")
+	trashRestoreCmd.MarkFlagRequired("id")
+	trashRestoreCmd.RegisterFlagCompletionFunc("id", trashedEntryIDComplete)
+}
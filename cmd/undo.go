@@ -0,0 +1,90 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/dinkur/dinkur/internal/console"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var undoCmd = &cobra.Command{
+		Use:   "undo",
+		Args:  cobra.NoArgs,
+		Short: "Undo the latest change to entries",
+		Long: fmt.Sprintf(`Reverts the latest change to entries that has not already been undone.
+
+Every change to entries, such as from the "in", "out", "edit", "remove", and
+"import" commands, is recorded in a journal. Undoing a change reverts all
+entries affected by it, so undoing "in" both removes the new entry and resumes
+the entry it stopped. Removed entries are added back in with their original
+IDs.
+
+Changes can be undone multiple times in a row to step further back, and any
+undone change can be reapplied with "%[1]s redo".
+`, RootCmd.Name()),
+		Run: func(cmd *cobra.Command, args []string) {
+			connectClientOrExit()
+			result, err := c.Undo(rootCtx)
+			if err != nil {
+				console.PrintFatal("Error undoing change:", err)
+			}
+			printJournalResult("Undid", result)
+		},
+	}
+
+	RootCmd.AddCommand(undoCmd)
+}
+
+func printJournalResult(verb string, result dinkur.JournalResult) {
+	if len(result.Changes) == 1 {
+		fmt.Printf("%s %s of 1 entry.\n", verb, result.Action)
+	} else {
+		fmt.Printf("%s %s of %d entries.\n", verb, result.Action, len(result.Changes))
+	}
+	printEntryChanges(result.Changes)
+}
+
+func printEntryChanges(changes []dinkur.EntryChange) {
+	for _, change := range changes {
+		fmt.Println()
+		switch {
+		case change.Before == nil && change.After != nil:
+			console.PrintEntryLabel(console.LabelledEntry{
+				Label: "Added entry:",
+				Entry: *change.After,
+			})
+		case change.After == nil && change.Before != nil:
+			console.PrintEntryLabel(console.LabelledEntry{
+				Label: "Deleted entry:",
+				Entry: *change.Before,
+			})
+		case change.Before != nil && change.After != nil:
+			console.PrintEntryEdit(dinkur.UpdatedEntry{
+				Before: *change.Before,
+				After:  *change.After,
+			})
+		}
+	}
+}
@@ -29,6 +29,9 @@ var camelCaseReplacer = strings.NewReplacer(
 	"JSON", "Json",
 	"YAML", "Yaml",
 	"API", "Api",
+	"TLS", "Tls",
+	"CA", "Ca",
+	"AFK", "Afk",
 )
 
 // ToCamelCase is a very stupid implementation for converting
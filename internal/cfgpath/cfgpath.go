@@ -27,10 +27,28 @@ var (
 	// DataPath is the full path (including file name and extension) of the
 	// database file. E.g "~/.local/share/dinkur/dinkur.db"
 	DataPath string
+	// TokenPath is the full path (including file name and extension) of the
+	// file containing the daemon's gRPC API authentication token.
+	// E.g "~/.local/share/dinkur/daemon.token"
+	TokenPath string
+	// TLSPinPath is the full path (including file name and extension) of the
+	// file containing TLS certificates pinned by clients on first use.
+	// E.g "~/.local/share/dinkur/pinned_certs"
+	TLSPinPath string
+	// DaemonAddress is the default address of the daemon's gRPC API, either
+	// as a TCP/IP hostname and port, or as a Unix domain socket.
+	// E.g "unix:///run/user/1000/dinkur.sock"
+	DaemonAddress string
 )
 
 func init() {
 	ConfigPath = getConfigPath()
 
 	DataPath = getDataPath()
+
+	TokenPath = getTokenPath()
+
+	TLSPinPath = getTLSPinPath()
+
+	DaemonAddress = getDaemonAddress()
 }
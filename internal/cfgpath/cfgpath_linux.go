@@ -23,6 +23,7 @@ package cfgpath
 import (
 	"os"
 	"path/filepath"
+	"strconv"
 )
 
 func getConfigPath() string {
@@ -38,9 +39,20 @@ func getConfigPath() string {
 }
 
 func getDataPath() string {
-	const filename = "dinkur.db"
-	if xdgConfig, ok := os.LookupEnv("XDG_DATA_HOME"); ok {
-		return filepath.Join(xdgConfig, "dinkur", filename)
+	return getDataFilePath("dinkur.db")
+}
+
+func getTokenPath() string {
+	return getDataFilePath("daemon.token")
+}
+
+func getTLSPinPath() string {
+	return getDataFilePath("pinned_certs")
+}
+
+func getDataFilePath(filename string) string {
+	if xdgData, ok := os.LookupEnv("XDG_DATA_HOME"); ok {
+		return filepath.Join(xdgData, "dinkur", filename)
 	}
 	home, err := os.UserHomeDir()
 	if err != nil {
@@ -48,3 +60,11 @@ func getDataPath() string {
 	}
 	return filepath.Join(home, ".local", "share", "dinkur", filename)
 }
+
+func getDaemonAddress() string {
+	const filename = "dinkur.sock"
+	if xdgRuntime, ok := os.LookupEnv("XDG_RUNTIME_DIR"); ok {
+		return "unix://" + filepath.Join(xdgRuntime, filename)
+	}
+	return "unix://" + filepath.Join("/run/user", strconv.Itoa(os.Getuid()), filename)
+}
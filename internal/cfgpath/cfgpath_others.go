@@ -43,3 +43,23 @@ func getDataPath() string {
 	}
 	return filepath.Join(configDir, ".dinkur.db")
 }
+
+func getTokenPath() string {
+	configDir, err := os.UserHomeDir()
+	if err != nil {
+		return "dinkur.token"
+	}
+	return filepath.Join(configDir, ".dinkur.token")
+}
+
+func getTLSPinPath() string {
+	configDir, err := os.UserHomeDir()
+	if err != nil {
+		return "dinkur_pinned_certs"
+	}
+	return filepath.Join(configDir, ".dinkur_pinned_certs")
+}
+
+func getDaemonAddress() string {
+	return "localhost:59122"
+}
@@ -48,3 +48,31 @@ func getDataPath() string {
 	}
 	return filepath.Join(home, ".dinkur.db")
 }
+
+func getTokenPath() string {
+	appdata, ok := os.LookupEnv("APPDATA")
+	if ok {
+		return filepath.Join(appdata, "dinkur", "daemon.token")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "dinkur.token"
+	}
+	return filepath.Join(home, ".dinkur.token")
+}
+
+func getTLSPinPath() string {
+	appdata, ok := os.LookupEnv("APPDATA")
+	if ok {
+		return filepath.Join(appdata, "dinkur", "pinned_certs")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "dinkur_pinned_certs"
+	}
+	return filepath.Join(home, ".dinkur_pinned_certs")
+}
+
+func getDaemonAddress() string {
+	return "localhost:59122"
+}
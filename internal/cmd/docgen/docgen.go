@@ -44,6 +44,8 @@ func main() {
 	}
 
 	cmd.RootCmd.PersistentFlags().Lookup("sqlite.path").DefValue = "~/.local/share/dinkur/dinkur.db"
+	cmd.RootCmd.PersistentFlags().Lookup("grpc.address").DefValue = "unix:///run/user/$UID/dinkur.sock"
+	cmd.RootCmd.PersistentFlags().Lookup("daemon.address").DefValue = "unix:///run/user/$UID/dinkur.sock"
 	if err := doc.GenMarkdownTree(cmd.RootCmd, path); err != nil {
 		log.Fatalln("Error generating markdown tree:", err)
 	}
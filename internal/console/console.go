@@ -24,7 +24,9 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/fatih/color"
@@ -52,6 +54,32 @@ var (
 	entryEndNilTextNow        = "now…"
 	entryEndNilTextActive     = "active…"
 	entryDurationColor        = color.New(color.FgCyan)
+	entryNoteColor            = color.New(color.FgWhite)
+	entryNoteHighlightColor   = color.New(color.FgHiWhite, color.Underline)
+	entryNoteMoreText         = "…"
+	entryNoteRowPrefix        = "↳ "
+	entryTagColor             = color.New(color.FgBlue)
+	entryTagDelim             = " "
+	entryDeletedColor         = color.New(color.FgRed)
+	projectNameColor          = color.New(color.FgMagenta)
+	projectClientColor        = color.New(color.FgHiMagenta)
+	projectClientDelimColor   = color.New(color.FgHiBlack)
+	projectClientDelim        = "/"
+	templateNameColor         = color.New(color.FgHiBlue)
+	templateScheduleColor     = color.New(color.FgGreen)
+	focusWorkColor            = color.New(color.FgHiRed)
+	focusBreakColor           = color.New(color.FgHiGreen)
+	goalPeriodColor           = color.New(color.FgGreen)
+	goalBarDoneColor          = color.New(color.FgHiGreen)
+	goalBarTodoColor          = color.New(color.FgHiBlack)
+	goalBarReachedColor       = color.New(color.FgGreen)
+	goalBarWidth              = 20
+	goalBarDone               = "█"
+	goalBarTodo               = "░"
+	reportKeyColor            = color.New(color.FgGreen)
+	balancePositiveColor      = color.New(color.FgGreen)
+	balanceNegativeColor      = color.New(color.FgRed)
+	backupFileColor           = color.New(color.FgYellow)
 	entryEditDelimColor       = color.New(color.FgHiMagenta)
 	entryEditNoneColor        = color.New(color.FgHiBlack, color.Italic)
 
@@ -124,8 +152,21 @@ func PrintFatal(label string, v any) {
 // PrintEntryEdit writes a formatted entry and highlights any edits made to it,
 // by diffing the before and after entries, to STDOUT.
 func PrintEntryEdit(update dinkur.UpdatedEntry) {
+	printEntryEditLabel("Updated entry ", update)
+}
+
+// PrintEntryEditPreview writes formatted entries and highlights the edits that
+// are about to be made to them, by diffing the before and after entries, to
+// STDOUT.
+func PrintEntryEditPreview(updates []dinkur.UpdatedEntry) {
+	for _, update := range updates {
+		printEntryEditLabel("Will update entry ", update)
+	}
+}
+
+func printEntryEditLabel(label string, update dinkur.UpdatedEntry) {
 	var sb strings.Builder
-	entryLabelColor.Fprint(&sb, "Updated entry ")
+	entryLabelColor.Fprint(&sb, label)
 	entryIDColor.Fprint(&sb, "#", update.After.ID)
 	sb.WriteByte(' ')
 	writeEntryName(&sb, update.After.Name)
@@ -141,6 +182,12 @@ func PrintEntryEdit(update dinkur.UpdatedEntry) {
 		writeCellEntryName(&t, update.After.Name)
 		t.CommitRow()
 	}
+	if update.Before.Note != update.After.Note {
+		writeCellEntryNote(&t, update.Before.Note)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellEntryNote(&t, update.After.Note)
+		t.CommitRow()
+	}
 	if !timesEqual(update.Before.Start, update.After.Start) ||
 		!timesPtrsEqual(update.Before.End, update.After.End) {
 		writeCellEntryTimeSpanDuration(&t, update.Before.Start, update.Before.End, update.Before.Elapsed())
@@ -148,6 +195,18 @@ func PrintEntryEdit(update dinkur.UpdatedEntry) {
 		writeCellEntryTimeSpanDuration(&t, update.After.Start, update.After.End, update.After.Elapsed())
 		t.CommitRow()
 	}
+	if !projectPtrsEqual(update.Before.Project, update.After.Project) {
+		writeCellProject(&t, update.Before.Project)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellProject(&t, update.After.Project)
+		t.CommitRow()
+	}
+	if !dinkur.TagsEqual(update.Before.Tags, update.After.Tags) {
+		writeCellEntryTags(&t, update.Before.Tags)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellEntryTags(&t, update.After.Tags)
+		t.CommitRow()
+	}
 	if t.Rows() == 0 {
 		entryEditNoneColor.Fprintln(stdout, entryEditPrefix, entryEditNoChange)
 	} else {
@@ -168,19 +227,11 @@ func PrintEntryListSearched(entries []dinkur.Entry, searchStart, searchEnd strin
 		tableEmptyColor.Fprintln(stdout, tableEmptyText)
 		return
 	}
-	var reg *regexp.Regexp
-	if searchStart != "" || searchEnd != "" {
-		var err error
-		reg, err = regexp.Compile(fmt.Sprintf("%s(.*?)%s",
-			regexp.QuoteMeta(searchStart), regexp.QuoteMeta(searchEnd)))
-		if err != nil {
-			PrintFatal("Failed to compile highlight regex:", err)
-		}
-	}
+	reg := compileHighlightRegex(searchStart, searchEnd)
 	var t table
 	t.SetSpacing("  ")
 	t.SetPrefix("  ")
-	t.WriteColoredRow(tableHeaderColor, "ID", "NAME", "DAY", "START", "END", "DURATION")
+	t.WriteColoredRow(tableHeaderColor, "ID", "NAME", "DAY", "START", "END", "DURATION", "PROJECT", "TAGS")
 	for i, group := range groupEntriesByDate(entries) {
 		if i > 0 {
 			t.CommitRow() // commit empty delimiting row
@@ -199,23 +250,562 @@ func PrintEntryListSearched(entries []dinkur.Entry, searchStart, searchEnd strin
 			}
 			writeCellEntryStartEnd(&t, entry.Start, entry.End)
 			writeCellDuration(&t, entry.Elapsed())
+			writeCellProject(&t, entry.Project)
+			writeCellEntryTags(&t, entry.Tags)
 			t.CommitRow()
+			if reg != nil && reg.MatchString(entry.Note) {
+				t.WriteCell("")
+				writeCellEntryNoteSearched(&t, entry.Note, reg)
+				t.CommitRow()
+			}
 		}
 	}
-	sum := sumEntries(entries)
 	t.CommitRow() // commit empty delimiting row
+	writeRowEntrySum(&t, "TOTAL", entries, 1, 2)
+	t.Fprintln(stdout)
+}
+
+// PrintEntryImport writes the imported entries and the skipped duplicate
+// entries to STDOUT. If dry run is enabled, then it is instead worded as what
+// would be imported.
+func PrintEntryImport(imported, duplicates []dinkur.Entry, dryRun bool) {
+	importedLabel, duplicatesLabel := "Imported", "Skipped"
+	if dryRun {
+		importedLabel, duplicatesLabel = "Would import", "Would skip"
+	}
+	entryLabelColor.Fprintf(stdout, "%s %d entries:\n", importedLabel, len(imported))
+	PrintEntryList(imported)
+	if len(duplicates) > 0 {
+		fmt.Fprintln(stdout)
+		entryLabelColor.Fprintf(stdout, "%s %d duplicate entries:\n", duplicatesLabel, len(duplicates))
+		PrintEntryList(duplicates)
+	}
+}
+
+// PrintTrashedEntryList writes a table for a list of entries in the trash,
+// including when they were removed, to STDOUT.
+func PrintTrashedEntryList(entries []dinkur.Entry) {
+	if len(entries) == 0 {
+		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.SetPrefix("  ")
+	t.WriteColoredRow(tableHeaderColor, "ID", "NAME", "DAY", "START", "END", "DURATION", "PROJECT", "TAGS", "REMOVED")
+	for _, entry := range entries {
+		writeCellEntryID(&t, entry.ID)
+		writeCellEntryName(&t, entry.Name)
+		writeCellDate(&t, newDate(entry.Start.Date()))
+		writeCellEntryStartEnd(&t, entry.Start, entry.End)
+		writeCellDuration(&t, entry.Elapsed())
+		writeCellProject(&t, entry.Project)
+		writeCellEntryTags(&t, entry.Tags)
+		if entry.DeletedAt != nil {
+			writeCellTimeColor(&t, *entry.DeletedAt, timeFormatLong, entryDeletedColor)
+		} else {
+			t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		}
+		t.CommitRow()
+	}
+	t.Fprintln(stdout)
+}
+
+// PrintTrashedEntryPurge writes the entries that were permanently deleted from
+// the trash to STDOUT.
+func PrintTrashedEntryPurge(purged []dinkur.Entry) {
+	entryLabelColor.Fprintf(stdout, "Purged %d entries from the trash:\n", len(purged))
+	PrintTrashedEntryList(purged)
+}
+
+// PrintEntryOverlapList writes a table for a list of overlapping pairs of
+// entries, including for how long they overlap, to STDOUT.
+func PrintEntryOverlapList(overlaps []dinkur.EntryOverlap) {
+	if len(overlaps) == 0 {
+		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.SetPrefix("  ")
+	t.WriteColoredRow(tableHeaderColor, "ID", "NAME", "DAY", "START", "END", "ID", "NAME", "START", "END", "OVERLAP")
+	for _, overlap := range overlaps {
+		writeCellEntryID(&t, overlap.First.ID)
+		writeCellEntryName(&t, overlap.First.Name)
+		writeCellDate(&t, newDate(overlap.First.Start.Date()))
+		writeCellEntryStartEnd(&t, overlap.First.Start, overlap.First.End)
+		writeCellEntryID(&t, overlap.Second.ID)
+		writeCellEntryName(&t, overlap.Second.Name)
+		writeCellEntryStartEnd(&t, overlap.Second.Start, overlap.Second.End)
+		writeCellDuration(&t, overlapDuration(overlap))
+		t.CommitRow()
+	}
+	t.Fprintln(stdout)
+}
+
+// PrintEntryGapList writes a table for a list of gaps between entries to
+// STDOUT, followed by a total row.
+func PrintEntryGapList(gaps []dinkur.EntryGap) {
+	if len(gaps) == 0 {
+		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.SetPrefix("  ")
+	t.WriteColoredRow(tableHeaderColor, "DAY", "START", "END", "DURATION", "PREVIOUS", "", "NEXT", "")
+	var total time.Duration
+	for _, gap := range gaps {
+		writeCellDate(&t, newDate(gap.Start.Date()))
+		writeCellEntryStartEnd(&t, gap.Start, &gap.End)
+		writeCellDuration(&t, gap.Duration())
+		writeCellEntryIDAndNamePtr(&t, gap.Previous)
+		writeCellEntryIDAndNamePtr(&t, gap.Next)
+		t.CommitRow()
+		total += gap.Duration()
+	}
+	t.CommitRow() // commit empty delimiting row
+	t.WriteColoredRow(tableSummaryColor,
+		fmt.Sprintf("TOTAL: %d gaps", len(gaps)), // DAY
+		tableCellEmptyText,                       // START
+		tableCellEmptyText,                       // END
+		FormatDuration(total),                    // DURATION
+		tableCellEmptyText,                       // PREVIOUS
+		tableCellEmptyText,
+		tableCellEmptyText, // NEXT
+		tableCellEmptyText,
+	)
+	t.Fprintln(stdout)
+}
+
+// PrintEntryListByProject writes a table for a list of entries, grouped by
+// the project, to STDOUT, as well as highlighting search terms (if any).
+func PrintEntryListByProject(entries []dinkur.Entry, searchStart, searchEnd string) {
+	if len(entries) == 0 {
+		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		return
+	}
+	reg := compileHighlightRegex(searchStart, searchEnd)
+	var t table
+	t.SetSpacing("  ")
+	t.SetPrefix("  ")
+	t.WriteColoredRow(tableHeaderColor, "PROJECT", "ID", "NAME", "DAY", "START", "END", "DURATION", "TAGS")
+	for i, group := range groupEntriesByProject(entries) {
+		if i > 0 {
+			t.CommitRow() // commit empty delimiting row
+		}
+		for i, entry := range group.entries {
+			if i == 0 {
+				writeCellProject(&t, group.project)
+			} else {
+				t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+			}
+			writeCellEntryID(&t, entry.ID)
+			if reg != nil {
+				writeCellEntryNameSearched(&t, entry.Name, reg)
+			} else {
+				writeCellEntryName(&t, entry.Name)
+			}
+			writeCellDate(&t, newDate(entry.Start.Date()))
+			writeCellEntryStartEnd(&t, entry.Start, entry.End)
+			writeCellDuration(&t, entry.Elapsed())
+			writeCellEntryTags(&t, entry.Tags)
+			t.CommitRow()
+			if reg != nil && reg.MatchString(entry.Note) {
+				t.WriteCell("")
+				t.WriteCell("")
+				writeCellEntryNoteSearched(&t, entry.Note, reg)
+				t.CommitRow()
+			}
+		}
+		writeRowEntrySum(&t, "SUBTOTAL", group.entries, 2, 1)
+	}
+	t.CommitRow() // commit empty delimiting row
+	writeRowEntrySum(&t, "TOTAL", entries, 2, 1)
+	t.Fprintln(stdout)
+}
+
+func compileHighlightRegex(searchStart, searchEnd string) *regexp.Regexp {
+	if searchStart == "" && searchEnd == "" {
+		return nil
+	}
+	reg, err := regexp.Compile(fmt.Sprintf("%s(.*?)%s",
+		regexp.QuoteMeta(searchStart), regexp.QuoteMeta(searchEnd)))
+	if err != nil {
+		PrintFatal("Failed to compile highlight regex:", err)
+	}
+	return reg
+}
+
+// writeRowEntrySum writes a summary row of the entries, with a number of empty
+// cells before the NAME column and after the DURATION column.
+func writeRowEntrySum(t *table, label string, entries []dinkur.Entry, emptyBefore, emptyAfter int) {
+	sum := sumEntries(entries)
 	endStr := entryEndNilTextActive
 	if sum.end != nil {
 		endStr = sum.end.Format(timeFormatShort)
 	}
-	t.WriteColoredRow(tableSummaryColor,
-		tableCellEmptyText, // ID
-		fmt.Sprintf("TOTAL: %d entries", len(entries)), // NAME
+	var cells []string
+	for i := 0; i < emptyBefore; i++ {
+		cells = append(cells, tableCellEmptyText)
+	}
+	cells = append(cells,
+		fmt.Sprintf("%s: %d entries", label, len(entries)), // NAME
 		tableCellEmptyText,                // DAY
 		sum.start.Format(timeFormatShort), // START
 		endStr,                            // END
 		FormatDuration(sum.duration),      // DURATION
 	)
+	for i := 0; i < emptyAfter; i++ {
+		cells = append(cells, tableCellEmptyText)
+	}
+	t.WriteColoredRow(tableSummaryColor, cells...)
+}
+
+// ReportRow is a single aggregated row in a time report, such as the total
+// time spent on a given day or with a given tag.
+type ReportRow struct {
+	Key      string
+	Entries  int
+	Duration time.Duration
+}
+
+// PrintReport writes a table of aggregated report rows to STDOUT, followed by
+// a total row. The key header is used as the title of the first column.
+func PrintReport(keyHeader string, rows []ReportRow, total ReportRow) {
+	if len(rows) == 0 {
+		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.SetPrefix("  ")
+	t.WriteColoredRow(tableHeaderColor, keyHeader, "ENTRIES", "DURATION", "HOURS")
+	for _, row := range rows {
+		if row.Key == "" {
+			t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		} else {
+			t.WriteCellColor(row.Key, reportKeyColor)
+		}
+		t.WriteCell(strconv.Itoa(row.Entries))
+		writeCellDuration(&t, row.Duration)
+		t.WriteCellColor(FormatHours(row.Duration), entryDurationColor)
+		t.CommitRow()
+	}
+	t.CommitRow() // commit empty delimiting row
+	t.WriteColoredRow(tableSummaryColor,
+		"TOTAL",
+		strconv.Itoa(total.Entries),
+		FormatDuration(total.Duration),
+		FormatHours(total.Duration),
+	)
+	t.Fprintln(stdout)
+}
+
+// BalanceRow is a single period in a flex time balance, such as a week or a
+// month, where the balance is accumulated from all periods up until and
+// including this one.
+type BalanceRow struct {
+	Key          string
+	Start        time.Time
+	End          time.Time
+	WorkDays     int
+	Holidays     int
+	VacationDays int
+	Expected     time.Duration
+	Tracked      time.Duration
+	Balance      time.Duration
+}
+
+// PrintBalance writes a table of flex time balance rows to STDOUT, followed by
+// a total row. The key header is used as the title of the first column.
+func PrintBalance(keyHeader string, rows []BalanceRow, total BalanceRow) {
+	if len(rows) == 0 {
+		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.SetPrefix("  ")
+	t.WriteColoredRow(tableHeaderColor, keyHeader, "DAYS", "OFF", "EXPECTED", "TRACKED", "DIFF", "BALANCE")
+	for _, row := range rows {
+		t.WriteCellColor(row.Key, reportKeyColor)
+		t.WriteCell(strconv.Itoa(row.WorkDays))
+		t.WriteCell(strconv.Itoa(row.Holidays + row.VacationDays))
+		t.WriteCellColor(FormatHours(row.Expected), entryDurationColor)
+		t.WriteCellColor(FormatHours(row.Tracked), entryDurationColor)
+		writeCellBalance(&t, row.Tracked-row.Expected)
+		writeCellBalance(&t, row.Balance)
+		t.CommitRow()
+	}
+	t.CommitRow() // commit empty delimiting row
+	t.WriteColoredRow(tableSummaryColor,
+		"TOTAL",
+		strconv.Itoa(total.WorkDays),
+		strconv.Itoa(total.Holidays+total.VacationDays),
+		FormatHours(total.Expected),
+		FormatHours(total.Tracked),
+		FormatHours(total.Tracked-total.Expected),
+		FormatHours(total.Balance),
+	)
+	t.Fprintln(stdout)
+}
+
+// PrintProjectLabel writes a label string followed by a formatted project to
+// STDOUT.
+func PrintProjectLabel(label string, project dinkur.Project) {
+	var t table
+	t.SetSpacing("  ")
+	t.WriteColoredRow(tableHeaderColor, "", "ID", "NAME", "CLIENT")
+	t.WriteCellColor(label, entryLabelColor)
+	writeCellsProject(&t, project)
+	t.CommitRow()
+	t.Fprintln(stdout)
+}
+
+// PrintBackupLabel writes a label string followed by the backup file name to
+// STDERR, so it does not interfere with backups written to STDOUT.
+func PrintBackupLabel(label, fileName string) {
+	var sb strings.Builder
+	entryLabelColor.Fprint(&sb, label)
+	sb.WriteByte(' ')
+	backupFileColor.Fprint(&sb, fileName)
+	fmt.Fprintln(stderr, sb.String())
+}
+
+// PrintProjectList writes a table for a list of projects to STDOUT.
+func PrintProjectList(projects []dinkur.Project) {
+	if len(projects) == 0 {
+		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.SetPrefix("  ")
+	t.WriteColoredRow(tableHeaderColor, "ID", "NAME", "CLIENT")
+	for _, project := range projects {
+		writeCellsProject(&t, project)
+		t.CommitRow()
+	}
+	t.Fprintln(stdout)
+}
+
+// PrintProjectEdit writes a formatted project and highlights any edits made
+// to it, by diffing the before and after projects, to STDOUT.
+func PrintProjectEdit(update dinkur.UpdatedProject) {
+	var sb strings.Builder
+	entryLabelColor.Fprint(&sb, "Updated project ")
+	entryIDColor.Fprint(&sb, "#", update.After.ID)
+	sb.WriteByte(' ')
+	writeProject(&sb, update.After)
+	entryLabelColor.Fprint(&sb, ":")
+	fmt.Fprintln(stdout, sb.String())
+
+	var t table
+	t.SetPrefix(entryEditPrefix)
+	t.SetSpacing(entryEditSpacing)
+	if update.Before.Name != update.After.Name {
+		writeCellProjectName(&t, update.Before.Name)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellProjectName(&t, update.After.Name)
+		t.CommitRow()
+	}
+	if update.Before.Client != update.After.Client {
+		writeCellProjectClient(&t, update.Before.Client)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellProjectClient(&t, update.After.Client)
+		t.CommitRow()
+	}
+	if t.Rows() == 0 {
+		entryEditNoneColor.Fprintln(stdout, entryEditPrefix, entryEditNoChange)
+	} else {
+		t.Fprintln(stdout)
+	}
+}
+
+// PrintTemplateLabel writes a label string followed by a formatted template
+// to STDOUT.
+func PrintTemplateLabel(label string, template dinkur.Template) {
+	var t table
+	t.SetSpacing("  ")
+	t.WriteColoredRow(tableHeaderColor, "", "ID", "NAME", "ENTRY", "DURATION", "TAGS", "SCHEDULE")
+	t.WriteCellColor(label, entryLabelColor)
+	writeCellsTemplate(&t, template)
+	t.CommitRow()
+	t.Fprintln(stdout)
+}
+
+// PrintTemplateList writes a table for a list of templates to STDOUT.
+func PrintTemplateList(templates []dinkur.Template) {
+	if len(templates) == 0 {
+		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.SetPrefix("  ")
+	t.WriteColoredRow(tableHeaderColor, "ID", "NAME", "ENTRY", "DURATION", "TAGS", "SCHEDULE")
+	for _, template := range templates {
+		writeCellsTemplate(&t, template)
+		t.CommitRow()
+	}
+	t.Fprintln(stdout)
+}
+
+// PrintTemplateEdit writes a formatted template and highlights any edits made
+// to it, by diffing the before and after templates, to STDOUT.
+func PrintTemplateEdit(update dinkur.UpdatedTemplate) {
+	var sb strings.Builder
+	entryLabelColor.Fprint(&sb, "Updated template ")
+	entryIDColor.Fprint(&sb, "#", update.After.ID)
+	sb.WriteByte(' ')
+	templateNameColor.Fprint(&sb, update.After.Name)
+	entryLabelColor.Fprint(&sb, ":")
+	fmt.Fprintln(stdout, sb.String())
+
+	var t table
+	t.SetPrefix(entryEditPrefix)
+	t.SetSpacing(entryEditSpacing)
+	if update.Before.Name != update.After.Name {
+		writeCellTemplateName(&t, update.Before.Name)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellTemplateName(&t, update.After.Name)
+		t.CommitRow()
+	}
+	if update.Before.EntryName != update.After.EntryName {
+		writeCellEntryName(&t, update.Before.EntryName)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellEntryName(&t, update.After.EntryName)
+		t.CommitRow()
+	}
+	if update.Before.Duration != update.After.Duration {
+		writeCellTemplateDuration(&t, update.Before.Duration)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellTemplateDuration(&t, update.After.Duration)
+		t.CommitRow()
+	}
+	if !dinkur.TagsEqual(update.Before.Tags, update.After.Tags) {
+		writeCellEntryTags(&t, update.Before.Tags)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellEntryTags(&t, update.After.Tags)
+		t.CommitRow()
+	}
+	before := formatTemplateSchedule(update.Before.Recurrence)
+	after := formatTemplateSchedule(update.After.Recurrence)
+	if before != after {
+		writeCellTemplateSchedule(&t, update.Before.Recurrence)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellTemplateSchedule(&t, update.After.Recurrence)
+		t.CommitRow()
+	}
+	if t.Rows() == 0 {
+		entryEditNoneColor.Fprintln(stdout, entryEditPrefix, entryEditNoChange)
+	} else {
+		t.Fprintln(stdout)
+	}
+}
+
+// PrintGoalLabel writes a label string followed by a formatted goal to
+// STDOUT.
+func PrintGoalLabel(label string, goal dinkur.Goal) {
+	var t table
+	t.SetSpacing("  ")
+	t.WriteColoredRow(tableHeaderColor, "", "ID", "PER", "TARGET", "PROJECT")
+	t.WriteCellColor(label, entryLabelColor)
+	writeCellsGoal(&t, goal)
+	t.CommitRow()
+	t.Fprintln(stdout)
+}
+
+// PrintGoalList writes a table for a list of goals to STDOUT.
+func PrintGoalList(goals []dinkur.Goal) {
+	if len(goals) == 0 {
+		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.SetPrefix("  ")
+	t.WriteColoredRow(tableHeaderColor, "ID", "PER", "TARGET", "PROJECT")
+	for _, goal := range goals {
+		writeCellsGoal(&t, goal)
+		t.CommitRow()
+	}
+	t.Fprintln(stdout)
+}
+
+// PrintGoalEdit writes a formatted goal and highlights any edits made to it,
+// by diffing the before and after goals, to STDOUT.
+func PrintGoalEdit(update dinkur.UpdatedGoal) {
+	var sb strings.Builder
+	entryLabelColor.Fprint(&sb, "Updated goal ")
+	entryIDColor.Fprint(&sb, "#", update.After.ID)
+	entryLabelColor.Fprint(&sb, ":")
+	fmt.Fprintln(stdout, sb.String())
+
+	var t table
+	t.SetPrefix(entryEditPrefix)
+	t.SetSpacing(entryEditSpacing)
+	if update.Before.Period != update.After.Period {
+		writeCellGoalPeriod(&t, update.Before.Period)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellGoalPeriod(&t, update.After.Period)
+		t.CommitRow()
+	}
+	if update.Before.Target != update.After.Target {
+		writeCellDuration(&t, update.Before.Target)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellDuration(&t, update.After.Target)
+		t.CommitRow()
+	}
+	if !projectPtrsEqual(update.Before.Project, update.After.Project) {
+		writeCellProject(&t, update.Before.Project)
+		t.WriteCellColor(entryEditDelim, entryEditDelimColor)
+		writeCellProject(&t, update.After.Project)
+		t.CommitRow()
+	}
+	if t.Rows() == 0 {
+		entryEditNoneColor.Fprintln(stdout, entryEditPrefix, entryEditNoChange)
+	} else {
+		t.Fprintln(stdout)
+	}
+}
+
+// PrintGoalProgress writes a table of progress bars for the goals to STDOUT.
+func PrintGoalProgress(progress []dinkur.GoalProgress) {
+	if len(progress) == 0 {
+		tableEmptyColor.Fprintln(stdout, tableEmptyText)
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.SetPrefix("  ")
+	t.WriteColoredRow(tableHeaderColor, "ID", "PER", "PROJECT", "PROGRESS", "TRACKED", "TARGET")
+	for _, p := range progress {
+		writeCellEntryID(&t, p.Goal.ID)
+		writeCellGoalPeriod(&t, p.Goal.Period)
+		writeCellProject(&t, p.Goal.Project)
+		writeCellGoalBar(&t, p.Ratio())
+		writeCellDuration(&t, p.Tracked)
+		writeCellDuration(&t, p.Goal.Target)
+		t.CommitRow()
+	}
+	t.Fprintln(stdout)
+}
+
+// PrintFocusLabel writes a label string followed by a formatted focus timer
+// state to STDOUT.
+func PrintFocusLabel(label string, focus dinkur.Focus) {
+	if focus.Phase == dinkur.FocusPhaseIdle {
+		fmt.Fprintln(stdout, "No focus timer is running.")
+		return
+	}
+	var t table
+	t.SetSpacing("  ")
+	t.WriteColoredRow(tableHeaderColor, "", "PHASE", "NAME", "CYCLE", "ENDS", "REMAINING")
+	t.WriteCellColor(label, entryLabelColor)
+	writeCellsFocus(&t, focus)
+	t.CommitRow()
 	t.Fprintln(stdout)
 }
 
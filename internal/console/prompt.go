@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/dinkur/dinkur/internal/fuzzytime"
 	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/mattn/go-isatty"
 )
@@ -51,7 +52,7 @@ func PromptEntryRemoval(entry dinkur.Entry) error {
 	var sb strings.Builder
 	promptWarnIconColor.Fprint(&sb, promptWarnIconText)
 	sb.WriteByte(' ')
-	sb.WriteString("Warning: You are about to permanently remove entry ")
+	sb.WriteString("Warning: You are about to remove entry ")
 	writeEntryID(&sb, entry.ID)
 	sb.WriteByte(' ')
 	writeEntryName(&sb, entry.Name)
@@ -71,17 +72,214 @@ func PromptEntryRemoval(entry dinkur.Entry) error {
 	return nil
 }
 
-// AFKResolution states what should be changed as decided from the human's AFK
-// resolution.
-type AFKResolution struct {
-	Edit     *dinkur.EditEntry
-	NewEntry *dinkur.NewEntry
+// PromptProjectRemoval asks the user for confirmation about removing a
+// project. Will return an io.EOF error if the current TTY is not an
+// interactive session.
+func PromptProjectRemoval(project dinkur.Project) error {
+	var sb strings.Builder
+	promptWarnIconColor.Fprint(&sb, promptWarnIconText)
+	sb.WriteByte(' ')
+	sb.WriteString("Warning: You are about to permanently remove project ")
+	writeEntryID(&sb, project.ID)
+	sb.WriteByte(' ')
+	writeProject(&sb, project)
+	sb.WriteByte('.')
+	fmt.Fprintln(stderr, sb.String())
+	var ok bool
+	prompt := &survey.Confirm{
+		Message: "Are you sure?",
+	}
+	if err := survey.AskOne(prompt, &ok); err != nil {
+		return convPromptErr(err)
+	}
+	if !ok {
+		fmt.Println("Aborted by user.")
+		os.Exit(1)
+	}
+	return nil
+}
+
+// PromptTemplateRemoval asks the user for confirmation about removing a
+// template. Will return an io.EOF error if the current TTY is not an
+// interactive session.
+func PromptTemplateRemoval(template dinkur.Template) error {
+	var sb strings.Builder
+	promptWarnIconColor.Fprint(&sb, promptWarnIconText)
+	sb.WriteByte(' ')
+	sb.WriteString("Warning: You are about to permanently remove template ")
+	writeEntryID(&sb, template.ID)
+	sb.WriteByte(' ')
+	templateNameColor.Fprint(&sb, template.Name)
+	sb.WriteByte('.')
+	fmt.Fprintln(stderr, sb.String())
+	var ok bool
+	prompt := &survey.Confirm{
+		Message: "Are you sure?",
+	}
+	if err := survey.AskOne(prompt, &ok); err != nil {
+		return convPromptErr(err)
+	}
+	if !ok {
+		fmt.Println("Aborted by user.")
+		os.Exit(1)
+	}
+	return nil
+}
+
+// PromptGoalRemoval asks the user for confirmation about removing a goal.
+// Will return an io.EOF error if the current TTY is not an interactive
+// session.
+func PromptGoalRemoval(goal dinkur.Goal) error {
+	var sb strings.Builder
+	promptWarnIconColor.Fprint(&sb, promptWarnIconText)
+	sb.WriteByte(' ')
+	sb.WriteString("Warning: You are about to permanently remove goal ")
+	writeEntryID(&sb, goal.ID)
+	sb.WriteString(" of ")
+	entryDurationColor.Fprint(&sb, FormatDuration(goal.Target))
+	sb.WriteString(" per ")
+	goalPeriodColor.Fprint(&sb, goal.Period)
+	sb.WriteByte('.')
+	fmt.Fprintln(stderr, sb.String())
+	var ok bool
+	prompt := &survey.Confirm{
+		Message: "Are you sure?",
+	}
+	if err := survey.AskOne(prompt, &ok); err != nil {
+		return convPromptErr(err)
+	}
+	if !ok {
+		fmt.Println("Aborted by user.")
+		os.Exit(1)
+	}
+	return nil
+}
+
+// PromptBulkChange asks the user for confirmation about changing multiple
+// entries at once, where the verb is the kind of change, such as "update".
+// Will return an io.EOF error if the current TTY is not an interactive
+// session.
+func PromptBulkChange(verb string, count int) error {
+	var sb strings.Builder
+	promptWarnIconColor.Fprint(&sb, promptWarnIconText)
+	sb.WriteByte(' ')
+	fmt.Fprintf(&sb, "Warning: You are about to %s %d entries.", verb, count)
+	fmt.Fprintln(stderr, sb.String())
+	var ok bool
+	prompt := &survey.Confirm{
+		Message: "Are you sure?",
+	}
+	if err := survey.AskOne(prompt, &ok); err != nil {
+		return convPromptErr(err)
+	}
+	if !ok {
+		fmt.Println("Aborted by user.")
+		os.Exit(1)
+	}
+	return nil
+}
+
+// PromptEntryNote opens the user's text editor, as set by the $VISUAL or
+// $EDITOR environment variables, to write an entry note. The editor is
+// prefilled with the given note. Will return an io.EOF error if the current
+// TTY is not an interactive session.
+func PromptEntryNote(note string) (string, error) {
+	if note != "" && !strings.HasSuffix(note, "\n") {
+		note += "\n"
+	}
+	var newNote string
+	prompt := &survey.Editor{
+		Message:       "Enter note of entry:",
+		Default:       note,
+		AppendDefault: true,
+		HideDefault:   true,
+		FileName:      "dinkur-note-*.txt",
+	}
+	if err := survey.AskOne(prompt, &newNote); err != nil {
+		return "", convPromptErr(err)
+	}
+	return strings.TrimSpace(newNote), nil
+}
+
+// PromptEntryOverlapFix asks the user for confirmation about changing or
+// removing entries to resolve overlaps. Will return an io.EOF error if the
+// current TTY is not an interactive session.
+func PromptEntryOverlapFix(count int) error {
+	var sb strings.Builder
+	promptWarnIconColor.Fprint(&sb, promptWarnIconText)
+	sb.WriteByte(' ')
+	fmt.Fprintf(&sb, "Warning: You are about to resolve %d overlaps by trimming, splitting, or removing entries.", count)
+	fmt.Fprintln(stderr, sb.String())
+	var ok bool
+	prompt := &survey.Confirm{
+		Message: "Are you sure?",
+	}
+	if err := survey.AskOne(prompt, &ok); err != nil {
+		return convPromptErr(err)
+	}
+	if !ok {
+		fmt.Println("Aborted by user.")
+		os.Exit(1)
+	}
+	return nil
+}
+
+// PromptTrashPurge asks the user for confirmation about permanently deleting
+// entries from the trash. Will return an io.EOF error if the current TTY is
+// not an interactive session.
+func PromptTrashPurge(count int) error {
+	var sb strings.Builder
+	promptWarnIconColor.Fprint(&sb, promptWarnIconText)
+	sb.WriteByte(' ')
+	fmt.Fprintf(&sb, "Warning: You are about to permanently delete %d entries from the trash.", count)
+	fmt.Fprintln(stderr, sb.String())
+	var ok bool
+	prompt := &survey.Confirm{
+		Message: "Are you sure?",
+	}
+	if err := survey.AskOne(prompt, &ok); err != nil {
+		return convPromptErr(err)
+	}
+	if !ok {
+		fmt.Println("Aborted by user.")
+		os.Exit(1)
+	}
+	return nil
 }
 
-// PromptAFKResolution asks the user for how to resolve an AFK status.
-func PromptAFKResolution(activeEntry dinkur.Entry, afkSince time.Time) (AFKResolution, error) {
+// PromptRestore asks the user for confirmation about replacing all data with
+// a backup. Will return an io.EOF error if the current TTY is not an
+// interactive session.
+func PromptRestore(fileName string) error {
+	var sb strings.Builder
+	promptWarnIconColor.Fprint(&sb, promptWarnIconText)
+	sb.WriteByte(' ')
+	sb.WriteString("Warning: You are about to replace all entries, projects, and statuses with the backup ")
+	backupFileColor.Fprint(&sb, fileName)
+	sb.WriteByte('.')
+	fmt.Fprintln(stderr, sb.String())
+	var ok bool
+	prompt := &survey.Confirm{
+		Message: "Are you sure?",
+	}
+	if err := survey.AskOne(prompt, &ok); err != nil {
+		return convPromptErr(err)
+	}
+	if !ok {
+		fmt.Println("Aborted by user.")
+		os.Exit(1)
+	}
+	return nil
+}
+
+// PromptAFKResolution asks the user for how to resolve pending away time.
+func PromptAFKResolution(pending dinkur.PendingAway) (dinkur.ResolveAway, error) {
 	var sb strings.Builder
 	now := time.Now()
+	activeEntry := pending.ActiveEntry
+	afkSince := pending.AFKSince
+	// The daemon may already have stopped the entry at the time you went AFK.
+	autoStopped := activeEntry.End != nil
 
 	promptWarnIconColor.Fprint(&sb, promptWarnIconText)
 	sb.WriteString(" Note: You were away since ")
@@ -90,7 +288,11 @@ func PromptAFKResolution(activeEntry dinkur.Entry, afkSince time.Time) (AFKResol
 	writeEntryDurationWithDelim(&sb, now.Sub(afkSince))
 	sb.WriteByte('\n')
 	promptWarnIconColor.Fprint(&sb, promptWarnIconText)
-	sb.WriteString(" while having an active entry ")
+	if autoStopped {
+		sb.WriteString(" while having an automatically stopped entry ")
+	} else {
+		sb.WriteString(" while having an active entry ")
+	}
 	writeEntryID(&sb, activeEntry.ID)
 	sb.WriteByte(' ')
 	writeEntryName(&sb, activeEntry.Name)
@@ -102,24 +304,37 @@ func PromptAFKResolution(activeEntry dinkur.Entry, afkSince time.Time) (AFKResol
 		promptWarnIconColor.Fprint(&sb, promptWarnIconText)
 		sb.WriteString(" The terminal seems to be non-interactive. Skipping prompt.\n")
 		promptWarnIconColor.Fprint(&sb, promptWarnIconText)
+		if autoStopped {
+			sb.WriteString(` Assuming option "2. Discard the away time I was away, leaving the entry stopped."`)
+			fmt.Fprintln(stderr, sb.String())
+			entryEditNoneColor.Fprintln(stdout, entryEditPrefix, entryEditNoChange)
+			return dinkur.ResolveAway{Action: dinkur.AwayActionDiscard}, nil
+		}
 		sb.WriteString(` Assuming option "1. Leave the active entry as-is and continue with the invoked command."`)
 		fmt.Fprintln(stderr, sb.String())
 		entryEditNoneColor.Fprintln(stdout, entryEditPrefix, entryEditNoChange)
-		return AFKResolution{}, nil
+		return dinkur.ResolveAway{Action: dinkur.AwayActionKeep}, nil
 	}
 
 	sb.WriteString("How do you want to save this away time?\n")
 
-	sb.WriteString("  1. Leave the active entry as-is and continue with the invoked command.\n")
+	if autoStopped {
+		sb.WriteString("  1. Restart the stopped entry, including the away time in it.\n")
+		sb.WriteString("  2. Discard the away time I was away, leaving the entry stopped.\n")
+	} else {
+		sb.WriteString("  1. Leave the active entry as-is and continue with the invoked command.\n")
 
-	sb.WriteString("  2. Discard the away time I was away, changing active entry to ")
-	writeEntryTimeSpanNowDuration(&sb, activeEntry.Start, &afkSince, afkSince.Sub(activeEntry.Start))
-	sb.WriteString(".\n")
+		sb.WriteString("  2. Discard the away time I was away, changing active entry to ")
+		writeEntryTimeSpanNowDuration(&sb, activeEntry.Start, &afkSince, afkSince.Sub(activeEntry.Start))
+		sb.WriteString(".\n")
+	}
 
 	sb.WriteString("  3. Save the away time as a new entry ")
 	writeEntryTimeSpanNowDuration(&sb, afkSince, nil, now.Sub(afkSince))
 	sb.WriteString("  (naming it in a later prompt).\n")
 
+	sb.WriteString("  4. Split the away time into multiple new entries (naming them in later prompts).\n")
+
 	sb.WriteByte(' ')
 	promptCtrlCHelpColor.Fprint(&sb, "(press Ctrl+C to abort)")
 	sb.WriteByte('\n')
@@ -128,61 +343,253 @@ func PromptAFKResolution(activeEntry dinkur.Entry, afkSince time.Time) (AFKResol
 	fmt.Fprint(stderr, sb.String())
 
 	prompt := &survey.Input{
-		Message: "Select option [1-3]:",
+		Message: "Select option [1-4]:",
 	}
-	answerInt, err := promptIntRange(prompt, 1, 3)
+	answerInt, err := promptIntRange(prompt, 1, 4)
 	if err != nil {
-		return AFKResolution{}, err
+		return dinkur.ResolveAway{}, err
 	}
 
 	switch answerInt {
 	case 1:
 		// Leave the active entry as-is.
 		entryEditNoneColor.Fprintln(stdout, entryEditPrefix, entryEditNoChange)
-		return AFKResolution{}, nil
+		return dinkur.ResolveAway{Action: dinkur.AwayActionKeep}, nil
 
 	case 2:
 		// Discard the time
 		fmt.Fprintln(stderr, "Discarding the away time from the currently active entry.")
-		return AFKResolution{
-			Edit: &dinkur.EditEntry{
-				IDOrZero: activeEntry.ID,
-				End:      &afkSince,
-			},
-		}, nil
+		return dinkur.ResolveAway{Action: dinkur.AwayActionDiscard}, nil
 
 	case 3:
 		// Save the time as a new entry
-		return promptAFKSaveAsNewEntry(activeEntry, afkSince)
+		return promptAFKSaveAsNewEntry()
+
+	case 4:
+		// Split the time into multiple new entries
+		return promptAFKSplit(afkSince)
 
 	default:
-		return AFKResolution{}, errors.New("no answer chosen")
+		return dinkur.ResolveAway{}, errors.New("no answer chosen")
 	}
 }
 
-func promptAFKSaveAsNewEntry(activeEntry dinkur.Entry, afkSince time.Time) (AFKResolution, error) {
+func promptAFKSaveAsNewEntry() (dinkur.ResolveAway, error) {
 	name, err := promptNonEmptyString(&survey.Input{
 		Message: "Enter name of new entry:",
 	})
 	if err != nil {
-		return AFKResolution{}, err
+		return dinkur.ResolveAway{}, err
 	}
 	var sb strings.Builder
 	sb.WriteString("Saving the away time as a new entry with name ")
 	writeEntryName(&sb, name)
 	sb.WriteString(".\n")
 	fmt.Fprint(stderr, sb.String())
-	return AFKResolution{
-		Edit: &dinkur.EditEntry{
-			IDOrZero: activeEntry.ID,
-			End:      &afkSince,
+	return dinkur.ResolveAway{
+		Action: dinkur.AwayActionSaveAsNew,
+		Parts:  []dinkur.AwayPart{{Name: name}},
+	}, nil
+}
+
+func promptAFKSplit(afkSince time.Time) (dinkur.ResolveAway, error) {
+	var parts []dinkur.AwayPart
+	start := afkSince
+	for {
+		var name string
+		if len(parts) == 0 {
+			var err error
+			name, err = promptNonEmptyString(&survey.Input{
+				Message: "Enter name of entry 1:",
+			})
+			if err != nil {
+				return dinkur.ResolveAway{}, err
+			}
+		} else {
+			prompt := &survey.Input{
+				Message: fmt.Sprintf("Enter name of entry %d (leave empty to stop here):", len(parts)+1),
+			}
+			if err := survey.AskOne(prompt, &name); err != nil {
+				return dinkur.ResolveAway{}, convPromptErr(err)
+			}
+			if name == "" {
+				break
+			}
+		}
+		end, err := promptAFKSplitEnd(name, start)
+		if err != nil {
+			return dinkur.ResolveAway{}, err
+		}
+		parts = append(parts, dinkur.AwayPart{Name: name, End: end})
+		if end == nil {
+			break
+		}
+		start = *end
+	}
+	fmt.Fprintf(stderr, "Splitting the away time into %d new entries.\n", len(parts))
+	return dinkur.ResolveAway{
+		Action: dinkur.AwayActionSplit,
+		Parts:  parts,
+	}, nil
+}
+
+func promptAFKSplitEnd(name string, start time.Time) (*time.Time, error) {
+	var sb strings.Builder
+	sb.WriteString("Enter end time of ")
+	writeEntryName(&sb, name)
+	sb.WriteString(" (leave empty to keep it active):")
+	prompt := &survey.Input{
+		Message: sb.String(),
+	}
+	for {
+		var answer string
+		if err := survey.AskOne(prompt, &answer); err != nil {
+			return nil, convPromptErr(err)
+		}
+		if answer == "" {
+			return nil, nil
+		}
+		end, err := fuzzytime.Parse(answer, time.Now())
+		if err != nil {
+			promptErrorColor.Fprintf(stderr, "Invalid answer: %v\n\n", err)
+			continue
+		}
+		if !end.After(start) {
+			promptErrorColor.Fprintf(stderr, "Please enter a time after %s.\n\n", start.Format(timeFormatShort))
+			continue
+		}
+		return &end, nil
+	}
+}
+
+// GapResolution states what should be changed as decided from the human's gap
+// resolution. Both fields are nil if the gap should be left as-is.
+type GapResolution struct {
+	Edit     *dinkur.EditEntry
+	NewEntry *dinkur.NewEntry
+}
+
+// PromptGapResolution asks the user for how to fill a gap between two entries.
+// The previous and next entries are only offered to be extended if they
+// are adjacent to the gap.
+func PromptGapResolution(gap dinkur.EntryGap) (GapResolution, error) {
+	var sb strings.Builder
+
+	promptWarnIconColor.Fprint(&sb, promptWarnIconText)
+	sb.WriteString(" Gap ")
+	writeEntryTimeSpan(&sb, gap.Start, &gap.End, entryEndNilTextNow)
+	sb.WriteByte(' ')
+	writeEntryDurationWithDelim(&sb, gap.Duration())
+	switch {
+	case gap.Previous != nil && gap.Next != nil:
+		sb.WriteString(" between ")
+		writeEntryIDAndName(&sb, *gap.Previous)
+		sb.WriteString(" and ")
+		writeEntryIDAndName(&sb, *gap.Next)
+	case gap.Previous != nil:
+		sb.WriteString(" after ")
+		writeEntryIDAndName(&sb, *gap.Previous)
+	case gap.Next != nil:
+		sb.WriteString(" before ")
+		writeEntryIDAndName(&sb, *gap.Next)
+	}
+	sb.WriteString("\n\n")
+
+	// Reuse the same semantics as "dinkur in --after-id ID --before-id ID"
+	// when the gap is adjacent to the entries, so the entries' exact
+	// timestamps are used. The start and end times are still set, as they
+	// are used as fallback.
+	var (
+		startAfterIDOrZero uint
+		endBeforeIDOrZero  uint
+	)
+	if gap.Previous != nil && gap.Previous.End != nil && gap.Previous.End.Equal(gap.Start) {
+		startAfterIDOrZero = gap.Previous.ID
+	}
+	if gap.Next != nil && gap.Next.Start.Equal(gap.End) {
+		endBeforeIDOrZero = gap.Next.ID
+	}
+
+	type option struct {
+		text    string
+		resolve func() (GapResolution, error)
+	}
+	var options []option
+	if startAfterIDOrZero != 0 {
+		options = append(options, option{
+			text: "Assign the gap to the previous entry " + entryNameColor.Sprintf(entryNameFormat, gap.Previous.Name) + ".",
+			resolve: func() (GapResolution, error) {
+				return GapResolution{
+					Edit: &dinkur.EditEntry{
+						IDOrZero:          gap.Previous.ID,
+						End:               &gap.End,
+						EndBeforeIDOrZero: endBeforeIDOrZero,
+					},
+				}, nil
+			},
+		})
+	}
+	if endBeforeIDOrZero != 0 {
+		options = append(options, option{
+			text: "Assign the gap to the next entry " + entryNameColor.Sprintf(entryNameFormat, gap.Next.Name) + ".",
+			resolve: func() (GapResolution, error) {
+				return GapResolution{
+					Edit: &dinkur.EditEntry{
+						IDOrZero:           gap.Next.ID,
+						Start:              &gap.Start,
+						StartAfterIDOrZero: startAfterIDOrZero,
+					},
+				}, nil
+			},
+		})
+	}
+	options = append(options, option{
+		text: "Save the gap as a new entry (naming it in a later prompt).",
+		resolve: func() (GapResolution, error) {
+			name, err := promptNonEmptyString(&survey.Input{
+				Message: "Enter name of new entry:",
+			})
+			if err != nil {
+				return GapResolution{}, err
+			}
+			return GapResolution{
+				NewEntry: &dinkur.NewEntry{
+					Name:               name,
+					Start:              &gap.Start,
+					End:                &gap.End,
+					StartAfterIDOrZero: startAfterIDOrZero,
+					EndBeforeIDOrZero:  endBeforeIDOrZero,
+				},
+			}, nil
 		},
-		NewEntry: &dinkur.NewEntry{
-			Name:               name,
-			Start:              &afkSince,
-			StartAfterIDOrZero: activeEntry.ID,
+	})
+	options = append(options, option{
+		text: "Skip this gap.",
+		resolve: func() (GapResolution, error) {
+			entryEditNoneColor.Fprintln(stdout, entryEditPrefix, entryEditNoChange)
+			return GapResolution{}, nil
 		},
-	}, nil
+	})
+
+	sb.WriteString("How do you want to fill this gap?\n")
+	for i, opt := range options {
+		fmt.Fprintf(&sb, "  %d. %s\n", i+1, opt.text)
+	}
+	sb.WriteByte(' ')
+	promptCtrlCHelpColor.Fprint(&sb, "(press Ctrl+C to abort)")
+	sb.WriteByte('\n')
+	sb.WriteByte('\n')
+
+	fmt.Fprint(stderr, sb.String())
+
+	prompt := &survey.Input{
+		Message: fmt.Sprintf("Select option [1-%d]:", len(options)),
+	}
+	answerInt, err := promptIntRange(prompt, 1, len(options))
+	if err != nil {
+		return GapResolution{}, err
+	}
+	return options[answerInt-1].resolve()
 }
 
 // PromptDupEntryResolution asks the user for how to resolve creating a new
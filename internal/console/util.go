@@ -21,8 +21,13 @@ package console
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/dinkur/dinkur/internal/rrule"
+	"github.com/dinkur/dinkur/pkg/conv"
 	"github.com/dinkur/dinkur/pkg/dinkur"
 )
 
@@ -45,6 +50,12 @@ func FormatDuration(d time.Duration) string {
 	return fmt.Sprintf("%d:%02d:%02d", hours, minutes, seconds)
 }
 
+// FormatHours returns a formatted time.Duration as decimal hours with two
+// decimals, such as 1.50 for one and a half hour.
+func FormatHours(d time.Duration) string {
+	return strconv.FormatFloat(d.Hours(), 'f', 2, 64)
+}
+
 func newDate(year int, month time.Month, day int) date {
 	return date{year, month, day}
 }
@@ -87,6 +98,44 @@ func groupEntriesByDate(entries []dinkur.Entry) []entryDateGroup {
 	return groups
 }
 
+type entryProjectGroup struct {
+	project *dinkur.Project
+	entries []dinkur.Entry
+}
+
+// groupEntriesByProject groups the entries by their project, sorted by the
+// project's client and name, and with entries without a project last. The
+// order of the entries within each group is preserved.
+func groupEntriesByProject(entries []dinkur.Entry) []entryProjectGroup {
+	var groups []entryProjectGroup
+	groupIndexByID := map[uint]int{}
+	var noProject entryProjectGroup
+	for _, t := range entries {
+		if t.Project == nil {
+			noProject.entries = append(noProject.entries, t)
+			continue
+		}
+		idx, ok := groupIndexByID[t.Project.ID]
+		if !ok {
+			idx = len(groups)
+			groupIndexByID[t.Project.ID] = idx
+			groups = append(groups, entryProjectGroup{project: t.Project})
+		}
+		groups[idx].entries = append(groups[idx].entries, t)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		a, b := groups[i].project, groups[j].project
+		if a.Client != b.Client {
+			return a.Client < b.Client
+		}
+		return a.Name < b.Name
+	})
+	if len(noProject.entries) > 0 {
+		groups = append(groups, noProject)
+	}
+	return groups
+}
+
 type entrySum struct {
 	start    time.Time
 	end      *time.Time
@@ -159,6 +208,16 @@ func uintWidth(i uint) int {
 	}
 }
 
+func projectPtrsEqual(a, b *dinkur.Project) bool {
+	if a == nil && b == nil {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.ID == b.ID
+}
+
 func timesEqual(a, b time.Time) bool {
 	return a.UnixMilli() == b.UnixMilli()
 }
@@ -172,3 +231,41 @@ func timesPtrsEqual(a, b *time.Time) bool {
 	}
 	return timesEqual(*a, *b)
 }
+
+func overlapDuration(overlap dinkur.EntryOverlap) time.Duration {
+	end := conv.TimeOrNow(overlap.First.End)
+	if secondEnd := conv.TimeOrNow(overlap.Second.End); secondEnd.Before(end) {
+		end = secondEnd
+	}
+	return end.Sub(overlap.Second.Start)
+}
+
+// formatTemplateSchedule returns a short human readable description of a
+// template's recurrence, such as "weekdays at 09:30", or an empty string if
+// the template does not recur.
+func formatTemplateSchedule(recurrence *dinkur.Recurrence) string {
+	if recurrence == nil {
+		return ""
+	}
+	var sb strings.Builder
+	if rule, err := rrule.Parse(recurrence.Rule); err == nil {
+		sb.WriteString(rule.Text())
+	} else {
+		sb.WriteString(recurrence.Rule)
+	}
+	fmt.Fprintf(&sb, " at %02d:%02d",
+		int(recurrence.StartTime.Hours()), int(recurrence.StartTime.Minutes())%60)
+	if recurrence.Since.After(time.Now()) {
+		sb.WriteString(", from ")
+		sb.WriteString(recurrence.Since.Format("2006-01-02"))
+	}
+	switch len(recurrence.Exceptions) {
+	case 0:
+	case 1:
+		sb.WriteString(", except ")
+		sb.WriteString(recurrence.Exceptions[0].Format("2006-01-02"))
+	default:
+		fmt.Fprintf(&sb, ", except %d dates", len(recurrence.Exceptions))
+	}
+	return sb.String()
+}
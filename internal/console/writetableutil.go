@@ -20,10 +20,12 @@
 package console
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/fatih/color"
 )
 
@@ -40,11 +42,26 @@ func writeCellsLabelledEntry(t *table, labelled LabelledEntry) {
 }
 
 func writeCellEntryID(t *table, id uint) {
+	if id == 0 {
+		// entries that are not yet stored, such as when previewing imports
+		t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		return
+	}
 	var sb strings.Builder
 	width := writeEntryID(&sb, id)
 	t.WriteCellWidth(sb.String(), width)
 }
 
+func writeCellEntryIDAndNamePtr(t *table, entry *dinkur.Entry) {
+	if entry == nil {
+		t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		return
+	}
+	writeCellEntryID(t, entry.ID)
+	writeCellEntryName(t, entry.Name)
+}
+
 func writeCellEntryName(t *table, name string) {
 	var sb strings.Builder
 	width := writeEntryName(&sb, name)
@@ -57,6 +74,136 @@ func writeCellEntryNameSearched(t *table, name string, reg *regexp.Regexp) {
 	t.WriteCellWidth(sb.String(), width)
 }
 
+func writeCellEntryNote(t *table, note string) {
+	if note == "" {
+		t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		return
+	}
+	var sb strings.Builder
+	width := writeEntryNote(&sb, note)
+	t.WriteCellWidth(sb.String(), width)
+}
+
+func writeCellEntryNoteSearched(t *table, note string, reg *regexp.Regexp) {
+	var sb strings.Builder
+	width := writeEntryNoteSearched(&sb, note, reg)
+	t.WriteCellWidth(sb.String(), width)
+}
+
+func writeCellEntryTags(t *table, tags []string) {
+	if len(tags) == 0 {
+		t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		return
+	}
+	var sb strings.Builder
+	width := writeEntryTags(&sb, tags)
+	t.WriteCellWidth(sb.String(), width)
+}
+
+func writeCellProject(t *table, project *dinkur.Project) {
+	if project == nil {
+		t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		return
+	}
+	var sb strings.Builder
+	width := writeProject(&sb, *project)
+	t.WriteCellWidth(sb.String(), width)
+}
+
+func writeCellsProject(t *table, project dinkur.Project) {
+	writeCellEntryID(t, project.ID)
+	writeCellProjectName(t, project.Name)
+	writeCellProjectClient(t, project.Client)
+}
+
+func writeCellProjectName(t *table, name string) {
+	t.WriteCellColor(name, projectNameColor)
+}
+
+func writeCellProjectClient(t *table, client string) {
+	if client == "" {
+		t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		return
+	}
+	t.WriteCellColor(client, projectClientColor)
+}
+
+func writeCellsTemplate(t *table, template dinkur.Template) {
+	writeCellEntryID(t, template.ID)
+	writeCellTemplateName(t, template.Name)
+	writeCellEntryName(t, template.EntryName)
+	writeCellTemplateDuration(t, template.Duration)
+	writeCellEntryTags(t, template.Tags)
+	writeCellTemplateSchedule(t, template.Recurrence)
+}
+
+func writeCellTemplateName(t *table, name string) {
+	t.WriteCellColor(name, templateNameColor)
+}
+
+func writeCellTemplateDuration(t *table, d time.Duration) {
+	if d == 0 {
+		t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		return
+	}
+	writeCellDuration(t, d)
+}
+
+func writeCellTemplateSchedule(t *table, recurrence *dinkur.Recurrence) {
+	if recurrence == nil {
+		t.WriteCellColor(tableCellEmptyText, tableCellEmptyColor)
+		return
+	}
+	t.WriteCellColor(formatTemplateSchedule(recurrence), templateScheduleColor)
+}
+
+func writeCellsGoal(t *table, goal dinkur.Goal) {
+	writeCellEntryID(t, goal.ID)
+	writeCellGoalPeriod(t, goal.Period)
+	writeCellDuration(t, goal.Target)
+	writeCellProject(t, goal.Project)
+}
+
+func writeCellGoalPeriod(t *table, period dinkur.GoalPeriod) {
+	t.WriteCellColor(period.String(), goalPeriodColor)
+}
+
+func writeCellGoalBar(t *table, ratio float64) {
+	var sb strings.Builder
+	done := int(ratio * float64(goalBarWidth))
+	if done > goalBarWidth {
+		done = goalBarWidth
+	}
+	goalBarDoneColor.Fprint(&sb, strings.Repeat(goalBarDone, done))
+	goalBarTodoColor.Fprint(&sb, strings.Repeat(goalBarTodo, goalBarWidth-done))
+	percent := fmt.Sprintf(" %3.0f%%", ratio*100)
+	if ratio >= 1 {
+		goalBarReachedColor.Fprint(&sb, percent)
+	} else {
+		sb.WriteString(percent)
+	}
+	t.WriteCellWidth(sb.String(), goalBarWidth+len(percent))
+}
+
+func writeCellsFocus(t *table, focus dinkur.Focus) {
+	writeCellFocusPhase(t, focus.Phase)
+	writeCellEntryName(t, focus.Name)
+	t.WriteCell(fmt.Sprintf("%d/%d", focus.Cycle, focus.Cycles))
+	writeCellTimeColor(t, focus.PhaseEnd, timeFormatShort, entryEndColor)
+	writeCellDuration(t, focus.Remaining())
+}
+
+func writeCellFocusPhase(t *table, phase dinkur.FocusPhase) {
+	switch phase {
+	case dinkur.FocusPhaseWork:
+		t.WriteCellColor(phase.String(), focusWorkColor)
+	case dinkur.FocusPhaseBreak:
+		t.WriteCellColor(phase.String(), focusBreakColor)
+	default:
+		t.WriteCellColor(phase.String(), tableCellEmptyColor)
+	}
+}
+
 func writeCellDate(t *table, d date) {
 	dateStr := d.String()
 	t.WriteCellColor(dateStr, entryDateColor)
@@ -93,6 +240,18 @@ func writeCellEntryStartEnd(t *table, start time.Time, end *time.Time) {
 	}
 }
 
+func writeCellBalance(t *table, d time.Duration) {
+	str := FormatHours(d)
+	switch {
+	case d < 0:
+		t.WriteCellColor(str, balanceNegativeColor)
+	case d > 0:
+		t.WriteCellColor("+"+str, balancePositiveColor)
+	default:
+		t.WriteCell(str)
+	}
+}
+
 func writeCellDuration(t *table, d time.Duration) {
 	var sb strings.Builder
 	width := writeEntryDuration(&sb, d)
@@ -22,9 +22,11 @@ package console
 import (
 	"io"
 	"regexp"
+	"strings"
 	"time"
 	"unicode/utf8"
 
+	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/fatih/color"
 )
 
@@ -44,8 +46,82 @@ func writeEntryName(w io.Writer, name string) int {
 	return 2 + utf8.RuneCountInString(name)
 }
 
+func writeEntryIDAndName(w io.Writer, entry dinkur.Entry) int {
+	width := writeEntryID(w, entry.ID)
+	io.WriteString(w, " ")
+	return width + 1 + writeEntryName(w, entry.Name)
+}
+
+func writeEntryTags(w io.Writer, tags []string) int {
+	var width int
+	for i, tag := range tags {
+		if i > 0 {
+			io.WriteString(w, entryTagDelim)
+			width += len(entryTagDelim)
+		}
+		entryTagColor.Fprint(w, tag)
+		width += utf8.RuneCountInString(tag)
+	}
+	return width
+}
+
+func writeProject(w io.Writer, project dinkur.Project) int {
+	var width int
+	if project.Client != "" {
+		projectClientColor.Fprint(w, project.Client)
+		projectClientDelimColor.Fprint(w, projectClientDelim)
+		width += utf8.RuneCountInString(project.Client) + len(projectClientDelim)
+	}
+	projectNameColor.Fprint(w, project.Name)
+	return width + utf8.RuneCountInString(project.Name)
+}
+
 func writeEntryNameSearched(w io.Writer, name string, reg *regexp.Regexp) int {
-	matches := reg.FindAllStringSubmatchIndex(name, -1)
+	var width int
+	width++
+	entryNameColor.Fprint(w, entryNameQuote)
+	width += writeSearched(w, name, reg, entryNameColor, entryNameHighlightColor)
+	width++
+	entryNameColor.Fprint(w, entryNameQuote)
+	return width
+}
+
+// writeEntryNote writes the first line of the note, followed by an ellipsis if
+// the note has more lines.
+func writeEntryNote(w io.Writer, note string) int {
+	line, more := firstLine(note)
+	entryNoteColor.Fprint(w, line)
+	width := utf8.RuneCountInString(line)
+	if more {
+		entryNoteColor.Fprint(w, entryNoteMoreText)
+		width += utf8.RuneCountInString(entryNoteMoreText)
+	}
+	return width
+}
+
+// writeEntryNoteSearched writes the first line of the note that contains
+// highlighted search terms, or the first line if none does.
+func writeEntryNoteSearched(w io.Writer, note string, reg *regexp.Regexp) int {
+	lines := strings.Split(strings.TrimSpace(note), "\n")
+	line := lines[0]
+	for _, l := range lines {
+		if reg.MatchString(l) {
+			line = l
+			break
+		}
+	}
+	width := utf8.RuneCountInString(entryNoteRowPrefix)
+	entryNoteColor.Fprint(w, entryNoteRowPrefix)
+	width += writeSearched(w, strings.TrimSpace(line), reg, entryNoteColor, entryNoteHighlightColor)
+	if len(lines) > 1 {
+		entryNoteColor.Fprint(w, entryNoteMoreText)
+		width += utf8.RuneCountInString(entryNoteMoreText)
+	}
+	return width
+}
+
+func writeSearched(w io.Writer, s string, reg *regexp.Regexp, c, highlight *color.Color) int {
+	matches := reg.FindAllStringSubmatchIndex(s, -1)
 	const (
 		g0Start = 0 // group 0 = full match
 		g0End   = 1
@@ -53,28 +129,30 @@ func writeEntryNameSearched(w io.Writer, name string, reg *regexp.Regexp) int {
 		g1End   = 3
 	)
 	var width int
-	width++
-	entryNameColor.Fprint(w, entryNameQuote)
 	var lastIdx int
 	for _, match := range matches {
-		nameUntilMatch := name[lastIdx:match[g0Start]]
-		width += utf8.RuneCountInString(nameUntilMatch)
-		entryNameColor.Fprint(w, nameUntilMatch)
+		untilMatch := s[lastIdx:match[g0Start]]
+		width += utf8.RuneCountInString(untilMatch)
+		c.Fprint(w, untilMatch)
 
-		matchGroup1 := name[match[g1Start]:match[g1End]]
+		matchGroup1 := s[match[g1Start]:match[g1End]]
 		width += utf8.RuneCountInString(matchGroup1)
-		entryNameHighlightColor.Fprint(w, matchGroup1)
+		highlight.Fprint(w, matchGroup1)
 
 		lastIdx = match[g0End]
 	}
-	nameUntilEnd := name[lastIdx:]
-	width += utf8.RuneCountInString(nameUntilEnd)
-	entryNameColor.Fprint(w, nameUntilEnd)
-	width++
-	entryNameColor.Fprint(w, entryNameQuote)
+	untilEnd := s[lastIdx:]
+	width += utf8.RuneCountInString(untilEnd)
+	c.Fprint(w, untilEnd)
 	return width
 }
 
+func firstLine(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	line, _, more := strings.Cut(s, "\n")
+	return strings.TrimSpace(line), more
+}
+
 func writeEntryTimeSpanActive(w io.Writer, start time.Time, end *time.Time) int {
 	return writeEntryTimeSpan(w, start, end, entryEndNilTextActive)
 }
@@ -59,6 +59,9 @@ func Parse(s string, base time.Time) (time.Time, error) {
 	if t, err := ParseKnownLayouts(s); err == nil {
 		return t, nil
 	}
+	if t, err := ParseLocalLayouts(s, base.Location()); err == nil {
+		return t, nil
+	}
 	return ParseWhen(s, base)
 }
 
@@ -83,6 +86,23 @@ func ParseKnownLayouts(s string) (time.Time, error) {
 	return time.Time{}, ErrUnknownFormat
 }
 
+var localLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// ParseLocalLayouts attempts to parse the string as an ISO 8601 date, with an
+// optional time of day, in the given location.
+func ParseLocalLayouts(s string, loc *time.Location) (time.Time, error) {
+	for _, layout := range localLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, ErrUnknownFormat
+}
+
 // ParseWhen performs a fuzzy time parsing via the `when` package.
 func ParseWhen(s string, base time.Time) (time.Time, error) {
 	r, err := w.Parse(s, base.Truncate(time.Second))
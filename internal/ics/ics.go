@@ -0,0 +1,148 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package ics contains an encoder for writing entries in the iCalendar format,
+// as defined in RFC 5545.
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+const (
+	timeLayout = "20060102T150405Z"
+	prodID     = "-//Dinkur//Dinkur//EN"
+	uidDomain  = "dinkur"
+	// maxLineOctets is the maximum length of a content line, excluding the
+	// line break, before it has to be folded.
+	maxLineOctets = 75
+)
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// Encoder writes entries as iCalendar events to an output stream.
+type Encoder struct {
+	w *bufio.Writer
+	// SkipActive makes the encoder skip active entries, instead of writing
+	// them as ending at the time of encoding.
+	SkipActive bool
+	// Now is used as the end time of active entries and as the timestamp of
+	// when the events were created. Defaults to time.Now() if left unset.
+	Now time.Time
+}
+
+// NewEncoder returns a new encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: bufio.NewWriter(w)}
+}
+
+// Encode writes a calendar containing one event per entry to the stream.
+func (enc *Encoder) Encode(entries []dinkur.Entry) error {
+	now := enc.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	enc.writeLine("BEGIN:VCALENDAR")
+	enc.writeLine("VERSION:2.0")
+	enc.writeLine("PRODID:" + prodID)
+	enc.writeLine("CALSCALE:GREGORIAN")
+	for _, entry := range entries {
+		if entry.End == nil && enc.SkipActive {
+			continue
+		}
+		enc.writeEvent(entry, now)
+	}
+	enc.writeLine("END:VCALENDAR")
+	return enc.w.Flush()
+}
+
+func (enc *Encoder) writeEvent(entry dinkur.Entry, now time.Time) {
+	end := now
+	if entry.End != nil {
+		end = *entry.End
+	}
+	enc.writeLine("BEGIN:VEVENT")
+	enc.writeLine("UID:" + UID(entry))
+	enc.writeLine("DTSTAMP:" + formatTime(now))
+	enc.writeLine("DTSTART:" + formatTime(entry.Start))
+	enc.writeLine("DTEND:" + formatTime(end))
+	enc.writeLine("SUMMARY:" + escapeText(entry.Name))
+	if entry.Note != "" {
+		enc.writeLine("DESCRIPTION:" + escapeText(entry.Note))
+	}
+	if len(entry.Tags) > 0 {
+		tags := make([]string, len(entry.Tags))
+		for i, tag := range entry.Tags {
+			tags[i] = escapeText(tag)
+		}
+		enc.writeLine("CATEGORIES:" + strings.Join(tags, ","))
+	}
+	enc.writeLine("LAST-MODIFIED:" + formatTime(entry.UpdatedAt))
+	enc.writeLine("END:VEVENT")
+}
+
+// writeLine writes a content line terminated by CRLF, folding it into
+// multiple lines if it exceeds 75 octets. Errors are caught by the final call
+// to Flush, as bufio.Writer keeps the first error.
+func (enc *Encoder) writeLine(line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		// don't split multi-byte UTF-8 characters
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		enc.w.WriteString(line[:cut])
+		enc.w.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines are prefixed with a space, which counts
+		limit = maxLineOctets - 1
+	}
+	enc.w.WriteString(line)
+	enc.w.WriteString("\r\n")
+}
+
+// UID returns a unique identifier of the entry that is stable across exports,
+// derived from the entry's ID and creation time.
+func UID(entry dinkur.Entry) string {
+	return fmt.Sprintf("%d-%s@%s", entry.ID, formatTime(entry.CreatedAt), uidDomain)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
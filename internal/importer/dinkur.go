@@ -0,0 +1,170 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"gopkg.in/yaml.v3"
+)
+
+// csvTimeLayouts are the time layouts accepted in Dinkur's CSV format. Older
+// versions of Dinkur wrote the end time using time.Time.String.
+var csvTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+}
+
+// csvColumns are the column names of Dinkur's CSV format, in the order they
+// are written when no header row is present.
+var csvColumns = []string{
+	"ID",
+	"Created at",
+	"Updated at",
+	"Name",
+	"Start",
+	"End",
+	"Tags",
+	"Project",
+	"Note",
+}
+
+func decodeCSV(data []byte) ([]dinkur.Entry, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	columns := csvColumns
+	if strings.EqualFold(records[0][0], csvColumns[0]) {
+		columns = records[0]
+		records = records[1:]
+	}
+	index := make(map[string]int, len(columns))
+	for i, col := range columns {
+		index[strings.ToLower(strings.TrimSpace(col))] = i
+	}
+	field := func(record []string, col string) string {
+		i, ok := index[strings.ToLower(col)]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	entries := make([]dinkur.Entry, 0, len(records))
+	for i, record := range records {
+		entry := dinkur.Entry{
+			Name:    field(record, "Name"),
+			Note:    field(record, "Note"),
+			Tags:    splitTags(field(record, "Tags")),
+			Project: parseProject(field(record, "Project")),
+		}
+		entry.Start, err = parseCSVTime(field(record, "Start"))
+		if err != nil {
+			return nil, fmt.Errorf("record %d: start: %w", i+1, err)
+		}
+		if endStr := field(record, "End"); endStr != "" {
+			end, err := parseCSVTime(endStr)
+			if err != nil {
+				return nil, fmt.Errorf("record %d: end: %w", i+1, err)
+			}
+			entry.End = &end
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func parseCSVTime(s string) (time.Time, error) {
+	var firstErr error
+	for _, layout := range csvTimeLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return time.Time{}, firstErr
+}
+
+// decodeJSON reads either a JSON array of entries, or one JSON entry object
+// per line.
+func decodeJSON(data []byte) ([]dinkur.Entry, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	var entries []dinkur.Entry
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		raw = bytes.TrimSpace(raw)
+		if bytes.HasPrefix(raw, []byte("[")) {
+			var slice []dinkur.Entry
+			if err := json.Unmarshal(raw, &slice); err != nil {
+				return nil, err
+			}
+			entries = append(entries, slice...)
+			continue
+		}
+		var entry dinkur.Entry
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", len(entries)+1, err)
+		}
+		entries = append(entries, entry)
+	}
+	return cleanDecodedEntries(entries), nil
+}
+
+func decodeYAML(data []byte) ([]dinkur.Entry, error) {
+	var entries []dinkur.Entry
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	return cleanDecodedEntries(entries), nil
+}
+
+// cleanDecodedEntries removes database-specific fields from entries that were
+// decoded from Dinkur's own formats, as they are not kept on import.
+func cleanDecodedEntries(entries []dinkur.Entry) []dinkur.Entry {
+	for i := range entries {
+		entries[i].CommonFields = dinkur.CommonFields{}
+		if p := entries[i].Project; p != nil {
+			entries[i].Project = &dinkur.Project{Name: p.Name, Client: p.Client}
+		}
+	}
+	return entries
+}
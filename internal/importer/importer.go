@@ -0,0 +1,222 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package importer contains decoders for reading entries that were exported
+// by Dinkur itself or by other time tracking tools.
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// Errors that are specific to the importer.
+var (
+	ErrUnknownFormat = errors.New("unknown import format")
+	ErrEmptyName     = errors.New("entry name cannot be empty")
+	ErrZeroStart     = errors.New("entry start time cannot be empty")
+)
+
+// Format is an enumeration of supported import formats.
+type Format string
+
+const (
+	// FormatAuto detects the format based on the file extension and content.
+	FormatAuto Format = "auto"
+	// FormatCSV is Dinkur's own CSV format, as written by
+	// "dinkur list --output csv" or "--output csv-header".
+	FormatCSV Format = "csv"
+	// FormatJSON is Dinkur's own JSON format, as written by
+	// "dinkur list --output json" or "--output json-line".
+	FormatJSON Format = "json"
+	// FormatYAML is Dinkur's own YAML format, as written by
+	// "dinkur list --output yaml".
+	FormatYAML Format = "yaml"
+	// FormatTogglCSV is the CSV format of Toggl Track's detailed report
+	// export.
+	FormatTogglCSV Format = "toggl-csv"
+	// FormatTimewarriorJSON is the JSON format written by "timew export".
+	FormatTimewarriorJSON Format = "timewarrior-json"
+)
+
+// Formats is a list of all supported import formats.
+var Formats = []Format{
+	FormatAuto,
+	FormatCSV,
+	FormatJSON,
+	FormatYAML,
+	FormatTogglCSV,
+	FormatTimewarriorJSON,
+}
+
+// ParseFormat returns the format with the given name, or an error if no such
+// format is supported.
+func ParseFormat(s string) (Format, error) {
+	for _, f := range Formats {
+		if strings.EqualFold(s, string(f)) {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownFormat, s)
+}
+
+// Decode reads all entries from the reader. If the format is FormatAuto, then
+// the format is detected using the file name and the content, and the detected
+// format is returned.
+//
+// The decoded entries have no IDs. Any referenced projects only have their
+// name and client set.
+func Decode(r io.Reader, fileName string, format Format) ([]dinkur.Entry, Format, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, format, err
+	}
+	if format == FormatAuto {
+		format = DetectFormat(fileName, data)
+	}
+	var entries []dinkur.Entry
+	switch format {
+	case FormatCSV:
+		entries, err = decodeCSV(data)
+	case FormatJSON:
+		entries, err = decodeJSON(data)
+	case FormatYAML:
+		entries, err = decodeYAML(data)
+	case FormatTogglCSV:
+		entries, err = decodeTogglCSV(data)
+	case FormatTimewarriorJSON:
+		entries, err = decodeTimewarriorJSON(data)
+	default:
+		return nil, format, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+	if err != nil {
+		return nil, format, err
+	}
+	for i, entry := range entries {
+		entries[i].Tags = sanitizeTags(entry.Tags)
+		if err := validateEntry(entry); err != nil {
+			return nil, format, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+	}
+	return entries, format, nil
+}
+
+// DetectFormat makes an educated guess of the format, based on the file
+// extension and the first record of the content.
+func DetectFormat(fileName string, data []byte) Format {
+	trimmed := bytes.TrimSpace(data)
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".csv":
+		return detectCSVFormat(trimmed)
+	case ".json":
+		return detectJSONFormat(trimmed)
+	}
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("[")), bytes.HasPrefix(trimmed, []byte("{")):
+		return detectJSONFormat(trimmed)
+	case bytes.HasPrefix(trimmed, []byte("-")):
+		return FormatYAML
+	default:
+		return detectCSVFormat(trimmed)
+	}
+}
+
+func detectCSVFormat(data []byte) Format {
+	header, err := csv.NewReader(bytes.NewReader(data)).Read()
+	if err == nil && isTogglCSVHeader(header) {
+		return FormatTogglCSV
+	}
+	return FormatCSV
+}
+
+func detectJSONFormat(data []byte) Format {
+	var timew []timewarriorEntry
+	if err := json.Unmarshal(data, &timew); err == nil &&
+		len(timew) > 0 && isTimewarriorTime(timew[0].Start) {
+		return FormatTimewarriorJSON
+	}
+	return FormatJSON
+}
+
+func validateEntry(entry dinkur.Entry) error {
+	if entry.Name == "" {
+		return ErrEmptyName
+	}
+	if entry.Start.IsZero() {
+		return ErrZeroStart
+	}
+	if entry.End != nil && entry.End.Before(entry.Start) {
+		return dinkur.ErrEntryEndBeforeStart
+	}
+	return nil
+}
+
+// parseProject parses a project string of the format "client/name" or "name",
+// as written by the dinkur.Project.String method.
+func parseProject(s string) *dinkur.Project {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	client, name, ok := strings.Cut(s, "/")
+	if !ok {
+		return &dinkur.Project{Name: s}
+	}
+	return &dinkur.Project{Name: name, Client: client}
+}
+
+// sanitizeTags replaces whitespace and commas in tags with dashes, and removes
+// leading dashes, as other time trackers allow tags that Dinkur does not.
+func sanitizeTags(tags []string) []string {
+	sanitized := tags[:0]
+	for _, tag := range tags {
+		tag = strings.Join(strings.FieldsFunc(tag, func(r rune) bool {
+			return r == ',' || unicode.IsSpace(r)
+		}), "-")
+		tag = strings.TrimLeft(tag, "-")
+		if tag != "" {
+			sanitized = append(sanitized, tag)
+		}
+	}
+	return sanitized
+}
+
+// splitTags splits a comma-separated list of tags, ignoring empty tags.
+func splitTags(s string) []string {
+	var tags []string
+	for _, tag := range strings.Split(s, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
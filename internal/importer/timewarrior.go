@@ -0,0 +1,79 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+const timewarriorTimeLayout = "20060102T150405Z"
+
+type timewarriorEntry struct {
+	ID         int      `json:"id"`
+	Start      string   `json:"start"`
+	End        string   `json:"end"`
+	Tags       []string `json:"tags"`
+	Annotation string   `json:"annotation"`
+}
+
+func isTimewarriorTime(s string) bool {
+	_, err := time.Parse(timewarriorTimeLayout, s)
+	return err == nil
+}
+
+// decodeTimewarriorJSON reads the JSON written by "timew export". Timewarrior
+// intervals have no name, so the annotation is used as the entry name, or the
+// tags joined by spaces if the interval has no annotation.
+func decodeTimewarriorJSON(data []byte) ([]dinkur.Entry, error) {
+	var intervals []timewarriorEntry
+	if err := json.Unmarshal(data, &intervals); err != nil {
+		return nil, err
+	}
+	entries := make([]dinkur.Entry, 0, len(intervals))
+	for i, interval := range intervals {
+		entry := dinkur.Entry{
+			Name: strings.TrimSpace(interval.Annotation),
+			Tags: interval.Tags,
+		}
+		if entry.Name == "" {
+			entry.Name = strings.Join(interval.Tags, " ")
+		}
+		start, err := time.Parse(timewarriorTimeLayout, interval.Start)
+		if err != nil {
+			return nil, fmt.Errorf("interval %d: start: %w", i+1, err)
+		}
+		entry.Start = start
+		if interval.End != "" {
+			end, err := time.Parse(timewarriorTimeLayout, interval.End)
+			if err != nil {
+				return nil, fmt.Errorf("interval %d: end: %w", i+1, err)
+			}
+			entry.End = &end
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,116 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+const togglTimeLayout = "2006-01-02 15:04:05"
+
+var errTogglMissingColumn = errors.New("missing column in Toggl CSV header")
+
+func isTogglCSVHeader(header []string) bool {
+	var hasDesc, hasStartDate bool
+	for _, col := range header {
+		switch strings.TrimSpace(col) {
+		case "Description":
+			hasDesc = true
+		case "Start date":
+			hasStartDate = true
+		}
+	}
+	return hasDesc && hasStartDate
+}
+
+// decodeTogglCSV reads Toggl Track's detailed report CSV export. The times in
+// the export have no time zone, and are therefore parsed in the local time
+// zone.
+func decodeTogglCSV(data []byte) ([]dinkur.Entry, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	index := make(map[string]int, len(records[0]))
+	for i, col := range records[0] {
+		// the file may start with a UTF-8 byte order mark
+		index[strings.TrimPrefix(strings.TrimSpace(col), "\ufeff")] = i
+	}
+	for _, col := range []string{"Description", "Start date", "Start time"} {
+		if _, ok := index[col]; !ok {
+			return nil, fmt.Errorf("%w: %q", errTogglMissingColumn, col)
+		}
+	}
+	field := func(record []string, col string) string {
+		i, ok := index[col]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	entries := make([]dinkur.Entry, 0, len(records)-1)
+	for i, record := range records[1:] {
+		entry := dinkur.Entry{
+			Name: field(record, "Description"),
+			Tags: splitTags(field(record, "Tags")),
+		}
+		if name := field(record, "Project"); name != "" {
+			entry.Project = &dinkur.Project{
+				Name:   name,
+				Client: field(record, "Client"),
+			}
+		}
+		if entry.Name == "" {
+			// Toggl allows entries without descriptions
+			entry.Name = field(record, "Task")
+		}
+		if entry.Name == "" && entry.Project != nil {
+			entry.Name = entry.Project.Name
+		}
+		entry.Start, err = time.ParseInLocation(togglTimeLayout,
+			field(record, "Start date")+" "+field(record, "Start time"), time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: start: %w", i+1, err)
+		}
+		if endDate := field(record, "End date"); endDate != "" {
+			end, err := time.ParseInLocation(togglTimeLayout,
+				endDate+" "+field(record, "End time"), time.Local)
+			if err != nil {
+				return nil, fmt.Errorf("record %d: end: %w", i+1, err)
+			}
+			entry.End = &end
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,147 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pflagutil
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrDurationInvalid is returned when parsing a malformed duration.
+	ErrDurationInvalid = errors.New("invalid duration")
+)
+
+var durationDayUnits = map[string]time.Duration{
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// ParseDuration parses a duration string the same way as time.ParseDuration,
+// but also allows the units "d" for days and "w" for weeks, where a day is
+// always 24 hours. Negative durations are not allowed.
+//
+// Examples: "30d", "2w", "1d12h", "90m".
+func ParseDuration(s string) (time.Duration, error) {
+	rest := strings.TrimSpace(s)
+	if rest == "" {
+		return 0, ErrDurationInvalid
+	}
+	if rest == "0" {
+		return 0, nil
+	}
+	var total time.Duration
+	for rest != "" {
+		numEnd := strings.IndexFunc(rest, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.'
+		})
+		if numEnd <= 0 {
+			return 0, ErrDurationInvalid
+		}
+		unitEnd := strings.IndexFunc(rest[numEnd:], func(r rune) bool {
+			return (r >= '0' && r <= '9') || r == '.'
+		})
+		if unitEnd == -1 {
+			unitEnd = len(rest)
+		} else {
+			unitEnd += numEnd
+		}
+		num, unit := rest[:numEnd], rest[numEnd:unitEnd]
+		rest = rest[unitEnd:]
+		if dayUnit, ok := durationDayUnits[unit]; ok {
+			f, err := strconv.ParseFloat(num, 64)
+			if err != nil {
+				return 0, ErrDurationInvalid
+			}
+			total += time.Duration(f * float64(dayUnit))
+			continue
+		}
+		d, err := time.ParseDuration(num + unit)
+		if err != nil {
+			return 0, err
+		}
+		total += d
+	}
+	return total, nil
+}
+
+// Duration is a pflag.Value-compatible type for allowing durations to be used
+// in flags. The ParseDuration function is used to parse the user-provided flag
+// string value.
+type Duration struct {
+	source string
+	parsed time.Duration
+}
+
+// NewDuration creates a new duration flag value with a default value.
+func NewDuration(def time.Duration) *Duration {
+	return &Duration{parsed: def}
+}
+
+// String returns the source string of the duration, or a formatted string of
+// the default value if the flag has not been set.
+func (d *Duration) String() string {
+	if d == nil {
+		return ""
+	}
+	if d.source != "" {
+		return d.source
+	}
+	return formatDuration(d.parsed)
+}
+
+// Set attempts to parse the string as a duration and updates its internal
+// state on success, or returns a parsing error if it fails.
+func (d *Duration) Set(s string) error {
+	parsed, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.source = s
+	d.parsed = parsed
+	return nil
+}
+
+// Type returns "duration", the flag type name to be used in helper text.
+func (d *Duration) Type() string {
+	return "duration"
+}
+
+// Duration returns the parsed duration.
+func (d *Duration) Duration() time.Duration {
+	if d == nil {
+		return 0
+	}
+	return d.parsed
+}
+
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return "0"
+	}
+	day := durationDayUnits["d"]
+	if d%day == 0 {
+		return strconv.FormatInt(int64(d/day), 10) + "d"
+	}
+	return d.String()
+}
@@ -0,0 +1,92 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package pflagutil
+
+import (
+	"errors"
+	"strings"
+)
+
+// Tags is a pflag.Value-compatible type for allowing entry tags to be used in
+// flags. The flag can be repeated or given a comma-separated list of tags.
+// Tags prefixed with a dash (e.g "-meeting") are collected as excluded tags,
+// while all other tags are collected as included tags.
+type Tags struct {
+	include []string
+	exclude []string
+}
+
+// String returns a comma-separated list of all tags, where excluded tags are
+// prefixed with a dash.
+func (t *Tags) String() string {
+	if t == nil {
+		return ""
+	}
+	all := make([]string, 0, len(t.include)+len(t.exclude))
+	all = append(all, t.include...)
+	for _, tag := range t.exclude {
+		all = append(all, "-"+tag)
+	}
+	return strings.Join(all, ",")
+}
+
+// Set parses a comma-separated list of tags and adds them to the included or
+// excluded tags.
+func (t *Tags) Set(s string) error {
+	for _, tag := range strings.Split(s, ",") {
+		tag = strings.TrimSpace(tag)
+		list := &t.include
+		if strings.HasPrefix(tag, "-") {
+			list = &t.exclude
+			tag = tag[1:]
+		} else {
+			tag = strings.TrimPrefix(tag, "+")
+		}
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			return errors.New("tag name cannot be empty")
+		}
+		*list = append(*list, tag)
+	}
+	return nil
+}
+
+// Type returns "tag", the flag type name to be used in helper text.
+func (t *Tags) Type() string {
+	return "tag"
+}
+
+// Include returns the tags that were not prefixed with a dash.
+func (t *Tags) Include() []string {
+	if t == nil {
+		return nil
+	}
+	return t.include
+}
+
+// Exclude returns the tags that were prefixed with a dash, with the dash
+// trimmed away.
+func (t *Tags) Exclude() []string {
+	if t == nil {
+		return nil
+	}
+	return t.exclude
+}
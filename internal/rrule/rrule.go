@@ -0,0 +1,367 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package rrule contains a parser and evaluator for a subset of the iCalendar
+// recurrence rules (RRULE), as defined in RFC 5545.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalid is returned when parsing a malformed or unsupported recurrence
+// rule.
+var ErrInvalid = errors.New("invalid recurrence rule")
+
+// Frequency is an enumeration of how often a rule recurs.
+type Frequency byte
+
+const (
+	// Daily means the rule recurs every day, or every n-th day if an interval
+	// is set.
+	Daily Frequency = iota + 1
+	// Weekly means the rule recurs every week, or every n-th week if an
+	// interval is set.
+	Weekly
+)
+
+// String returns the RRULE FREQ value of the frequency.
+func (f Frequency) String() string {
+	switch f {
+	case Daily:
+		return "DAILY"
+	case Weekly:
+		return "WEEKLY"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Rule is a parsed recurrence rule. Only the FREQ, INTERVAL, BYDAY, COUNT, and
+// UNTIL rule parts are supported, and only with the DAILY and WEEKLY frequencies.
+type Rule struct {
+	// Freq is how often the rule recurs.
+	Freq Frequency
+	// Interval is how many days or weeks there are between each recurrence.
+	// Zero is treated as 1.
+	Interval int
+	// ByDay limits the recurrences to these weekdays. For weekly rules, an
+	// empty slice means the weekday of the rule's start date.
+	ByDay []time.Weekday
+	// Count is how many times the rule recurs, counted from the rule's start
+	// date, or zero if the rule is not limited by a count.
+	Count int
+	// Until is the last date the rule recurs on, or the zero value if the
+	// rule recurs indefinitely.
+	Until time.Time
+}
+
+var weekdayCodes = [...]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+var shorthands = map[string]string{
+	"daily":    "FREQ=DAILY",
+	"weekly":   "FREQ=WEEKLY",
+	"weekdays": "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+	"weekday":  "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+}
+
+// Parse parses a recurrence rule, such as "FREQ=WEEKLY;BYDAY=MO,WE". An
+// optional "RRULE:" prefix is ignored. The shorthands "daily", "weekly", and
+// "weekdays" are also accepted.
+func Parse(s string) (Rule, error) {
+	s = strings.TrimSpace(s)
+	if expanded, ok := shorthands[strings.ToLower(s)]; ok {
+		s = expanded
+	}
+	s = strings.TrimPrefix(strings.ToUpper(s), "RRULE:")
+	if s == "" {
+		return Rule{}, fmt.Errorf("%w: empty rule", ErrInvalid)
+	}
+	var r Rule
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Rule{}, fmt.Errorf("%w: missing equal sign in %q", ErrInvalid, part)
+		}
+		switch key {
+		case "FREQ":
+			switch value {
+			case "DAILY":
+				r.Freq = Daily
+			case "WEEKLY":
+				r.Freq = Weekly
+			default:
+				return Rule{}, fmt.Errorf("%w: unsupported frequency: %q", ErrInvalid, value)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 {
+				return Rule{}, fmt.Errorf("%w: interval must be a positive integer: %q", ErrInvalid, value)
+			}
+			r.Interval = interval
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				day, ok := parseWeekday(code)
+				if !ok {
+					return Rule{}, fmt.Errorf("%w: unsupported weekday: %q", ErrInvalid, code)
+				}
+				r.ByDay = append(r.ByDay, day)
+			}
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return Rule{}, fmt.Errorf("%w: count must be a positive integer: %q", ErrInvalid, value)
+			}
+			r.Count = count
+		case "UNTIL":
+			until, err := parseUntil(value)
+			if err != nil {
+				return Rule{}, fmt.Errorf("%w: until: %q", ErrInvalid, value)
+			}
+			r.Until = until
+		case "WKST":
+			// weeks always start on Monday, which is the RFC 5545 default
+		default:
+			return Rule{}, fmt.Errorf("%w: unsupported rule part: %q", ErrInvalid, key)
+		}
+	}
+	if r.Freq == 0 {
+		return Rule{}, fmt.Errorf("%w: missing frequency", ErrInvalid)
+	}
+	if r.Count != 0 && !r.Until.IsZero() {
+		return Rule{}, fmt.Errorf("%w: count and until may not both be set", ErrInvalid)
+	}
+	return r, nil
+}
+
+func parseWeekday(code string) (time.Weekday, bool) {
+	for day, c := range weekdayCodes {
+		if c == code {
+			return time.Weekday(day), true
+		}
+	}
+	return 0, false
+}
+
+func parseUntil(s string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", s); err == nil {
+		return t.Local(), nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", s, time.Local); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("20060102", s, time.Local)
+}
+
+// String returns the rule in the RRULE format, without the "RRULE:" prefix.
+func (r Rule) String() string {
+	var sb strings.Builder
+	sb.WriteString("FREQ=")
+	sb.WriteString(r.Freq.String())
+	if r.Interval > 1 {
+		fmt.Fprintf(&sb, ";INTERVAL=%d", r.Interval)
+	}
+	if len(r.ByDay) > 0 {
+		sb.WriteString(";BYDAY=")
+		for i, day := range r.ByDay {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteString(weekdayCodes[day])
+		}
+	}
+	if r.Count > 0 {
+		fmt.Fprintf(&sb, ";COUNT=%d", r.Count)
+	}
+	if !r.Until.IsZero() {
+		sb.WriteString(";UNTIL=")
+		sb.WriteString(r.Until.Format("20060102"))
+	}
+	return sb.String()
+}
+
+var weekdays = []time.Weekday{
+	time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday,
+}
+
+// Text returns a short human readable description of the rule, such as
+// "weekdays" or "every 2 weeks on Mon, Wed".
+func (r Rule) Text() string {
+	var sb strings.Builder
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	byDay := r.ByDay
+	switch {
+	case r.Freq == Weekly && interval == 1 && sameWeekdays(byDay, weekdays):
+		sb.WriteString("weekdays")
+		byDay = nil
+	case r.Freq == Daily && interval == 1:
+		sb.WriteString("daily")
+	case r.Freq == Daily:
+		fmt.Fprintf(&sb, "every %d days", interval)
+	case r.Freq == Weekly && interval == 1:
+		sb.WriteString("weekly")
+	case r.Freq == Weekly:
+		fmt.Fprintf(&sb, "every %d weeks", interval)
+	default:
+		sb.WriteString(r.String())
+	}
+	if len(byDay) > 0 {
+		sb.WriteString(" on ")
+		for i, day := range byDay {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(day.String()[:3])
+		}
+	}
+	if r.Count == 1 {
+		sb.WriteString(", once")
+	} else if r.Count > 1 {
+		fmt.Fprintf(&sb, ", %d times", r.Count)
+	}
+	if !r.Until.IsZero() {
+		sb.WriteString(", until ")
+		sb.WriteString(r.Until.Format("2006-01-02"))
+	}
+	return sb.String()
+}
+
+func sameWeekdays(a, b []time.Weekday) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, day := range a {
+		if !containsWeekday(b, day) {
+			return false
+		}
+	}
+	return true
+}
+
+// Dates returns the dates the rule recurs on between the from and to dates,
+// both inclusive. The start date is the first date the rule may recur on, and
+// is the anchor for the rule's interval. Dates are returned as midnight in the
+// start date's location.
+func (r Rule) Dates(start, from, to time.Time) []time.Time {
+	loc := start.Location()
+	startDate := truncateDate(start)
+	first := truncateDate(from.In(loc))
+	day := startDate
+	if r.Count == 0 && day.Before(first) {
+		// the dates before the from date only need to be iterated when
+		// counting the recurrences
+		day = first
+	}
+	last := truncateDate(to.In(loc))
+	if !r.Until.IsZero() {
+		until := truncateDate(r.Until.In(loc))
+		if until.Before(last) {
+			last = until
+		}
+	}
+	var (
+		dates []time.Time
+		count int
+	)
+	for !day.After(last) {
+		if r.recursOn(startDate, day) {
+			if !day.Before(first) {
+				dates = append(dates, day)
+			}
+			count++
+			if r.Count > 0 && count >= r.Count {
+				break
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return dates
+}
+
+func (r Rule) recursOn(startDate, day time.Time) bool {
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	switch r.Freq {
+	case Daily:
+		if len(r.ByDay) > 0 && !containsWeekday(r.ByDay, day.Weekday()) {
+			return false
+		}
+		return daysBetween(startDate, day)%interval == 0
+	case Weekly:
+		byDay := r.ByDay
+		if len(byDay) == 0 {
+			byDay = []time.Weekday{startDate.Weekday()}
+		}
+		if !containsWeekday(byDay, day.Weekday()) {
+			return false
+		}
+		weeks := daysBetween(weekStart(startDate), weekStart(day)) / 7
+		return weeks%interval == 0
+	default:
+		return false
+	}
+}
+
+func containsWeekday(days []time.Weekday, day time.Weekday) bool {
+	for _, d := range days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+func truncateDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// weekStart returns the Monday of the date's week.
+func weekStart(date time.Time) time.Time {
+	offset := (int(date.Weekday()) + 6) % 7
+	return date.AddDate(0, 0, -offset)
+}
+
+// daysBetween returns the number of calendar days between the two dates,
+// unaffected by daylight saving time.
+func daysBetween(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	aUTC := time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)
+	bUTC := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC)
+	return int(bUTC.Sub(aUTC).Hours() / 24)
+}
@@ -0,0 +1,314 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package rrule
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		want       Rule
+		wantString string
+	}{
+		{
+			name:       "daily",
+			input:      "FREQ=DAILY",
+			want:       Rule{Freq: Daily},
+			wantString: "FREQ=DAILY",
+		},
+		{
+			name:       "weekly with prefix",
+			input:      "RRULE:FREQ=WEEKLY",
+			want:       Rule{Freq: Weekly},
+			wantString: "FREQ=WEEKLY",
+		},
+		{
+			name:       "lowercase",
+			input:      "freq=daily;interval=3",
+			want:       Rule{Freq: Daily, Interval: 3},
+			wantString: "FREQ=DAILY;INTERVAL=3",
+		},
+		{
+			name:       "interval",
+			input:      "FREQ=WEEKLY;INTERVAL=2",
+			want:       Rule{Freq: Weekly, Interval: 2},
+			wantString: "FREQ=WEEKLY;INTERVAL=2",
+		},
+		{
+			name:       "interval of one",
+			input:      "FREQ=WEEKLY;INTERVAL=1",
+			want:       Rule{Freq: Weekly, Interval: 1},
+			wantString: "FREQ=WEEKLY",
+		},
+		{
+			name:       "byday",
+			input:      "FREQ=WEEKLY;BYDAY=MO,WE,SU",
+			want:       Rule{Freq: Weekly, ByDay: []time.Weekday{time.Monday, time.Wednesday, time.Sunday}},
+			wantString: "FREQ=WEEKLY;BYDAY=MO,WE,SU",
+		},
+		{
+			name:       "count",
+			input:      "FREQ=DAILY;COUNT=5",
+			want:       Rule{Freq: Daily, Count: 5},
+			wantString: "FREQ=DAILY;COUNT=5",
+		},
+		{
+			name:       "until date",
+			input:      "FREQ=DAILY;UNTIL=20260105",
+			want:       Rule{Freq: Daily, Until: time.Date(2026, 1, 5, 0, 0, 0, 0, time.Local)},
+			wantString: "FREQ=DAILY;UNTIL=20260105",
+		},
+		{
+			name:       "until local date time",
+			input:      "FREQ=DAILY;UNTIL=20260105T120000",
+			want:       Rule{Freq: Daily, Until: time.Date(2026, 1, 5, 12, 0, 0, 0, time.Local)},
+			wantString: "FREQ=DAILY;UNTIL=20260105",
+		},
+		{
+			name:       "until UTC date time",
+			input:      "FREQ=DAILY;UNTIL=20260105T120000Z",
+			want:       Rule{Freq: Daily, Until: time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)},
+			wantString: "FREQ=DAILY;UNTIL=" + time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC).Local().Format("20060102"),
+		},
+		{
+			name:       "week start is ignored",
+			input:      "FREQ=WEEKLY;WKST=MO",
+			want:       Rule{Freq: Weekly},
+			wantString: "FREQ=WEEKLY",
+		},
+		{
+			name:       "weekdays shorthand",
+			input:      "Weekdays",
+			want:       Rule{Freq: Weekly, ByDay: weekdays},
+			wantString: "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+		},
+		{
+			name:       "all parts",
+			input:      "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;COUNT=10",
+			want:       Rule{Freq: Weekly, Interval: 2, ByDay: []time.Weekday{time.Tuesday, time.Thursday}, Count: 10},
+			wantString: "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;COUNT=10",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Parse(tc.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got.Freq != tc.want.Freq {
+				t.Errorf("want freq %s, got %s", tc.want.Freq, got.Freq)
+			}
+			if got.Interval != tc.want.Interval {
+				t.Errorf("want interval %d, got %d", tc.want.Interval, got.Interval)
+			}
+			if !reflect.DeepEqual(got.ByDay, tc.want.ByDay) {
+				t.Errorf("want byday %v, got %v", tc.want.ByDay, got.ByDay)
+			}
+			if got.Count != tc.want.Count {
+				t.Errorf("want count %d, got %d", tc.want.Count, got.Count)
+			}
+			if !got.Until.Equal(tc.want.Until) {
+				t.Errorf("want until %s, got %s", tc.want.Until, got.Until)
+			}
+			if s := got.String(); s != tc.wantString {
+				t.Errorf("want string %q, got %q", tc.wantString, s)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty", input: ""},
+		{name: "only prefix", input: "RRULE:"},
+		{name: "missing equal sign", input: "FREQ"},
+		{name: "missing freq", input: "INTERVAL=2"},
+		{name: "unsupported freq", input: "FREQ=MONTHLY"},
+		{name: "zero interval", input: "FREQ=DAILY;INTERVAL=0"},
+		{name: "non-numeric interval", input: "FREQ=DAILY;INTERVAL=two"},
+		{name: "unknown weekday", input: "FREQ=WEEKLY;BYDAY=MO,XX"},
+		{name: "ordinal weekday", input: "FREQ=WEEKLY;BYDAY=1MO"},
+		{name: "negative count", input: "FREQ=DAILY;COUNT=-1"},
+		{name: "non-numeric count", input: "FREQ=DAILY;COUNT=many"},
+		{name: "invalid until", input: "FREQ=DAILY;UNTIL=tomorrow"},
+		{name: "count and until", input: "FREQ=DAILY;COUNT=2;UNTIL=20260105"},
+		{name: "unsupported part", input: "FREQ=DAILY;BYSETPOS=1"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(tc.input)
+			if !errors.Is(err, ErrInvalid) {
+				t.Errorf("want %q, got: %v", ErrInvalid, err)
+			}
+		})
+	}
+}
+
+func TestRuleDates(t *testing.T) {
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	if err != nil {
+		t.Fatalf("load time zone: %s", err)
+	}
+	date := func(loc *time.Location, y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, loc)
+	}
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		from  time.Time
+		to    time.Time
+		want  []string
+	}{
+		{
+			name:  "daily",
+			rule:  "FREQ=DAILY",
+			start: date(time.UTC, 2026, 1, 1),
+			from:  date(time.UTC, 2026, 1, 1),
+			to:    date(time.UTC, 2026, 1, 3),
+			want:  []string{"2026-01-01 00:00", "2026-01-02 00:00", "2026-01-03 00:00"},
+		},
+		{
+			name:  "daily interval",
+			rule:  "FREQ=DAILY;INTERVAL=2",
+			start: date(time.UTC, 2026, 1, 1),
+			from:  date(time.UTC, 2026, 1, 2),
+			to:    date(time.UTC, 2026, 1, 7),
+			want:  []string{"2026-01-03 00:00", "2026-01-05 00:00", "2026-01-07 00:00"},
+		},
+		{
+			name:  "daily byday",
+			rule:  "FREQ=DAILY;BYDAY=SA,SU",
+			start: date(time.UTC, 2026, 1, 1),
+			from:  date(time.UTC, 2026, 1, 1),
+			to:    date(time.UTC, 2026, 1, 11),
+			want:  []string{"2026-01-03 00:00", "2026-01-04 00:00", "2026-01-10 00:00", "2026-01-11 00:00"},
+		},
+		{
+			name:  "weekly on start weekday",
+			rule:  "FREQ=WEEKLY",
+			start: date(time.UTC, 2026, 1, 1),
+			from:  date(time.UTC, 2026, 1, 1),
+			to:    date(time.UTC, 2026, 1, 20),
+			want:  []string{"2026-01-01 00:00", "2026-01-08 00:00", "2026-01-15 00:00"},
+		},
+		{
+			name:  "weekly interval byday",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+			start: date(time.UTC, 2026, 1, 5),
+			from:  date(time.UTC, 2026, 1, 1),
+			to:    date(time.UTC, 2026, 1, 25),
+			want:  []string{"2026-01-05 00:00", "2026-01-07 00:00", "2026-01-19 00:00", "2026-01-21 00:00"},
+		},
+		{
+			name:  "count",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,FR;COUNT=3",
+			start: date(time.UTC, 2026, 1, 1),
+			from:  date(time.UTC, 2026, 1, 1),
+			to:    date(time.UTC, 2026, 1, 31),
+			want:  []string{"2026-01-02 00:00", "2026-01-05 00:00", "2026-01-09 00:00"},
+		},
+		{
+			name:  "count is counted from start",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: date(time.UTC, 2026, 1, 1),
+			from:  date(time.UTC, 2026, 1, 2),
+			to:    date(time.UTC, 2026, 1, 31),
+			want:  []string{"2026-01-02 00:00", "2026-01-03 00:00"},
+		},
+		{
+			name:  "until",
+			rule:  "FREQ=DAILY;UNTIL=20260104T120000Z",
+			start: date(time.UTC, 2026, 1, 1),
+			from:  date(time.UTC, 2026, 1, 1),
+			to:    date(time.UTC, 2026, 1, 31),
+			want:  []string{"2026-01-01 00:00", "2026-01-02 00:00", "2026-01-03 00:00", "2026-01-04 00:00"},
+		},
+		{
+			name:  "from before start",
+			rule:  "FREQ=DAILY",
+			start: date(time.UTC, 2026, 1, 5),
+			from:  date(time.UTC, 2026, 1, 1),
+			to:    date(time.UTC, 2026, 1, 6),
+			want:  []string{"2026-01-05 00:00", "2026-01-06 00:00"},
+		},
+		{
+			name:  "to before start",
+			rule:  "FREQ=DAILY",
+			start: date(time.UTC, 2026, 1, 5),
+			from:  date(time.UTC, 2026, 1, 1),
+			to:    date(time.UTC, 2026, 1, 4),
+			want:  nil,
+		},
+		{
+			name:  "daily over DST start",
+			rule:  "FREQ=DAILY",
+			start: date(stockholm, 2026, 3, 28),
+			from:  date(stockholm, 2026, 3, 28),
+			to:    date(stockholm, 2026, 3, 30),
+			want:  []string{"2026-03-28 00:00", "2026-03-29 00:00", "2026-03-30 00:00"},
+		},
+		{
+			name:  "weekly over DST start",
+			rule:  "FREQ=WEEKLY",
+			start: date(stockholm, 2026, 3, 22),
+			from:  date(stockholm, 2026, 3, 22),
+			to:    date(stockholm, 2026, 4, 5),
+			want:  []string{"2026-03-22 00:00", "2026-03-29 00:00", "2026-04-05 00:00"},
+		},
+		{
+			name:  "daily interval over DST end",
+			rule:  "FREQ=DAILY;INTERVAL=2",
+			start: date(stockholm, 2025, 10, 24),
+			from:  date(stockholm, 2025, 10, 24),
+			to:    date(stockholm, 2025, 10, 30),
+			want:  []string{"2025-10-24 00:00", "2025-10-26 00:00", "2025-10-28 00:00", "2025-10-30 00:00"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := Parse(tc.rule)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			var got []string
+			for _, d := range rule.Dates(tc.start, tc.from, tc.to) {
+				if d.Location() != tc.start.Location() {
+					t.Errorf("want location %s, got %s", tc.start.Location(), d.Location())
+				}
+				got = append(got, d.Format("2006-01-02 15:04"))
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
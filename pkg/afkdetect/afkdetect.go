@@ -19,6 +19,11 @@
 
 // Package afkdetect contains code to detect if the user has gone AFK or
 // returned from AFK.
+//
+// When multiple AFK-detector hooks are used, their results are combined. The
+// user is considered AFK as soon as any hook reports the user as AFK, and is
+// only considered to have returned once all hooks agree that the user is no
+// longer AFK.
 package afkdetect
 
 import (
@@ -36,8 +41,6 @@ var (
 	ErrObserverIsNil = errors.New("observer is nil")
 )
 
-var afkPollIntervalDur = 3 * time.Second
-var afkThresholdDur = 5 * time.Minute
 var log = logger.NewScoped("AFK")
 
 // Detector is an AFK-detector.
@@ -56,7 +59,11 @@ type Detector interface {
 }
 
 // Started contains event data for when user has gone AFK.
-type Started struct{}
+type Started struct {
+	// AFKSince is when the user went AFK. This is usually the time of the
+	// event, but may be earlier, such as when the computer has been suspended.
+	AFKSince time.Time
+}
 
 // Stopped contains event data for when user is no longer AFK (after being AFK).
 type Stopped struct {
@@ -71,11 +78,136 @@ type detectorHook interface {
 	Tick() error
 }
 
-var detectorHooks []detectorHookRegisterer
+// Names of the AFK-detector hooks that can be used in [Options.Detectors].
+// Not all hooks are available on all operating systems.
+const (
+	// DetectorGNOME uses the GNOME-specific org.gnome.Mutter.IdleMonitor and
+	// org.gnome.ScreenSaver D-Bus interfaces. Only available on GNU/Linux.
+	DetectorGNOME = "gnome"
+	// DetectorLogind uses the IdleHint property, the Lock and Unlock signals of
+	// the current session, and the PrepareForSleep signal from
+	// org.freedesktop.login1 on the system D-Bus. Only available on GNU/Linux.
+	DetectorLogind = "logind"
+	// DetectorScreenSaver uses the org.freedesktop.ScreenSaver D-Bus interface,
+	// as implemented by KDE and other desktop environments. Only available on
+	// GNU/Linux.
+	DetectorScreenSaver = "screensaver"
+	// DetectorIdleCommand runs the command from [Options.IdleCommand] to get
+	// the number of seconds the user has been idle.
+	DetectorIdleCommand = "idle-command"
+	// DetectorIdleFile reads the file from [Options.IdleFile] to get the number
+	// of seconds the user has been idle.
+	DetectorIdleFile = "idle-file"
+	// DetectorWindows uses the WH_KEYBOARD_LL and WH_MOUSE_LL Windows hooks.
+	// Only available on Windows.
+	DetectorWindows = "windows"
+)
+
+// Options for the AFK-detector.
+type Options struct {
+	// Detectors are the names of the AFK-detector hooks to use, in the order
+	// they are registered and polled. Hooks that are not available on the
+	// current OS are skipped. The order does not affect the result, as the
+	// user is considered AFK if any hook says so, and is only considered to
+	// have returned once no hook says so.
+	Detectors []string
+	// Enabled can be used to disable specific AFK-detector hooks by their
+	// names. Hooks set to false are skipped, while hooks missing from the map
+	// are enabled.
+	Enabled map[string]bool
+	// PollInterval is how often the AFK-detector hooks are polled for the
+	// idle time.
+	PollInterval time.Duration
+	// Threshold is how long the user must be idle to be considered AFK.
+	Threshold time.Duration
+	// IdleCommand is the command and its arguments that is executed by the
+	// "idle-command" hook on every poll. The command must write the number of
+	// seconds the user has been idle to its standard output, such as "42" or
+	// "42.5".
+	IdleCommand []string
+	// IdleFile is the path to the file that is read by the "idle-file" hook on
+	// every poll. The file must contain the number of seconds the user has been
+	// idle, such as "42" or "42.5".
+	IdleFile string
+	// Clock is used to get the current time and to poll the AFK-detector
+	// hooks. Can be replaced to drive the AFK-detector deterministically in
+	// tests without real timers.
+	Clock Clock
+}
+
+// Clock is an abstraction of the time functions used by the AFK-detector.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTicker returns a new ticker that sends the current time on its
+	// channel with the given interval.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker is an abstraction of [time.Ticker].
+type Ticker interface {
+	// Chan returns the channel on which the ticks are delivered.
+	Chan() <-chan time.Time
+	// Stop turns off the ticker.
+	Stop()
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTicker struct {
+	*time.Ticker
+}
+
+func (t realTicker) Chan() <-chan time.Time {
+	return t.C
+}
+
+// DefaultOptions values are used for any zero values used when creating a new
+// AFK-detector.
+var DefaultOptions = Options{
+	Detectors: []string{
+		DetectorGNOME,
+		DetectorScreenSaver,
+		DetectorLogind,
+		DetectorWindows,
+		DetectorIdleCommand,
+		DetectorIdleFile,
+	},
+	PollInterval: 3 * time.Second,
+	Threshold:    5 * time.Minute,
+	Clock:        realClock{},
+}
+
+var detectorHooks = map[string]detectorHookRegisterer{}
 
 // New creates a new AFK-detector.
-func New() Detector {
+//
+// Both the global DefaultOptions and the opt parameter is used. The
+// DefaultOptions values are only used for any zero valued fields in the
+// opt parameter.
+func New(opt Options) Detector {
+	if opt.Detectors == nil {
+		opt.Detectors = DefaultOptions.Detectors
+	}
+	if opt.PollInterval == 0 {
+		opt.PollInterval = DefaultOptions.PollInterval
+	}
+	if opt.Threshold == 0 {
+		opt.Threshold = DefaultOptions.Threshold
+	}
+	if opt.Clock == nil {
+		opt.Clock = DefaultOptions.Clock
+	}
 	return &detector{
+		opt: opt,
 		startedObs: chans.PubSub[Started]{
 			PubTimeoutAfter: 10 * time.Second,
 			OnPubTimeout: func(ev Started) {
@@ -92,60 +224,103 @@ func New() Detector {
 }
 
 type detector struct {
+	opt        Options
 	isAFKMutex sync.RWMutex
 	isAFK      bool
+	afkSources map[string]struct{}
 	startedObs chans.PubSub[Started]
 	stoppedObs chans.PubSub[Stopped]
 
 	hooks          []detectorHook
 	startStopMutex sync.Mutex
-	ticker         *time.Ticker
+	ticker         Ticker
 	tickChanStop   chan struct{}
 }
 
-func (d *detector) tryChangeIsAFK(isAFK bool) bool {
-	if d.isAFK == isAFK {
-		return false
+// sourceSuspend is the source name used when marking the user as AFK because
+// the computer was suspended, as detected by detectWallClockJump.
+const sourceSuspend = "suspend"
+
+// tryChangeSourceIsAFK sets whether the given source, such as the name of an
+// AFK-detector hook, considers the user as AFK. The user is AFK if any source
+// considers them AFK. Returns true if this changed whether the user is AFK.
+func (d *detector) tryChangeSourceIsAFK(source string, isAFK bool) bool {
+	d.isAFKMutex.Lock()
+	defer d.isAFKMutex.Unlock()
+	if isAFK {
+		if d.afkSources == nil {
+			d.afkSources = map[string]struct{}{}
+		}
+		d.afkSources[source] = struct{}{}
+	} else {
+		delete(d.afkSources, source)
 	}
-	d.isAFK = isAFK
-	return true
+	wasAFK := d.isAFK
+	d.isAFK = len(d.afkSources) > 0
+	return d.isAFK != wasAFK
+}
+
+func (d *detector) markAsAFK(source string) {
+	d.markAsAFKSince(source, d.opt.Clock.Now().Round(0))
 }
 
-func (d *detector) markAsAFK() {
-	if !d.tryChangeIsAFK(true) {
+func (d *detector) markAsAFKSince(source string, afkSince time.Time) {
+	if !d.tryChangeSourceIsAFK(source, true) {
 		return
 	}
-	log.Debug().Message("User is now AFK.")
-	d.startedObs.PubWait(Started{})
+	log.Debug().WithString("source", source).WithTime("since", afkSince).
+		Message("User is now AFK.")
+	d.startedObs.PubWait(Started{AFKSince: afkSince})
 }
 
-func (d *detector) markAsNoLongerAFK() {
-	if !d.tryChangeIsAFK(false) {
+func (d *detector) markAsNoLongerAFK(source string) {
+	if !d.tryChangeSourceIsAFK(source, false) {
 		return
 	}
-	log.Debug().Message("User is no longer AFK.")
+	log.Debug().WithString("source", source).Message("User is no longer AFK.")
 	d.stoppedObs.PubWait(Stopped{})
 }
 
-func (d *detector) StartDetecting() error {
-	if len(detectorHooks) == 0 {
-		log.Warn().Message("No AFK-detectors available for this OS.")
-		return nil
+// markByIdleDuration marks the user as AFK by the given source if the idle
+// duration exceeds the threshold, or as no longer AFK otherwise.
+func (d *detector) markByIdleDuration(source string, idleDur time.Duration) {
+	if idleDur > d.opt.Threshold {
+		d.markAsAFK(source)
+	} else {
+		d.markAsNoLongerAFK(source)
 	}
+}
+
+func (d *detector) StartDetecting() error {
 	d.startStopMutex.Lock()
 	defer d.startStopMutex.Unlock()
 	d.hooks = nil
-	for _, reg := range detectorHooks {
+	for _, name := range d.opt.Detectors {
+		if enabled, ok := d.opt.Enabled[name]; ok && !enabled {
+			log.Debug().WithString("detector", name).
+				Message("AFK-detector is disabled. Skipping.")
+			continue
+		}
+		reg, ok := detectorHooks[name]
+		if !ok {
+			log.Debug().WithString("detector", name).
+				Message("AFK-detector is not available for this OS. Skipping.")
+			continue
+		}
 		hook, err := reg.Register(d)
 		if err != nil {
-			d.StopDetecting()
-			return err
+			d.unregisterHooks()
+			return fmt.Errorf("register %s AFK-detector: %w", name, err)
 		}
 		if hook != nil {
 			d.hooks = append(d.hooks, hook)
 		}
 	}
-	d.ticker = time.NewTicker(afkPollIntervalDur)
+	if len(d.hooks) == 0 {
+		log.Warn().Message("No AFK-detectors available.")
+		return nil
+	}
+	d.ticker = d.opt.Clock.NewTicker(d.opt.PollInterval)
 	go d.timerTickListener(d.ticker)
 	return nil
 }
@@ -153,12 +328,7 @@ func (d *detector) StartDetecting() error {
 func (d *detector) StopDetecting() error {
 	d.startStopMutex.Lock()
 	defer d.startStopMutex.Unlock()
-	for _, hook := range d.hooks {
-		if err := hook.Unregister(); err != nil {
-			log.Error().WithError(err).Messagef("Failed to unregister %T.", hook)
-		}
-	}
-	d.hooks = nil
+	d.unregisterHooks()
 	if d.ticker != nil {
 		d.ticker.Stop()
 		d.ticker = nil
@@ -179,13 +349,26 @@ func (d *detector) StopDetecting() error {
 	return nil
 }
 
-func (d *detector) timerTickListener(ticker *time.Ticker) {
+func (d *detector) unregisterHooks() {
+	for _, hook := range d.hooks {
+		if err := hook.Unregister(); err != nil {
+			log.Error().WithError(err).Messagef("Failed to unregister %T.", hook)
+		}
+	}
+	d.hooks = nil
+}
+
+func (d *detector) timerTickListener(ticker Ticker) {
+	lastTick := d.opt.Clock.Now()
 	for {
 		select {
 		case <-d.tickChanStop:
 			ticker.Stop()
 			return
-		case <-ticker.C:
+		case <-ticker.Chan():
+			tick := d.opt.Clock.Now()
+			d.detectWallClockJump(lastTick, wallClockJump(lastTick, tick))
+			lastTick = tick
 			for _, hook := range d.hooks {
 				if err := hook.Tick(); err != nil {
 					log.Warn().WithError(err).
@@ -196,6 +379,30 @@ func (d *detector) timerTickListener(ticker *time.Ticker) {
 	}
 }
 
+// wallClockJump returns how far the wall clock has moved ahead of the monotonic
+// clock between the two ticks. The monotonic clock does not advance while the
+// computer is suspended or hibernating, while the wall clock does.
+func wallClockJump(prevTick, tick time.Time) time.Duration {
+	wallDur := tick.Round(0).Sub(prevTick.Round(0))
+	monotonicDur := tick.Sub(prevTick)
+	return wallDur - monotonicDur
+}
+
+// detectWallClockJump marks the user as AFK since the previous tick if the wall
+// clock has jumped ahead by more than the AFK threshold, as returned by
+// wallClockJump. The user is then marked as no longer AFK, as the computer has
+// been woken up again.
+func (d *detector) detectWallClockJump(prevTick time.Time, jump time.Duration) {
+	if jump <= d.opt.Threshold {
+		return
+	}
+	log.Debug().
+		WithDuration("jump", jump).
+		Message("Detected wall clock jump. Computer was likely suspended.")
+	d.markAsAFKSince(sourceSuspend, prevTick.Round(0))
+	d.markAsNoLongerAFK(sourceSuspend)
+}
+
 func (d *detector) StartedObs() *chans.PubSub[Started] {
 	return &d.startedObs
 }
@@ -0,0 +1,427 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package afkdetect
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	mutex   sync.Mutex
+	now     time.Time
+	tickers []*fakeTicker
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) NewTicker(time.Duration) Ticker {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	t := &fakeTicker{ch: make(chan time.Time)}
+	c.tickers = append(c.tickers, t)
+	return t
+}
+
+// tick sends the current time to all tickers, and blocks until they have
+// received it.
+func (c *fakeClock) tick() {
+	c.mutex.Lock()
+	now := c.now
+	tickers := c.tickers
+	c.mutex.Unlock()
+	for _, t := range tickers {
+		t.ch <- now
+	}
+}
+
+type fakeTicker struct {
+	ch chan time.Time
+}
+
+func (t *fakeTicker) Chan() <-chan time.Time {
+	return t.ch
+}
+
+func (t *fakeTicker) Stop() {
+}
+
+type fakeHookRegisterer struct {
+	name       string
+	registered *[]string
+	ticked     chan string
+}
+
+func (r fakeHookRegisterer) Register(*detector) (detectorHook, error) {
+	*r.registered = append(*r.registered, r.name)
+	return fakeHook(r), nil
+}
+
+type fakeHook fakeHookRegisterer
+
+func (fakeHook) Unregister() error {
+	return nil
+}
+
+func (h fakeHook) Tick() error {
+	h.ticked <- h.name
+	return nil
+}
+
+type eventRecorder struct {
+	started <-chan Started
+	stopped <-chan Stopped
+}
+
+func newTestDetector(opt Options) (*detector, *fakeClock, eventRecorder) {
+	clock := newFakeClock()
+	opt.Clock = clock
+	d := New(opt).(*detector)
+	rec := eventRecorder{
+		started: d.StartedObs().SubBuf(10),
+		stopped: d.StoppedObs().SubBuf(10),
+	}
+	return d, clock, rec
+}
+
+// drain returns the events that have been published so far.
+func (r eventRecorder) drain() ([]Started, int) {
+	var started []Started
+	var stopped int
+	for {
+		select {
+		case ev := <-r.started:
+			started = append(started, ev)
+		case <-r.stopped:
+			stopped++
+		default:
+			return started, stopped
+		}
+	}
+}
+
+func TestMarkByIdleDuration(t *testing.T) {
+	const threshold = 5 * time.Minute
+	tests := []struct {
+		name      string
+		idleDur   time.Duration
+		wantIsAFK bool
+	}{
+		{name: "not idle", idleDur: 0, wantIsAFK: false},
+		{name: "below threshold", idleDur: threshold - time.Second, wantIsAFK: false},
+		{name: "at threshold", idleDur: threshold, wantIsAFK: false},
+		{name: "just above threshold", idleDur: threshold + time.Nanosecond, wantIsAFK: true},
+		{name: "well above threshold", idleDur: 2 * threshold, wantIsAFK: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d, clock, rec := newTestDetector(Options{Threshold: threshold})
+			d.markByIdleDuration("test", tc.idleDur)
+			if d.isAFK != tc.wantIsAFK {
+				t.Errorf("want isAFK %t, got %t", tc.wantIsAFK, d.isAFK)
+			}
+			started, stopped := rec.drain()
+			if stopped != 0 {
+				t.Errorf("want 0 stopped events, got %d", stopped)
+			}
+			if !tc.wantIsAFK {
+				if len(started) != 0 {
+					t.Errorf("want 0 started events, got %d", len(started))
+				}
+				return
+			}
+			if len(started) != 1 {
+				t.Fatalf("want 1 started event, got %d", len(started))
+			}
+			if want := clock.Now(); !started[0].AFKSince.Equal(want) {
+				t.Errorf("want AFK since %s, got %s", want, started[0].AFKSince)
+			}
+		})
+	}
+}
+
+func TestMarkTransitions(t *testing.T) {
+	type step struct {
+		source string
+		isAFK  bool
+	}
+	tests := []struct {
+		name        string
+		steps       []step
+		wantStarted int
+		wantStopped int
+		wantIsAFK   bool
+	}{
+		{
+			name:        "no longer AFK without being AFK",
+			steps:       []step{{"a", false}},
+			wantStarted: 0,
+			wantStopped: 0,
+			wantIsAFK:   false,
+		},
+		{
+			name:        "AFK",
+			steps:       []step{{"a", true}},
+			wantStarted: 1,
+			wantStopped: 0,
+			wantIsAFK:   true,
+		},
+		{
+			name:        "AFK and back",
+			steps:       []step{{"a", true}, {"a", false}},
+			wantStarted: 1,
+			wantStopped: 1,
+			wantIsAFK:   false,
+		},
+		{
+			name:        "repeated AFK",
+			steps:       []step{{"a", true}, {"a", true}},
+			wantStarted: 1,
+			wantStopped: 0,
+			wantIsAFK:   true,
+		},
+		{
+			name:        "AFK twice",
+			steps:       []step{{"a", true}, {"a", false}, {"a", true}},
+			wantStarted: 2,
+			wantStopped: 1,
+			wantIsAFK:   true,
+		},
+		{
+			name:        "AFK if any source is AFK",
+			steps:       []step{{"a", false}, {"b", true}},
+			wantStarted: 1,
+			wantStopped: 0,
+			wantIsAFK:   true,
+		},
+		{
+			name:        "still AFK while other source is AFK",
+			steps:       []step{{"a", true}, {"b", true}, {"a", false}},
+			wantStarted: 1,
+			wantStopped: 0,
+			wantIsAFK:   true,
+		},
+		{
+			name:        "back when all sources agree",
+			steps:       []step{{"a", true}, {"b", true}, {"a", false}, {"b", false}},
+			wantStarted: 1,
+			wantStopped: 1,
+			wantIsAFK:   false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d, _, rec := newTestDetector(Options{})
+			for _, s := range tc.steps {
+				if s.isAFK {
+					d.markAsAFK(s.source)
+				} else {
+					d.markAsNoLongerAFK(s.source)
+				}
+			}
+			started, stopped := rec.drain()
+			if len(started) != tc.wantStarted {
+				t.Errorf("want %d started events, got %d", tc.wantStarted, len(started))
+			}
+			if stopped != tc.wantStopped {
+				t.Errorf("want %d stopped events, got %d", tc.wantStopped, stopped)
+			}
+			if d.isAFK != tc.wantIsAFK {
+				t.Errorf("want isAFK %t, got %t", tc.wantIsAFK, d.isAFK)
+			}
+		})
+	}
+}
+
+func TestStartDetectingEnabled(t *testing.T) {
+	tests := []struct {
+		name           string
+		detectors      []string
+		enabled        map[string]bool
+		wantRegistered []string
+	}{
+		{
+			name:           "all enabled by default",
+			detectors:      []string{"test-a", "test-b"},
+			wantRegistered: []string{"test-a", "test-b"},
+		},
+		{
+			name:           "explicitly enabled",
+			detectors:      []string{"test-a", "test-b"},
+			enabled:        map[string]bool{"test-a": true, "test-b": true},
+			wantRegistered: []string{"test-a", "test-b"},
+		},
+		{
+			name:           "one disabled",
+			detectors:      []string{"test-a", "test-b"},
+			enabled:        map[string]bool{"test-a": false},
+			wantRegistered: []string{"test-b"},
+		},
+		{
+			name:           "all disabled",
+			detectors:      []string{"test-a", "test-b"},
+			enabled:        map[string]bool{"test-a": false, "test-b": false},
+			wantRegistered: nil,
+		},
+		{
+			name:           "unavailable detector",
+			detectors:      []string{"test-missing", "test-b"},
+			wantRegistered: []string{"test-b"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var registered []string
+			ticked := make(chan string)
+			for _, name := range []string{"test-a", "test-b"} {
+				detectorHooks[name] = fakeHookRegisterer{
+					name:       name,
+					registered: &registered,
+					ticked:     ticked,
+				}
+			}
+			t.Cleanup(func() {
+				delete(detectorHooks, "test-a")
+				delete(detectorHooks, "test-b")
+			})
+			d, clock, _ := newTestDetector(Options{
+				Detectors: tc.detectors,
+				Enabled:   tc.enabled,
+			})
+			if err := d.StartDetecting(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			defer d.StopDetecting()
+			if !equalStrings(registered, tc.wantRegistered) {
+				t.Errorf("want registered %v, got %v", tc.wantRegistered, registered)
+			}
+			if len(tc.wantRegistered) == 0 {
+				if len(clock.tickers) != 0 {
+					t.Errorf("want no ticker, got %d", len(clock.tickers))
+				}
+				return
+			}
+			go clock.tick()
+			var gotTicked []string
+			for range tc.wantRegistered {
+				select {
+				case name := <-ticked:
+					gotTicked = append(gotTicked, name)
+				case <-time.After(time.Second):
+					t.Fatalf("timed out waiting for tick, got %v", gotTicked)
+				}
+			}
+			if !equalStrings(gotTicked, tc.wantRegistered) {
+				t.Errorf("want ticked %v, got %v", tc.wantRegistered, gotTicked)
+			}
+		})
+	}
+}
+
+func TestDetectWallClockJump(t *testing.T) {
+	const threshold = 5 * time.Minute
+	tests := []struct {
+		name        string
+		jump        time.Duration
+		alreadyAFK  bool
+		wantStarted int
+		wantStopped int
+		wantIsAFK   bool
+	}{
+		{
+			name: "no jump",
+			jump: 0,
+		},
+		{
+			name: "jump below threshold",
+			jump: threshold - time.Second,
+		},
+		{
+			name: "jump at threshold",
+			jump: threshold,
+		},
+		{
+			name:        "jump above threshold",
+			jump:        threshold + time.Second,
+			wantStarted: 1,
+			wantStopped: 1,
+		},
+		{
+			name:        "jump while AFK by other source",
+			jump:        time.Hour,
+			alreadyAFK:  true,
+			wantStarted: 1,
+			wantStopped: 0,
+			wantIsAFK:   true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d, clock, rec := newTestDetector(Options{Threshold: threshold})
+			if tc.alreadyAFK {
+				d.markAsAFK("other")
+			}
+			prevTick := clock.Now().Add(-time.Minute)
+			d.detectWallClockJump(prevTick, tc.jump)
+			started, stopped := rec.drain()
+			if len(started) != tc.wantStarted {
+				t.Fatalf("want %d started events, got %d", tc.wantStarted, len(started))
+			}
+			if stopped != tc.wantStopped {
+				t.Errorf("want %d stopped events, got %d", tc.wantStopped, stopped)
+			}
+			if d.isAFK != tc.wantIsAFK {
+				t.Errorf("want isAFK %t, got %t", tc.wantIsAFK, d.isAFK)
+			}
+			if tc.wantStarted > 0 && !tc.alreadyAFK && !started[0].AFKSince.Equal(prevTick) {
+				t.Errorf("want AFK since %s, got %s", prevTick, started[0].AFKSince)
+			}
+		})
+	}
+}
+
+func TestWallClockJumpWithoutSuspend(t *testing.T) {
+	prevTick := time.Now()
+	tick := prevTick.Add(3 * time.Second)
+	if jump := wallClockJump(prevTick, tick); jump != 0 {
+		t.Errorf("want no jump, got %s", jump)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -27,13 +27,13 @@ import (
 )
 
 func init() {
-	detectorHooks = append(detectorHooks, dbusHookRegisterer{})
+	detectorHooks[DetectorGNOME] = gnomeHookRegisterer{}
 }
 
-type dbusHookRegisterer struct {
+type gnomeHookRegisterer struct {
 }
 
-func (h dbusHookRegisterer) Register(d *detector) (detectorHook, error) {
+func (h gnomeHookRegisterer) Register(d *detector) (detectorHook, error) {
 	if d == nil {
 		return nil, nil
 	}
@@ -46,7 +46,7 @@ func (h dbusHookRegisterer) Register(d *detector) (detectorHook, error) {
 	// https://unix.stackexchange.com/a/492328
 	// https://gitlab.gnome.org/GNOME/mutter/-/blob/41.2/src/org.gnome.Mutter.IdleMonitor.xml#L14-16
 	idleMon := conn.Object("org.gnome.Mutter.IdleMonitor", "/org/gnome/Mutter/IdleMonitor/Core")
-	hook := &dbusHook{
+	hook := &gnomeHook{
 		d:       d,
 		conn:    conn,
 		idleMon: idleMon,
@@ -70,7 +70,7 @@ func (h dbusHookRegisterer) Register(d *detector) (detectorHook, error) {
 	return hook, nil
 }
 
-func (h *dbusHook) handleDbusSignal() {
+func (h *gnomeHook) handleDbusSignal() {
 	log.Debug().Message("Listen for dbus signals...")
 	ch := make(chan *dbus.Signal, 10)
 	h.conn.Signal(ch)
@@ -85,31 +85,31 @@ func (h *dbusHook) handleDbusSignal() {
 				continue
 			}
 			if activeChanged {
-				h.d.markAsAFK()
+				h.d.markAsAFK(DetectorGNOME)
 			} else {
-				h.d.markAsNoLongerAFK()
+				h.d.markAsNoLongerAFK(DetectorGNOME)
 			}
 		case "org.gnome.ScreenSaver.WakeUpScreen",
 			"org.gnome.Shell.Introspect.RunningApplicationsChanged":
-			h.d.markAsNoLongerAFK()
+			h.d.markAsNoLongerAFK(DetectorGNOME)
 		default:
 			log.Debug().WithString("name", signal.Name).Message("Unknown dbus signal.")
 		}
 	}
 }
 
-type dbusHook struct {
+type gnomeHook struct {
 	d       *detector
 	conn    *dbus.Conn
 	idleMon dbus.BusObject
 }
 
-func (h *dbusHook) Unregister() error {
+func (h *gnomeHook) Unregister() error {
 	log.Debug().Message("Unregistering dbus connection.")
 	return h.conn.Close()
 }
 
-func (h *dbusHook) Tick() error {
+func (h *gnomeHook) Tick() error {
 	if h.idleMon == nil {
 		return nil
 	}
@@ -120,15 +120,10 @@ func (h *dbusHook) Tick() error {
 			log.Debug().WithError(err).
 				Message("Detected 'unknown service' error. Disabling org.gnome.Mutter integration.")
 			h.idleMon = nil
-		} else {
-			return err
+			return nil
 		}
+		return err
 	}
-	idleDur := time.Duration(idleDurMs) * time.Millisecond
-	if idleDur > afkThresholdDur {
-		h.d.markAsAFK()
-	} else {
-		h.d.markAsNoLongerAFK()
-	}
+	h.d.markByIdleDuration(DetectorGNOME, time.Duration(idleDurMs)*time.Millisecond)
 	return nil
 }
@@ -0,0 +1,97 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package afkdetect
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func init() {
+	detectorHooks[DetectorIdleCommand] = idleCommandHookRegisterer{}
+	detectorHooks[DetectorIdleFile] = idleFileHookRegisterer{}
+}
+
+type idleCommandHookRegisterer struct {
+}
+
+func (h idleCommandHookRegisterer) Register(d *detector) (detectorHook, error) {
+	if d == nil || len(d.opt.IdleCommand) == 0 {
+		return nil, nil
+	}
+	args := d.opt.IdleCommand
+	log.Debug().WithString("command", strings.Join(args, " ")).
+		Message("Registering idle time command.")
+	return &idleHook{
+		d:    d,
+		name: DetectorIdleCommand,
+		readIdle: func() ([]byte, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), d.opt.PollInterval)
+			defer cancel()
+			return exec.CommandContext(ctx, args[0], args[1:]...).Output()
+		},
+	}, nil
+}
+
+type idleFileHookRegisterer struct {
+}
+
+func (h idleFileHookRegisterer) Register(d *detector) (detectorHook, error) {
+	if d == nil || d.opt.IdleFile == "" {
+		return nil, nil
+	}
+	path := d.opt.IdleFile
+	log.Debug().WithString("path", path).Message("Registering idle time file.")
+	return &idleHook{
+		d:    d,
+		name: DetectorIdleFile,
+		readIdle: func() ([]byte, error) {
+			return os.ReadFile(path)
+		},
+	}, nil
+}
+
+type idleHook struct {
+	d        *detector
+	name     string
+	readIdle func() ([]byte, error)
+}
+
+func (h *idleHook) Unregister() error {
+	return nil
+}
+
+func (h *idleHook) Tick() error {
+	b, err := h.readIdle()
+	if err != nil {
+		return err
+	}
+	idleDurSec, err := strconv.ParseFloat(strings.TrimSpace(string(b)), 64)
+	if err != nil {
+		return fmt.Errorf("parse idle seconds: %w", err)
+	}
+	h.d.markByIdleDuration(h.name, time.Duration(idleDurSec*float64(time.Second)))
+	return nil
+}
@@ -0,0 +1,172 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package afkdetect
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+)
+
+func init() {
+	detectorHooks[DetectorLogind] = logindHookRegisterer{}
+}
+
+type logindHookRegisterer struct {
+}
+
+func (h logindHookRegisterer) Register(d *detector) (detectorHook, error) {
+	if d == nil {
+		return nil, nil
+	}
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		log.Debug().WithError(err).Message("Failed to connect to system dbus.")
+		return nil, nil // swallow error, in case of GNU/Linux distros w/o dbus
+	}
+	sessionPath, err := logindSessionPath(conn)
+	if err != nil {
+		log.Debug().WithError(err).Message("Failed to find org.freedesktop.login1 session.")
+		conn.Close()
+		return nil, nil // swallow error, in case of GNU/Linux distros w/o systemd-logind
+	}
+	log.Debug().WithString("session", string(sessionPath)).
+		Message("Registering dbus connection for org.freedesktop.login1.")
+	// https://www.freedesktop.org/software/systemd/man/org.freedesktop.login1.html
+	if err := conn.AddMatchSignal(
+		dbus.WithMatchObjectPath(sessionPath),
+		dbus.WithMatchInterface("org.freedesktop.login1.Session"),
+	); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := conn.AddMatchSignal(
+		dbus.WithMatchObjectPath("/org/freedesktop/login1"),
+		dbus.WithMatchInterface("org.freedesktop.login1.Manager"),
+		dbus.WithMatchMember("PrepareForSleep"),
+	); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	hook := &logindHook{
+		d:       d,
+		conn:    conn,
+		session: conn.Object("org.freedesktop.login1", sessionPath),
+	}
+	go hook.handleDbusSignal()
+	return hook, nil
+}
+
+func logindSessionPath(conn *dbus.Conn) (dbus.ObjectPath, error) {
+	// The "auto" session refers to the session of the caller, or the display
+	// session of the user if the caller does not belong to a session, such as
+	// when running as a systemd user service. Signals are only sent from the
+	// real session object, so the "auto" session is resolved via its ID.
+	auto := conn.Object("org.freedesktop.login1", "/org/freedesktop/login1/session/auto")
+	idVariant, err := auto.GetProperty("org.freedesktop.login1.Session.Id")
+	if err != nil {
+		return "", err
+	}
+	id, ok := idVariant.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected session ID type: %s", idVariant.Signature())
+	}
+	var path dbus.ObjectPath
+	manager := conn.Object("org.freedesktop.login1", "/org/freedesktop/login1")
+	if err := manager.Call("org.freedesktop.login1.Manager.GetSession", 0, id).Store(&path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func (h *logindHook) handleDbusSignal() {
+	log.Debug().Message("Listen for org.freedesktop.login1 dbus signals...")
+	ch := make(chan *dbus.Signal, 10)
+	h.conn.Signal(ch)
+	for signal := range ch {
+		switch signal.Name {
+		case "org.freedesktop.login1.Session.Lock":
+			h.d.markAsAFK(DetectorLogind)
+		case "org.freedesktop.login1.Session.Unlock":
+			h.d.markAsNoLongerAFK(DetectorLogind)
+		case "org.freedesktop.login1.Manager.PrepareForSleep":
+			if len(signal.Body) != 1 {
+				continue
+			}
+			goingToSleep, ok := signal.Body[0].(bool)
+			if !ok {
+				continue
+			}
+			if goingToSleep {
+				h.d.markAsAFK(DetectorLogind)
+			} else {
+				h.d.markAsNoLongerAFK(DetectorLogind)
+			}
+		default:
+			log.Debug().WithString("name", signal.Name).Message("Unknown dbus signal.")
+		}
+	}
+}
+
+type logindHook struct {
+	d        *detector
+	conn     *dbus.Conn
+	session  dbus.BusObject
+	idleHint bool
+}
+
+func (h *logindHook) Unregister() error {
+	log.Debug().Message("Unregistering org.freedesktop.login1 dbus connection.")
+	return h.conn.Close()
+}
+
+func (h *logindHook) Tick() error {
+	if h.session == nil {
+		return nil
+	}
+	idleHintVariant, err := h.session.GetProperty("org.freedesktop.login1.Session.IdleHint")
+	if err != nil {
+		var dbusErr dbus.Error
+		if errors.As(err, &dbusErr) && dbusErr.Name == "org.freedesktop.DBus.Error.UnknownObject" {
+			log.Debug().WithError(err).
+				Message("Detected 'unknown object' error. Disabling org.freedesktop.login1 IdleHint integration.")
+			h.session = nil
+			return nil
+		}
+		return err
+	}
+	idleHint, ok := idleHintVariant.Value().(bool)
+	if !ok {
+		return fmt.Errorf("unexpected IdleHint type: %s", idleHintVariant.Signature())
+	}
+	// Only react on changes, as the IdleHint is not updated by all desktop
+	// environments, and would otherwise override the other AFK-detectors.
+	if idleHint == h.idleHint {
+		return nil
+	}
+	h.idleHint = idleHint
+	if idleHint {
+		h.d.markAsAFK(DetectorLogind)
+	} else {
+		h.d.markAsNoLongerAFK(DetectorLogind)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package afkdetect
+
+import (
+	"errors"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"gopkg.in/typ.v4/slices"
+)
+
+func init() {
+	detectorHooks[DetectorScreenSaver] = screenSaverHookRegisterer{}
+}
+
+// dbus errors that means the org.freedesktop.ScreenSaver idle time is not
+// available, such as on GNOME which only implements parts of the interface,
+// or on KDE when running on Wayland.
+var screenSaverUnsupportedErrNames = []string{
+	"org.freedesktop.DBus.Error.ServiceUnknown",
+	"org.freedesktop.DBus.Error.UnknownMethod",
+	"org.freedesktop.DBus.Error.NotSupported",
+}
+
+type screenSaverHookRegisterer struct {
+}
+
+func (h screenSaverHookRegisterer) Register(d *detector) (detectorHook, error) {
+	if d == nil {
+		return nil, nil
+	}
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		log.Debug().WithError(err).Message("Failed to connect to session dbus.")
+		return nil, nil // swallow error, in case of GNU/Linux distros w/o dbus
+	}
+	log.Debug().Message("Registering dbus connection for org.freedesktop.ScreenSaver.")
+	// https://specifications.freedesktop.org/idle-inhibit-spec/latest/re01.html
+	if err := conn.AddMatchSignal(
+		dbus.WithMatchInterface("org.freedesktop.ScreenSaver"),
+		dbus.WithMatchMember("ActiveChanged"),
+	); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	hook := &screenSaverHook{
+		d:           d,
+		conn:        conn,
+		screenSaver: conn.Object("org.freedesktop.ScreenSaver", "/org/freedesktop/ScreenSaver"),
+	}
+	go hook.handleDbusSignal()
+	return hook, nil
+}
+
+func (h *screenSaverHook) handleDbusSignal() {
+	log.Debug().Message("Listen for org.freedesktop.ScreenSaver dbus signals...")
+	ch := make(chan *dbus.Signal, 10)
+	h.conn.Signal(ch)
+	for signal := range ch {
+		switch signal.Name {
+		case "org.freedesktop.ScreenSaver.ActiveChanged":
+			if len(signal.Body) != 1 {
+				continue
+			}
+			active, ok := signal.Body[0].(bool)
+			if !ok {
+				continue
+			}
+			if active {
+				h.d.markAsAFK(DetectorScreenSaver)
+			} else {
+				h.d.markAsNoLongerAFK(DetectorScreenSaver)
+			}
+		default:
+			log.Debug().WithString("name", signal.Name).Message("Unknown dbus signal.")
+		}
+	}
+}
+
+type screenSaverHook struct {
+	d           *detector
+	conn        *dbus.Conn
+	screenSaver dbus.BusObject
+}
+
+func (h *screenSaverHook) Unregister() error {
+	log.Debug().Message("Unregistering org.freedesktop.ScreenSaver dbus connection.")
+	return h.conn.Close()
+}
+
+func (h *screenSaverHook) Tick() error {
+	if h.screenSaver == nil {
+		return nil
+	}
+	var idleDurSec uint32
+	if err := h.screenSaver.Call("org.freedesktop.ScreenSaver.GetSessionIdleTime", 0).Store(&idleDurSec); err != nil {
+		var dbusErr dbus.Error
+		if errors.As(err, &dbusErr) && slices.Contains(screenSaverUnsupportedErrNames, dbusErr.Name) {
+			log.Debug().WithError(err).
+				Message("Detected unsupported idle time. Disabling org.freedesktop.ScreenSaver idle time integration.")
+			h.screenSaver = nil
+			return nil
+		}
+		return err
+	}
+	h.d.markByIdleDuration(DetectorScreenSaver, time.Duration(idleDurSec)*time.Second)
+	return nil
+}
@@ -31,7 +31,7 @@ import (
 var singletonWindowsHooks = &windowsHooks{}
 
 func init() {
-	detectorHooks = append(detectorHooks, singletonWindowsHooks)
+	detectorHooks[DetectorWindows] = singletonWindowsHooks
 }
 
 type windowsHooks struct {
@@ -87,7 +87,7 @@ func convUnregisterCodeToErr(code int32) error {
 
 func (h *windowsHooks) Tick() error {
 	if bool(C.GetWorkstationLocked()) {
-		h.detector.markAsAFK()
+		h.detector.markAsAFK(DetectorWindows)
 		return nil
 	}
 	if err := convSysErrCode(int32(C.GetThreadStatus())); err != nil {
@@ -95,11 +95,7 @@ func (h *windowsHooks) Tick() error {
 	}
 	sinceAFKMs := C.GetTickMs() - C.GetLastEventTickMs()
 	sinceAFK := (time.Duration(sinceAFKMs) * time.Millisecond).Truncate(time.Second)
-	if sinceAFK > afkThresholdDur {
-		h.detector.markAsAFK()
-	} else {
-		h.detector.markAsNoLongerAFK()
-	}
+	h.detector.markByIdleDuration(DetectorWindows, sinceAFK)
 	return nil
 }
 
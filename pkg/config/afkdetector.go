@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2022 Risk.Ident GmbH <contact@riskident.com>
+// SPDX-FileCopyrightText: 2023 Kalle Fagerberg
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"encoding"
+	"fmt"
+
+	"github.com/invopop/jsonschema"
+	"github.com/spf13/pflag"
+)
+
+type AFKDetector string
+
+const (
+	AFKDetectorGNOME       AFKDetector = "gnome"
+	AFKDetectorLogind      AFKDetector = "logind"
+	AFKDetectorScreenSaver AFKDetector = "screensaver"
+	AFKDetectorIdleCommand AFKDetector = "idle-command"
+	AFKDetectorIdleFile    AFKDetector = "idle-file"
+	AFKDetectorWindows     AFKDetector = "windows"
+)
+
+func _() {
+	// Ensure the type implements the interfaces
+	d := AFKDetectorGNOME
+	var _ pflag.Value = &d
+	var _ encoding.TextUnmarshaler = &d
+	var _ jsonSchemaInterface = d
+}
+
+func (d AFKDetector) String() string {
+	return string(d)
+}
+
+func (d *AFKDetector) Set(value string) error {
+	switch AFKDetector(value) {
+	case AFKDetectorGNOME,
+		AFKDetectorLogind,
+		AFKDetectorScreenSaver,
+		AFKDetectorIdleCommand,
+		AFKDetectorIdleFile,
+		AFKDetectorWindows:
+		*d = AFKDetector(value)
+	default:
+		return fmt.Errorf("unknown AFK-detector: %q, must be one of: gnome, logind, screensaver, idle-command, idle-file, windows", value)
+	}
+	return nil
+}
+
+func (d *AFKDetector) Type() string {
+	return "detector"
+}
+
+func (d *AFKDetector) UnmarshalText(text []byte) error {
+	return d.Set(string(text))
+}
+
+// JSONSchema returns the JSON schema struct for this struct.
+func (AFKDetector) JSONSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:  "string",
+		Title: "AFK-detector",
+		Enum: []any{
+			AFKDetectorGNOME,
+			AFKDetectorLogind,
+			AFKDetectorScreenSaver,
+			AFKDetectorIdleCommand,
+			AFKDetectorIdleFile,
+			AFKDetectorWindows,
+		},
+	}
+}
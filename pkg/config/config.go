@@ -25,18 +25,25 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/dinkur/dinkur/internal/casing"
 	"github.com/dinkur/dinkur/internal/cfgpath"
+	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/invopop/jsonschema"
 	"github.com/iver-wharf/wharf-core/v2/pkg/logger"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
+	"gopkg.in/typ.v4/slices"
 	"gopkg.in/yaml.v3"
 )
 
 var log = logger.NewScoped("config")
 
+const unixAddressPrefix = "unix://"
+
 var Default = Config{
 	fileUsed: "(embedded defaults)",
 	Sqlite: Sqlite{
@@ -45,10 +52,48 @@ var Default = Config{
 	},
 	Client: ClientTypeSqlite,
 	GRPC: GRPC{
-		Address: "localhost:59122",
+		Address:    cfgpath.DaemonAddress,
+		TokenFile:  cfgpath.TokenPath,
+		TLSPinFile: cfgpath.TLSPinPath,
 	},
 	Daemon: Daemon{
-		BindAddress: "localhost:59122",
+		BindAddress: cfgpath.DaemonAddress,
+		TokenFile:   cfgpath.TokenPath,
+	},
+	Entries: Entries{
+		OverlapPolicy: OverlapPolicy(dinkur.OverlapPolicyAllow),
+	},
+	WorkHours: WorkHours{
+		Start: TimeOfDay(8 * time.Hour),
+		End:   TimeOfDay(17 * time.Hour),
+		Days: []Weekday{
+			Weekday(time.Monday),
+			Weekday(time.Tuesday),
+			Weekday(time.Wednesday),
+			Weekday(time.Thursday),
+			Weekday(time.Friday),
+		},
+		DailyTarget: Duration(8 * time.Hour),
+	},
+	AFK: AFK{
+		Detectors: []AFKDetector{
+			AFKDetectorGNOME,
+			AFKDetectorScreenSaver,
+			AFKDetectorLogind,
+			AFKDetectorWindows,
+			AFKDetectorIdleCommand,
+			AFKDetectorIdleFile,
+		},
+		Enabled: AFKDetectorsEnabled{
+			Gnome:       true,
+			Logind:      true,
+			ScreenSaver: true,
+			IdleCommand: true,
+			IdleFile:    true,
+			Windows:     true,
+		},
+		PollInterval: Duration(3 * time.Second),
+		Threshold:    Duration(5 * time.Minute),
 	},
 	Log: Log{
 		Format: LogFormatPretty,
@@ -65,6 +110,11 @@ type Config struct {
 	GRPC   GRPC
 	Daemon Daemon
 
+	Entries   Entries
+	WorkHours WorkHours
+
+	AFK AFK
+
 	Log Log
 }
 
@@ -83,14 +133,193 @@ type Sqlite struct {
 }
 
 type GRPC struct {
-	// Address defines which IP/hostname and port to reach the API on.
+	// Address defines which IP/hostname and port to reach the API on, or the
+	// path to a Unix domain socket prefixed with "unix://". Environment
+	// variables and $UID are expanded in the socket path, such as in
+	// "unix:///run/user/$UID/dinkur.sock".
 	Address string
+	// Token is the authentication token sent to the gRPC API. Takes
+	// precedence over TokenFile if set.
+	Token string
+	// TokenFile is the path of a file containing the authentication token sent
+	// to the gRPC API. Defaults to the same file as the daemon generates its
+	// token into.
+	TokenFile string
+	// TLS enables connecting to the gRPC API over TLS.
+	TLS bool
+	// TLSCAFile is the path to a PEM-encoded file of certificate authorities
+	// used to verify the daemon's TLS certificate. Defaults to the system's
+	// certificate authorities.
+	TLSCAFile string
+	// TLSServerName overrides the hostname used to verify the daemon's TLS
+	// certificate.
+	TLSServerName string
+	// TLSTrustOnFirstUse skips verifying the daemon's TLS certificate against
+	// certificate authorities, and instead pins the certificate on the first
+	// connection. Any later connection fails if the daemon's certificate has
+	// changed. Meant to be used with self-signed certificates. Ignored if
+	// TLSCAFile is set, as the certificate is then verified against the
+	// certificate authorities in that file instead.
+	TLSTrustOnFirstUse bool
+	// TLSPinFile is the path of the file where TLS certificates are pinned
+	// when TLSTrustOnFirstUse is enabled.
+	TLSPinFile string
 }
 
 type Daemon struct {
 	// BindAddress defines which IP/hostname and port to serve the gRPC API on.
-	// Can be set to 0.0.0.0 as IP to allow access from any IP.
+	// Can be set to 0.0.0.0 as IP to allow access from any IP. Can also be set
+	// to the path of a Unix domain socket prefixed with "unix://", in which
+	// case environment variables and $UID are expanded in the path, such as
+	// in "unix:///run/user/$UID/dinkur.sock". The socket's directory must be
+	// owned by you and must not be writable by group or others.
 	BindAddress string
+	// TokenFile is the path of the file containing the authentication token
+	// that clients must send to access the gRPC API. A new token is generated
+	// and written to the file when the daemon starts, if the file does not
+	// already exist.
+	TokenFile string
+	// TLSCertFile is the path to a PEM-encoded TLS certificate file. The gRPC
+	// API is only served over TLS if both TLSCertFile and TLSKeyFile are set.
+	TLSCertFile string
+	// TLSKeyFile is the path to the PEM-encoded private key of the TLS
+	// certificate.
+	TLSKeyFile string
+	// TLSSelfSigned generates a self-signed TLS certificate and key into the
+	// TLSCertFile and TLSKeyFile paths when the daemon starts, if the
+	// certificate file does not already exist.
+	TLSSelfSigned bool
+	// MaterializeRecurring enables adding entries from recurring templates
+	// when their scheduled start time is reached. Entries are added by the
+	// daemon, and only while it is running. Occurrences that were missed
+	// while the daemon was stopped are added when it starts again.
+	MaterializeRecurring bool
+}
+
+type Entries struct {
+	// OverlapPolicy defines what to do when an entry is added or edited so
+	// that its time span overlaps other entries. Defaults to "allow", which
+	// leaves the other entries as-is. The other policies are opt-in: "reject"
+	// to fail with an error, "trim" to shorten the other entries, or "split"
+	// to also split any entry that covers the whole new entry in two around
+	// it. Entries that would be shortened to nothing are removed.
+	OverlapPolicy OverlapPolicy
+}
+
+type WorkHours struct {
+	// Start is the time of day when working hours start, such as "08:00".
+	Start TimeOfDay
+	// End is the time of day when working hours end, such as "17:00". Use
+	// "24:00" to end working hours at midnight.
+	End TimeOfDay
+	// Days are the days of the week that have working hours, such as
+	// "monday". Time on all other days is not considered working time.
+	Days []Weekday
+	// DailyTarget is how long you are expected to work on each of the Days,
+	// such as "8h" or "7h30m", which is used by the "dinkur balance" command.
+	// It takes precedence over the time between Start and End, which is only
+	// used as the target if this is set to "0s", as the working hours usually
+	// also include breaks such as lunch. Defaults to "8h".
+	DailyTarget Duration
+	// BalanceStart is the date from when the flex balance is accumulated,
+	// such as the first day of your employment, formatted as "2024-01-02". No
+	// time before this date is counted by the "dinkur balance" command.
+	BalanceStart Date
+	// Holidays are dates when no work is expected, such as public holidays,
+	// formatted as "2024-12-24".
+	Holidays []Date
+	// VacationDays are dates when no work is expected because you are on
+	// vacation or other leave, formatted as "2024-07-15".
+	VacationDays []Date
+}
+
+// Target returns the expected working time on each working day, which is the
+// DailyTarget if set, or otherwise the time between Start and End.
+func (h WorkHours) Target() time.Duration {
+	if h.DailyTarget > 0 {
+		return time.Duration(h.DailyTarget)
+	}
+	if h.End <= h.Start {
+		return 0
+	}
+	return time.Duration(h.End - h.Start)
+}
+
+// IsWorkDay returns true if the day of the week of the given time is one of
+// the days with working hours.
+func (h WorkHours) IsWorkDay(t time.Time) bool {
+	return slices.Contains(h.Days, Weekday(t.Weekday()))
+}
+
+// ExpectedOn returns the expected working time on the date of the given time,
+// which is zero on holidays, vacation days, and days without working hours.
+func (h WorkHours) ExpectedOn(t time.Time) time.Duration {
+	if !h.IsWorkDay(t) || h.IsHoliday(t) || h.IsVacationDay(t) {
+		return 0
+	}
+	return h.Target()
+}
+
+// IsHoliday returns true if the date of the given time is a holiday.
+func (h WorkHours) IsHoliday(t time.Time) bool {
+	return slices.Contains(h.Holidays, DateOf(t))
+}
+
+// IsVacationDay returns true if the date of the given time is a vacation day.
+func (h WorkHours) IsVacationDay(t time.Time) bool {
+	return slices.Contains(h.VacationDays, DateOf(t))
+}
+
+type AFK struct {
+	// Detectors are the AFK-detectors used by the daemon to detect when you
+	// have gone away from and returned to your computer, in the order they
+	// are registered and polled. Detectors not available on the current OS
+	// are skipped. Choose from "gnome", "logind", "screensaver",
+	// "idle-command", "idle-file", and "windows". You are considered AFK as
+	// soon as any detector says so, and are only considered to have returned
+	// once all detectors agree that you are no longer AFK.
+	Detectors []AFKDetector
+	// Enabled can be used to turn off individual AFK-detectors without
+	// having to change the Detectors list.
+	Enabled AFKDetectorsEnabled
+	// PollInterval is how often the AFK-detectors check how long you have
+	// been idle, such as "3s".
+	PollInterval Duration
+	// Threshold is how long you must be idle before you are considered AFK,
+	// such as "5m".
+	Threshold Duration
+	// AutoStopAfter makes the daemon stop the active entry when you have been
+	// AFK for this long, such as "2h". The entry is stopped at the time you
+	// went AFK. Defaults to "0s", which disables this feature.
+	AutoStopAfter Duration
+	// AutoRestart makes the daemon start a new entry with the same name,
+	// note, tags, and project as the automatically stopped entry when you
+	// return. Otherwise you are asked how to resolve the away time. Only used
+	// if AutoStopAfter is set.
+	AutoRestart bool
+	// IdleCommand is the command and its arguments that the "idle-command"
+	// AFK-detector runs every few seconds. The command must print the number
+	// of seconds you have been idle, such as ["sh", "-c", "echo 42"].
+	IdleCommand []string
+	// IdleFile is the path to the file that the "idle-file" AFK-detector
+	// reads every few seconds. The file must contain the number of seconds
+	// you have been idle, such as written by a "swayidle" timeout command.
+	IdleFile string
+}
+
+type AFKDetectorsEnabled struct {
+	// Gnome enables the "gnome" AFK-detector.
+	Gnome bool
+	// Logind enables the "logind" AFK-detector.
+	Logind bool
+	// ScreenSaver enables the "screensaver" AFK-detector.
+	ScreenSaver bool
+	// IdleCommand enables the "idle-command" AFK-detector.
+	IdleCommand bool
+	// IdleFile enables the "idle-file" AFK-detector.
+	IdleFile bool
+	// Windows enables the "windows" AFK-detector.
+	Windows bool
 }
 
 type Log struct {
@@ -189,13 +418,36 @@ func AddDefaults(v *viper.Viper) error {
 func Unmarshal(v *viper.Viper, cfg *Config) error {
 	err := v.Unmarshal(cfg, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
 		mapstructure.TextUnmarshallerHookFunc(),
+		dateFromTimeHookFunc(),
 		mapstructure.StringToTimeDurationHookFunc(), // default hook
 		mapstructure.StringToSliceHookFunc(","),     // default hook
 	)))
 	cfg.fileUsed = v.ConfigFileUsed()
+	cfg.GRPC.Address = ExpandAddress(cfg.GRPC.Address)
+	cfg.Daemon.BindAddress = ExpandAddress(cfg.Daemon.BindAddress)
 	return err
 }
 
+// ExpandAddress expands environment variables in Unix domain socket
+// addresses, i.e addresses prefixed with "unix://". The $UID variable is
+// expanded to the current user ID, even if it's not set as an environment
+// variable. Other addresses are returned as-is.
+func ExpandAddress(addr string) string {
+	path, ok := strings.CutPrefix(addr, unixAddressPrefix)
+	if !ok {
+		return addr
+	}
+	return unixAddressPrefix + os.Expand(path, func(key string) string {
+		if key == "UID" {
+			if uid, ok := os.LookupEnv("UID"); ok {
+				return uid
+			}
+			return strconv.Itoa(os.Getuid())
+		}
+		return os.Getenv(key)
+	})
+}
+
 // JSONSchema returns the JSON schema struct for the [Config] struct.
 func JSONSchema() *jsonschema.Schema {
 	r := new(jsonschema.Reflector)
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2022 Risk.Ident GmbH <contact@riskident.com>
+// SPDX-FileCopyrightText: 2023 Kalle Fagerberg
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+package config
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/invopop/jsonschema"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/pflag"
+)
+
+const dateLayout = "2006-01-02"
+
+// Date is a calendar date without a time of day, formatted as "2006-01-02".
+// The zero value is formatted as an empty string.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+func _() {
+	// Ensure the type implements the interfaces
+	d := Date{}
+	var _ pflag.Value = &d
+	var _ encoding.TextUnmarshaler = &d
+	var _ jsonSchemaInterface = d
+}
+
+// DateOf returns the date of a time, in the time's location.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{year, month, day}
+}
+
+// IsZero returns true if the date has not been set.
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// Time returns midnight at the start of the date in the given location.
+func (d Date) Time(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+func (d *Date) UnmarshalText(text []byte) error {
+	return d.Set(string(text))
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.Time(time.UTC).Format(dateLayout)
+}
+
+func (d *Date) Set(value string) error {
+	if value == "" {
+		*d = Date{}
+		return nil
+	}
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return fmt.Errorf("invalid date: %q, must be in the format of YYYY-MM-DD, such as 2024-12-24", value)
+	}
+	*d = DateOf(t)
+	return nil
+}
+
+func (d *Date) Type() string {
+	return "date"
+}
+
+// JSONSchema returns the JSON schema struct for this struct.
+func (Date) JSONSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:     "string",
+		Title:    "Date",
+		Pattern:  `^$|^[0-9]{4}-[0-9]{2}-[0-9]{2}$`,
+		Examples: []any{"2024-12-24"},
+	}
+}
+
+// dateFromTimeHookFunc is a mapstructure decode hook that converts timestamps
+// into dates, as YAML decodes unquoted dates such as 2024-12-24 as timestamps.
+func dateFromTimeHookFunc() mapstructure.DecodeHookFuncType {
+	return func(from, to reflect.Type, data any) (any, error) {
+		if to != reflect.TypeOf(Date{}) {
+			return data, nil
+		}
+		if t, ok := data.(time.Time); ok {
+			return DateOf(t), nil
+		}
+		return data, nil
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2022 Risk.Ident GmbH <contact@riskident.com>
+// SPDX-FileCopyrightText: 2023 Kalle Fagerberg
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"encoding"
+	"fmt"
+	"time"
+
+	"github.com/invopop/jsonschema"
+	"github.com/spf13/pflag"
+)
+
+// Duration is a time duration, formatted as "1h30m" or "45s".
+type Duration time.Duration
+
+func _() {
+	// Ensure the type implements the interfaces
+	d := Duration(0)
+	var _ pflag.Value = &d
+	var _ encoding.TextUnmarshaler = &d
+	var _ jsonSchemaInterface = d
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	return d.Set(string(text))
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+func (d *Duration) Set(value string) error {
+	dur, err := time.ParseDuration(value)
+	if err != nil || dur < 0 {
+		return fmt.Errorf("invalid duration: %q, must be a positive duration, such as 5m or 1h30m", value)
+	}
+	*d = Duration(dur)
+	return nil
+}
+
+func (d *Duration) Type() string {
+	return "duration"
+}
+
+// JSONSchema returns the JSON schema struct for this struct.
+func (Duration) JSONSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:     "string",
+		Title:    "Duration",
+		Pattern:  `^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$|^0$`,
+		Examples: []any{"3s", "5m", "1h30m"},
+	}
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2022 Risk.Ident GmbH <contact@riskident.com>
+// SPDX-FileCopyrightText: 2023 Kalle Fagerberg
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"encoding"
+	"fmt"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/invopop/jsonschema"
+	"github.com/spf13/pflag"
+)
+
+type OverlapPolicy dinkur.OverlapPolicy
+
+func _() {
+	// Ensure the type implements the interfaces
+	p := OverlapPolicy(dinkur.OverlapPolicyReject)
+	var _ pflag.Value = &p
+	var _ encoding.TextUnmarshaler = &p
+	var _ jsonSchemaInterface = p
+}
+
+func (p *OverlapPolicy) UnmarshalText(text []byte) error {
+	return p.Set(string(text))
+}
+
+func (p OverlapPolicy) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p OverlapPolicy) String() string {
+	return dinkur.OverlapPolicy(p).String()
+}
+
+func (p *OverlapPolicy) Set(value string) error {
+	switch value {
+	case "allow":
+		*p = OverlapPolicy(dinkur.OverlapPolicyAllow)
+	case "reject":
+		*p = OverlapPolicy(dinkur.OverlapPolicyReject)
+	case "trim":
+		*p = OverlapPolicy(dinkur.OverlapPolicyTrim)
+	case "split":
+		*p = OverlapPolicy(dinkur.OverlapPolicySplit)
+	default:
+		return fmt.Errorf("unknown overlap policy: %q, must be one of: allow, reject, trim, split", value)
+	}
+	return nil
+}
+
+func (p *OverlapPolicy) Type() string {
+	return "policy"
+}
+
+// JSONSchema returns the JSON schema struct for this struct.
+func (OverlapPolicy) JSONSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:  "string",
+		Title: "Entry overlap policy",
+		Enum: []any{
+			OverlapPolicy(dinkur.OverlapPolicyAllow),
+			OverlapPolicy(dinkur.OverlapPolicyReject),
+			OverlapPolicy(dinkur.OverlapPolicyTrim),
+			OverlapPolicy(dinkur.OverlapPolicySplit),
+		},
+	}
+}
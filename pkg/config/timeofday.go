@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2022 Risk.Ident GmbH <contact@riskident.com>
+// SPDX-FileCopyrightText: 2023 Kalle Fagerberg
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"encoding"
+	"fmt"
+	"time"
+
+	"github.com/invopop/jsonschema"
+	"github.com/spf13/pflag"
+)
+
+// TimeOfDay is a time of day, stored as the duration since midnight, and
+// formatted as "15:04".
+type TimeOfDay time.Duration
+
+func _() {
+	// Ensure the type implements the interfaces
+	t := TimeOfDay(0)
+	var _ pflag.Value = &t
+	var _ encoding.TextUnmarshaler = &t
+	var _ jsonSchemaInterface = t
+}
+
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	return t.Set(string(text))
+}
+
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t TimeOfDay) String() string {
+	d := time.Duration(t)
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+func (t *TimeOfDay) Set(value string) error {
+	var hour, minute int
+	if _, err := fmt.Sscanf(value, "%d:%d", &hour, &minute); err != nil ||
+		hour < 0 || hour > 24 || minute < 0 || minute > 59 || (hour == 24 && minute != 0) {
+		return fmt.Errorf("invalid time of day: %q, must be in the format of HH:MM, such as 08:30", value)
+	}
+	*t = TimeOfDay(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	return nil
+}
+
+func (t *TimeOfDay) Type() string {
+	return "time"
+}
+
+// JSONSchema returns the JSON schema struct for this struct.
+func (TimeOfDay) JSONSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:     "string",
+		Title:    "Time of day",
+		Pattern:  `^([01]?[0-9]|2[0-3]):[0-5][0-9]$|^24:00$`,
+		Examples: []any{"08:30", "17:00"},
+	}
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2022 Risk.Ident GmbH <contact@riskident.com>
+// SPDX-FileCopyrightText: 2023 Kalle Fagerberg
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"encoding"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/invopop/jsonschema"
+	"github.com/spf13/pflag"
+)
+
+// Weekday is a day of the week, formatted as its lowercase English name, such
+// as "monday".
+type Weekday time.Weekday
+
+func _() {
+	// Ensure the type implements the interfaces
+	d := Weekday(time.Monday)
+	var _ pflag.Value = &d
+	var _ encoding.TextUnmarshaler = &d
+	var _ jsonSchemaInterface = d
+}
+
+func (d *Weekday) UnmarshalText(text []byte) error {
+	return d.Set(string(text))
+}
+
+func (d Weekday) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d Weekday) String() string {
+	return strings.ToLower(time.Weekday(d).String())
+}
+
+func (d *Weekday) Set(value string) error {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if strings.EqualFold(value, wd.String()) {
+			*d = Weekday(wd)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown weekday: %q, must be one of: monday, tuesday, wednesday, thursday, friday, saturday, sunday", value)
+}
+
+func (d *Weekday) Type() string {
+	return "weekday"
+}
+
+// JSONSchema returns the JSON schema struct for this struct.
+func (Weekday) JSONSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:  "string",
+		Title: "Day of the week",
+		Enum: []any{
+			Weekday(time.Monday),
+			Weekday(time.Tuesday),
+			Weekday(time.Wednesday),
+			Weekday(time.Thursday),
+			Weekday(time.Friday),
+			Weekday(time.Saturday),
+			Weekday(time.Sunday),
+		},
+	}
+}
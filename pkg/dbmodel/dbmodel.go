@@ -24,6 +24,8 @@ package dbmodel
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // Fields names for CommonFields.
@@ -49,14 +51,20 @@ type CommonFields struct {
 
 // Field names for Entry.
 const (
-	EntryFieldEnd = "End"
+	EntryFieldEnd     = "End"
+	EntryFieldTags    = "Tags"
+	EntryFieldProject = "Project"
 )
 
 // Column names for Entry.
 const (
-	EntryColumnID    = "id"
-	EntryColumnStart = "start"
-	EntryColumnEnd   = "end"
+	EntryColumnID        = "id"
+	EntryColumnName      = "name"
+	EntryColumnNote      = "note"
+	EntryColumnStart     = "start"
+	EntryColumnEnd       = "end"
+	EntryColumnProjectID = "project_id"
+	EntryColumnDeletedAt = "deleted_at"
 )
 
 // Entry is a time tracked entry stored in the database.
@@ -64,10 +72,31 @@ type Entry struct {
 	CommonFields
 	// Name of the entry.
 	Name string `gorm:"not null;default:''"`
+	// Note is a longer free-text description of the entry, or empty if the
+	// entry has no note.
+	Note string `gorm:"not null;default:''"`
 	// Start time of the entry.
 	Start time.Time `gorm:"not null;default:CURRENT_TIMESTAMP;index"`
 	// End time of the entry, or nil if the entry is still active.
 	End *time.Time `gorm:"index"`
+	// Tags is the list of tags attached to this entry.
+	Tags []EntryTag `gorm:"foreignKey:EntryID;constraint:OnDelete:CASCADE"`
+	// ProjectID is the ID of the project this entry references, or nil if
+	// the entry does not reference any project.
+	ProjectID *uint `gorm:"index"`
+	// Project is the project this entry references, or nil if the entry does
+	// not reference any project.
+	//
+	// Ignored in migrations, as adding a foreign key constraint to an existing
+	// Sqlite table requires recreating the table, which would drop the FTS5
+	// triggers and cascade-delete the entry tags.
+	Project *Project `gorm:"-:migration"`
+	// DeletedAt is when the entry was moved to the trash, or null if the entry
+	// is not trashed.
+	//
+	// GORM automatically excludes trashed entries from all queries on entries,
+	// unless the query is unscoped.
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
 // Elapsed returns the duration of the entry. If the entry is currently active,
@@ -82,16 +111,162 @@ func (t Entry) Elapsed() time.Duration {
 	return end.Sub(t.Start)
 }
 
+// Column names for EntryTag.
+const (
+	EntryTagColumnEntryID = "entry_id"
+	EntryTagColumnName    = "name"
+)
+
+// EntryTag is a tag attached to an entry. The same tag name can only be
+// attached once per entry.
+type EntryTag struct {
+	// EntryID is the ID of the entry this tag is attached to.
+	EntryID uint `gorm:"primaryKey;autoIncrement:false"`
+	// Name of the tag.
+	Name string `gorm:"primaryKey;index"`
+}
+
+// Column names for Project.
+const (
+	ProjectColumnName   = "name"
+	ProjectColumnClient = "client"
+)
+
+// Project is a named collection of entries stored in the database. The
+// combination of name and client is unique.
+type Project struct {
+	CommonFields
+	// Name of the project.
+	Name string `gorm:"not null;default:'';uniqueIndex:idx_projects_client_name"`
+	// Client is the name of the client this project belongs to, or empty if
+	// the project does not belong to any client.
+	Client string `gorm:"not null;default:'';uniqueIndex:idx_projects_client_name"`
+}
+
+// Column names for EntryTemplate.
+const (
+	EntryTemplateColumnName = "name"
+)
+
+// Field names for EntryTemplate.
+const (
+	EntryTemplateFieldTags       = "Tags"
+	EntryTemplateFieldRecurrence = "Recurrence"
+)
+
+// EntryTemplate is a reusable set of default values for new entries, stored
+// in the database. Templates can optionally recur on a schedule.
+type EntryTemplate struct {
+	CommonFields
+	// Name of the template. Unique among all templates.
+	Name string `gorm:"not null;default:'';uniqueIndex"`
+	// EntryName is the name of entries created from the template.
+	EntryName string `gorm:"not null;default:''"`
+	// Duration is the duration of entries created from the template, or zero
+	// if they are created as active entries.
+	Duration time.Duration `gorm:"not null;default:0"`
+	// Tags is the list of tags attached to entries created from the template.
+	Tags []EntryTemplateTag `gorm:"foreignKey:TemplateID;constraint:OnDelete:CASCADE"`
+	// Recurrence is the schedule of the template, or nil if the template
+	// does not recur.
+	Recurrence *EntryRecurrence `gorm:"foreignKey:TemplateID;constraint:OnDelete:CASCADE"`
+}
+
+// Column names for EntryTemplateTag.
+const (
+	EntryTemplateTagColumnTemplateID = "template_id"
+)
+
+// EntryTemplateTag is a tag attached to a template. The same tag name can only
+// be attached once per template.
+type EntryTemplateTag struct {
+	// TemplateID is the ID of the template this tag is attached to.
+	TemplateID uint `gorm:"primaryKey;autoIncrement:false"`
+	// Name of the tag.
+	Name string `gorm:"primaryKey"`
+}
+
+// Column names for EntryRecurrence.
+const (
+	EntryRecurrenceColumnTemplateID        = "template_id"
+	EntryRecurrenceColumnMaterializedUntil = "materialized_until"
+)
+
+// EntryRecurrenceDateLayout is the time layout used for the dates stored in
+// EntryRecurrence.
+const EntryRecurrenceDateLayout = "2006-01-02"
+
+// EntryRecurrence is the schedule of a recurring template, stored in the
+// database.
+type EntryRecurrence struct {
+	CommonFields
+	// TemplateID is the ID of the template that recurs.
+	TemplateID uint `gorm:"not null;uniqueIndex"`
+	// Rule is the recurrence rule, in a subset of the iCalendar RRULE format,
+	// such as "FREQ=WEEKLY;BYDAY=MO,WE".
+	Rule string `gorm:"not null;default:''"`
+	// StartTime is the time of day that recurring entries start at, as a
+	// duration since midnight.
+	StartTime time.Duration `gorm:"not null;default:0"`
+	// Since is the first date the template recurs on, and is the anchor of
+	// the rule's interval. Formatted using EntryRecurrenceDateLayout.
+	Since string `gorm:"not null;default:''"`
+	// Exceptions is a comma-separated list of dates that the template does
+	// not recur on, formatted using EntryRecurrenceDateLayout.
+	Exceptions string `gorm:"not null;default:''"`
+	// MaterializedUntil is when entries were last added from this
+	// recurrence, or nil if no entries have been added yet.
+	MaterializedUntil *time.Time
+}
+
+// Column names for Goal.
+const (
+	GoalColumnPeriod    = "period"
+	GoalColumnProjectID = "project_id"
+)
+
+// Field names for Goal.
+const (
+	GoalFieldProject = "Project"
+)
+
+// Goal periods used in Goal.
+const (
+	GoalPeriodDay  = "day"
+	GoalPeriodWeek = "week"
+)
+
+// Goal is a target duration to track per day or week, stored in the database.
+// The combination of period and project is unique.
+type Goal struct {
+	CommonFields
+	// Period is either "day" or "week".
+	Period string `gorm:"not null;default:''"`
+	// Target is the duration to track per period.
+	Target time.Duration `gorm:"not null;default:0"`
+	// ProjectID is the ID of the project that the goal tracks entries of, or
+	// nil if the goal tracks all entries.
+	ProjectID *uint `gorm:"index"`
+	// Project is the project that the goal tracks entries of, or nil if the
+	// goal tracks all entries. Projects cannot be removed while tracked by
+	// any goal.
+	Project *Project `gorm:"constraint:OnDelete:RESTRICT"`
+}
+
 // Column names for EntryFTS5.
 const (
 	EntryFTS5ColumnRowID = "entries_idx.rowid"
 	EntryFTS5ColumnName  = "entries_idx.name"
+	EntryFTS5ColumnNote  = "entries_idx.note"
+	// EntryFTS5ColumnAll is used to match on all indexed columns.
+	EntryFTS5ColumnAll = "entries_idx"
 )
 
 // EntryFTS5 is used for free-text searching entries.
 type EntryFTS5 struct {
 	RowID uint   `gorm:"primaryKey;column:rowid"`
 	Name  string `gorm:"not null;default:''"`
+	Note  string `gorm:"not null;default:''"`
 }
 
 // TableName overrides the table name used by GORM.
@@ -99,12 +274,59 @@ func (EntryFTS5) TableName() string {
 	return "entries_idx"
 }
 
+// Column names for EntryJournal.
+const (
+	EntryJournalColumnID        = "id"
+	EntryJournalColumnAction    = "action"
+	EntryJournalColumnRevertsID = "reverts_id"
+	EntryJournalColumnChanges   = "changes"
+)
+
+// Journal actions used in EntryJournal.
+const (
+	JournalActionCreate  = "create"
+	JournalActionUpdate  = "update"
+	JournalActionDelete  = "delete"
+	JournalActionStop    = "stop"
+	JournalActionRestore = "restore"
+	JournalActionUndo    = "undo"
+	JournalActionRedo    = "redo"
+)
+
+// EntryJournal is a record in the append-only journal of changes made to
+// entries, used to undo and redo changes. Records are never updated nor
+// deleted.
+type EntryJournal struct {
+	CommonFields
+	// Action is the kind of change, such as "create" or "update". Undoing or
+	// redoing a change is recorded with the "undo" or "redo" actions.
+	Action string `gorm:"not null;default:''"`
+	// RevertsID is the ID of the journal record that was undone or redone, or
+	// nil if this record is not an undo nor redo.
+	RevertsID *uint `gorm:"index"`
+	// Changes is a JSON-encoded list of snapshots of the changed entries,
+	// before and after the change was applied.
+	Changes string `gorm:"not null;default:'[]'"`
+}
+
+// Column names for Status.
+const (
+	StatusColumnLastSeenAlive = "last_seen_alive"
+)
+
 // Status is used to track the user's current status, such as if they're
 // currently AFK.
 type Status struct {
 	CommonFields
 	AFKSince  *time.Time
 	BackSince *time.Time
+	// LastSeenAlive is the most recent heartbeat of the Dinkur daemon, used to
+	// detect when the computer has been rebooted while an entry was active.
+	LastSeenAlive *time.Time
+	// AutoStoppedEntryID is the ID of the entry that was automatically stopped
+	// by the Dinkur daemon after the user had been AFK for too long, or nil if
+	// no entry has been automatically stopped since the user was last back.
+	AutoStoppedEntryID *uint
 }
 
 // Migration holds the latest migration revision identifier. At most one row of
@@ -120,7 +342,7 @@ type MigrationVersion int
 // LatestMigrationVersion is an integer revision identifier for what migration
 // was last applied to the database. This is stored in the database to quickly
 // figure out if new migrations needs to be applied.
-const LatestMigrationVersion MigrationVersion = 8
+const LatestMigrationVersion MigrationVersion = 17
 
 const (
 	// MigrationUnknown means that Dinkur was unable to evaluate the database's
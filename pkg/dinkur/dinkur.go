@@ -24,6 +24,7 @@ package dinkur
 import (
 	"context"
 	"errors"
+	"io"
 	"math"
 	"strconv"
 	"time"
@@ -34,13 +35,41 @@ import (
 
 // Common errors used by multiple Dinkur client and daemon implementations.
 var (
-	ErrAlreadyConnected    = errors.New("client is already connected to database")
-	ErrNotConnected        = errors.New("client is not connected to database")
-	ErrEntryNameEmpty      = errors.New("entry name cannot be empty")
-	ErrEntryEndBeforeStart = errors.New("entry end time cannot be before start time")
-	ErrNotFound            = gorm.ErrRecordNotFound
-	ErrLimitTooLarge       = errors.New("search limit is too large, maximum: " + strconv.Itoa(math.MaxInt))
-	ErrClientIsNil         = errors.New("client is nil")
+	ErrAlreadyConnected     = errors.New("client is already connected to database")
+	ErrNotConnected         = errors.New("client is not connected to database")
+	ErrEntryNameEmpty       = errors.New("entry name cannot be empty")
+	ErrEntryEndBeforeStart  = errors.New("entry end time cannot be before start time")
+	ErrEntryTagEmpty        = errors.New("entry tag cannot be empty")
+	ErrEntryTagInvalid      = errors.New("entry tag cannot contain whitespace or commas, or start with a dash")
+	ErrProjectNameEmpty     = errors.New("project name cannot be empty")
+	ErrProjectNameInvalid   = errors.New("project and client names cannot contain slashes")
+	ErrProjectExists        = errors.New("project with the same name and client already exists")
+	ErrProjectHasGoals      = errors.New("project is tracked by goals, which must be removed first")
+	ErrTemplateNameEmpty    = errors.New("template name cannot be empty")
+	ErrTemplateExists       = errors.New("template with the same name already exists")
+	ErrRecurrenceInvalid    = errors.New("invalid recurrence rule")
+	ErrGoalPeriodInvalid    = errors.New("invalid goal period")
+	ErrGoalTargetInvalid    = errors.New("goal target duration must be positive")
+	ErrGoalExists           = errors.New("goal with the same period and project already exists")
+	ErrNotFound             = gorm.ErrRecordNotFound
+	ErrLimitTooLarge        = errors.New("search limit is too large, maximum: " + strconv.Itoa(math.MaxInt))
+	ErrSummaryGroupInvalid  = errors.New("invalid entry summary grouping")
+	ErrSummaryRangeTooLarge = errors.New("entry summary time range is too large")
+	ErrTimeZoneInvalid      = errors.New("invalid time zone")
+	ErrBackupInvalid        = errors.New("backup is not a valid Dinkur database")
+	ErrBackupTooNew         = errors.New("backup was created by a newer version of Dinkur")
+	ErrUnauthenticated      = errors.New("invalid or missing authentication token")
+	ErrNothingToUndo        = errors.New("no entry changes to undo")
+	ErrNothingToRedo        = errors.New("no undone entry changes to redo")
+	ErrEntryOverlaps        = errors.New("entry overlaps another entry")
+	ErrWorkHoursInvalid     = errors.New("working hours must end after they start, and within the same day")
+	ErrOverlapPolicyInvalid = errors.New("invalid overlap policy")
+	ErrClientIsNil          = errors.New("client is nil")
+	ErrFocusActive          = errors.New("a focus timer is already running")
+	ErrFocusNotActive       = errors.New("no focus timer is running")
+	ErrFocusInvalid         = errors.New("focus work duration and cycles must be positive, and break duration cannot be negative")
+	ErrAwayNotPending       = errors.New("no pending away time to resolve")
+	ErrAwayInvalid          = errors.New("invalid away time resolution")
 )
 
 // Client is a Dinkur client interface. This is the core interface to act upon
@@ -53,7 +82,15 @@ type Client interface {
 	Ping(ctx context.Context) error
 
 	Entries
+	Projects
+	Templates
+	Goals
 	Statuses
+	AwayResolutions
+	Backups
+	Journal
+	Trash
+	Overlaps
 }
 
 // Entries is the Dinkur client methods targeted to reading, creating, and
@@ -61,14 +98,57 @@ type Client interface {
 type Entries interface {
 	GetEntry(ctx context.Context, id uint) (Entry, error)
 	GetEntryList(ctx context.Context, search SearchEntry) ([]Entry, error)
+	GetEntrySummary(ctx context.Context, search SearchEntrySummary) ([]EntrySummary, error)
+	GetEntryGaps(ctx context.Context, search SearchEntryGaps) ([]EntryGap, error)
 	GetActiveEntry(ctx context.Context) (*Entry, error)
 	UpdateEntry(ctx context.Context, edit EditEntry) (UpdatedEntry, error)
 	DeleteEntry(ctx context.Context, id uint) (Entry, error)
+	UpdateEntries(ctx context.Context, edit BulkEditEntry) ([]UpdatedEntry, error)
+	DeleteEntries(ctx context.Context, del BulkDeleteEntry) ([]Entry, error)
 	CreateEntry(ctx context.Context, entry NewEntry) (StartedEntry, error)
+	CreateEntries(ctx context.Context, entries []NewEntry) ([]StartedEntry, error)
 	StopActiveEntry(ctx context.Context, endTime time.Time) (*Entry, error)
 	StreamEntry(ctx context.Context) (<-chan StreamedEntry, error)
 }
 
+// Projects is the Dinkur client methods targeted to reading, creating, and
+// updating projects.
+type Projects interface {
+	GetProject(ctx context.Context, id uint) (Project, error)
+	GetProjectList(ctx context.Context, search SearchProject) ([]Project, error)
+	CreateProject(ctx context.Context, project NewProject) (Project, error)
+	UpdateProject(ctx context.Context, edit EditProject) (UpdatedProject, error)
+	DeleteProject(ctx context.Context, id uint) (Project, error)
+}
+
+// Templates is the Dinkur client methods targeted to reading, creating, and
+// updating entry templates, and to adding entries from recurring templates.
+type Templates interface {
+	GetTemplate(ctx context.Context, id uint) (Template, error)
+	GetTemplateList(ctx context.Context, search SearchTemplate) ([]Template, error)
+	CreateTemplate(ctx context.Context, template NewTemplate) (Template, error)
+	UpdateTemplate(ctx context.Context, edit EditTemplate) (UpdatedTemplate, error)
+	DeleteTemplate(ctx context.Context, id uint) (Template, error)
+	// MaterializeRecurringEntries adds entries from all recurring templates
+	// that are scheduled to start after the previous call and up until the
+	// given time, and returns the added entries. Templates are never
+	// materialized for times before the template's recurrence was created.
+	MaterializeRecurringEntries(ctx context.Context, until time.Time) ([]Entry, error)
+}
+
+// Goals is the Dinkur client methods targeted to reading, creating, and
+// updating goals, and tracking the progress towards them.
+type Goals interface {
+	GetGoal(ctx context.Context, id uint) (Goal, error)
+	GetGoalList(ctx context.Context) ([]Goal, error)
+	CreateGoal(ctx context.Context, goal NewGoal) (Goal, error)
+	UpdateGoal(ctx context.Context, edit EditGoal) (UpdatedGoal, error)
+	DeleteGoal(ctx context.Context, id uint) (Goal, error)
+	// GetGoalProgress returns the progress of all goals, summed up from the
+	// entries within each goal's day or week.
+	GetGoalProgress(ctx context.Context, search SearchGoalProgress) ([]GoalProgress, error)
+}
+
 // Statuses is the Dinkur client methods targeted to setting and reading
 // statuses.
 type Statuses interface {
@@ -77,6 +157,91 @@ type Statuses interface {
 	GetStatus(ctx context.Context) (Status, error)
 }
 
+// AwayResolutions is the Dinkur client methods targeted to resolving the time
+// the user was away while having an active entry, as detected by the Dinkur
+// daemon.
+type AwayResolutions interface {
+	// GetPendingAway returns the away time waiting to be resolved, or nil if
+	// the user is not back from being away or had no active entry.
+	GetPendingAway(ctx context.Context) (*PendingAway, error)
+	// StreamPendingAway streams an EventCreated event when away time starts
+	// waiting to be resolved, and an EventDeleted event when it has been
+	// resolved.
+	StreamPendingAway(ctx context.Context) (<-chan StreamedPendingAway, error)
+	// ResolveAway applies the resolution to the pending away time and clears
+	// the AFK status in a single transaction.
+	ResolveAway(ctx context.Context, resolve ResolveAway) (ResolvedAway, error)
+}
+
+// Backups is the Dinkur client methods targeted to taking and restoring
+// snapshots of the whole data store.
+type Backups interface {
+	// Backup writes a snapshot of the database to w. The snapshot is an
+	// Sqlite3 database file, and can be taken while other clients are
+	// connected.
+	Backup(ctx context.Context, w io.Writer) error
+	// Restore replaces all data in the database with the snapshot read from r.
+	// Snapshots from older versions of Dinkur are migrated before they are
+	// restored.
+	Restore(ctx context.Context, r io.Reader) error
+}
+
+// Journal is the Dinkur client methods targeted to undoing and redoing
+// changes to entries.
+type Journal interface {
+	// Undo reverts the latest change to entries that has not already been
+	// undone.
+	Undo(ctx context.Context) (JournalResult, error)
+	// Redo reapplies the latest undone change to entries, as long as no other
+	// changes have been made since it was undone.
+	Redo(ctx context.Context) (JournalResult, error)
+}
+
+// Trash is the Dinkur client methods targeted to removed entries. Removed
+// entries are kept in the trash until they are purged.
+type Trash interface {
+	// GetTrashedEntryList returns all entries in the trash, sorted by when
+	// they were removed.
+	GetTrashedEntryList(ctx context.Context) ([]Entry, error)
+	// RestoreEntry moves an entry out of the trash.
+	RestoreEntry(ctx context.Context, id uint) (Entry, error)
+	// PurgeTrashedEntries permanently deletes the entries in the trash that
+	// were removed before the given time, and returns the deleted entries.
+	PurgeTrashedEntries(ctx context.Context, deletedBefore time.Time) ([]Entry, error)
+}
+
+// Overlaps is the Dinkur client methods targeted to finding and resolving
+// entries whose time spans overlap.
+type Overlaps interface {
+	// GetEntryOverlaps returns each pair of overlapping entries among the
+	// entries matching the search, sorted by start time.
+	GetEntryOverlaps(ctx context.Context, search SearchEntry) ([]EntryOverlap, error)
+	// ResolveEntryOverlaps changes the entries matching the search so they no
+	// longer overlap, and returns the changes that were applied. The entry
+	// that started last is kept as-is, while the entries it overlaps are
+	// trimmed, split, or removed.
+	ResolveEntryOverlaps(ctx context.Context, resolve ResolveEntryOverlaps) ([]EntryChange, error)
+}
+
+// Focuser is the Dinkur client methods targeted to running a focus timer,
+// where work entries are started and stopped in fixed intervals with breaks
+// in between. The timer needs a long-running process to stop and start the
+// entries, so this interface is only implemented by the Dinkur daemon and its
+// gRPC client, and is not part of the Client interface.
+type Focuser interface {
+	// StartFocus starts a new focus timer, and starts its first work entry.
+	StartFocus(ctx context.Context, focus NewFocus) (Focus, error)
+	// StopFocus stops the running focus timer, as well as its active work or
+	// break entry, and returns the timer's state from before it was stopped.
+	StopFocus(ctx context.Context) (Focus, error)
+	// GetFocus returns the state of the focus timer. The phase is
+	// FocusPhaseIdle if no focus timer is running.
+	GetFocus(ctx context.Context) (Focus, error)
+	// StreamFocus streams the state of the focus timer every time it changes
+	// phase, is started, or is stopped.
+	StreamFocus(ctx context.Context) (<-chan StreamedFocus, error)
+}
+
 // SearchEntry holds parameters used when searching for list of entries.
 type SearchEntry struct {
 	Start *time.Time
@@ -87,6 +252,59 @@ type SearchEntry struct {
 	NameFuzzy          string
 	NameHighlightStart string
 	NameHighlightEnd   string
+
+	// Tags filters the results to only include entries that has all of these
+	// tags.
+	Tags []string
+	// ExcludeTags filters the results to only include entries that has none
+	// of these tags.
+	ExcludeTags []string
+	// ProjectIDOrZero filters the results to only include entries that
+	// references the project with this ID.
+	ProjectIDOrZero uint
+}
+
+// SearchEntrySummary holds parameters used when summarizing the durations of
+// entries.
+type SearchEntrySummary struct {
+	Start *time.Time
+	End   *time.Time
+
+	Shorthand timeutil.TimeSpanShorthand
+
+	// GroupBy decides how the entries are bucketed.
+	GroupBy EntrySummaryGroup
+	// TimeZone is the IANA time zone name, such as "Europe/Stockholm", used
+	// when deciding the day, week, and month boundaries. If left empty, then
+	// the local time zone of the Dinkur client or daemon is used.
+	TimeZone string
+}
+
+// SearchEntryGaps holds parameters used when searching for gaps of
+// unaccounted time between entries.
+type SearchEntryGaps struct {
+	Start *time.Time
+	End   *time.Time
+
+	Shorthand timeutil.TimeSpanShorthand
+
+	// WorkHoursStart is the time of day, as a duration since midnight, when
+	// working hours start. Gaps are trimmed to start no earlier than this.
+	WorkHoursStart time.Duration
+	// WorkHoursEnd is the time of day, as a duration since midnight, when
+	// working hours end. Gaps are trimmed to end no later than this. If set to
+	// zero, then working hours end at midnight.
+	WorkHoursEnd time.Duration
+	// WorkDays are the days of the week that have working hours. Gaps on all
+	// other days are left out. If left empty, then all days have working
+	// hours.
+	WorkDays []time.Weekday
+	// MinDuration leaves out gaps that are shorter than this.
+	MinDuration time.Duration
+	// TimeZone is the IANA time zone name, such as "Europe/Stockholm", used
+	// when deciding the working hours of each day. If left empty, then the
+	// local time zone of the Dinkur client or daemon is used.
+	TimeZone string
 }
 
 // EditEntry holds parameters used when editing a entry.
@@ -120,7 +338,29 @@ type EditEntry struct {
 	EndFuzzy string
 	// AppendName changes the name field to append the name to the entry's
 	// existing name (delimited with a space) instead of replacing it.
-	AppendName         bool
+	AppendName bool
+	// Note is the new entry note. Set to a pointer to an empty string to
+	// remove the entry's note. If AppendNote is enabled, then this value will
+	// append to the existing note, delimited with a newline.
+	//
+	// No change to the entry note is applied if this is set to nil.
+	Note *string
+	// AppendNote changes the note field to append the note to the entry's
+	// existing note (delimited with a newline) instead of replacing it.
+	AppendNote bool
+	// Tags are added to the entry's existing tags. Tags that the entry already
+	// has are ignored.
+	Tags []string
+	// RemoveTags are removed from the entry's existing tags. Tags that the
+	// entry does not have are ignored.
+	RemoveTags []string
+	// ProjectIDOrZero is the ID of the new project the entry references.
+	//
+	// No change to the entry's project is applied if this is set to zero.
+	ProjectIDOrZero uint
+	// RemoveProject changes the entry to no longer reference any project.
+	// ProjectIDOrZero is ignored if this is set.
+	RemoveProject      bool
 	StartAfterIDOrZero uint
 	EndBeforeIDOrZero  uint
 	StartAfterLast     bool
@@ -133,9 +373,88 @@ type UpdatedEntry struct {
 	After  Entry
 }
 
+// BulkEditEntry holds parameters used when editing all entries matching a
+// search query.
+type BulkEditEntry struct {
+	// Search decides which entries to edit. The name highlighting fields are
+	// ignored.
+	Search SearchEntry
+	// Name is the new name of all the matched entries.
+	//
+	// No change to the entry names is applied if this is set to nil.
+	Name *string
+	// ReplaceNameOld is replaced with ReplaceNameNew in the names of all the
+	// matched entries. This is applied after the Name field.
+	//
+	// No replacement is applied if this is set to empty.
+	ReplaceNameOld string
+	// ReplaceNameNew is the replacement of ReplaceNameOld.
+	ReplaceNameNew string
+	// Shift is added to both the start and end timestamps of all the matched
+	// entries. A negative value shifts the entries back in time. Any other
+	// entries that the shifted entries then overlap are changed according to
+	// the client's overlap policy.
+	Shift time.Duration
+	// DryRun makes the edits without saving them, so the results can be
+	// previewed.
+	DryRun bool
+}
+
+// BulkDeleteEntry holds parameters used when removing all entries matching a
+// search query.
+type BulkDeleteEntry struct {
+	// Search decides which entries to remove. The name highlighting fields
+	// are ignored.
+	Search SearchEntry
+	// DryRun finds the entries without removing them, so the results can be
+	// previewed.
+	DryRun bool
+}
+
+// JournalResult is the response from undoing or redoing a change to entries.
+type JournalResult struct {
+	// Action is the kind of change that was undone or redone.
+	Action JournalAction
+	// Changes are the changes that were applied to the entries, in order.
+	Changes []EntryChange
+}
+
+// EntryChange is a change applied to a single entry, with values for before
+// and after the change was applied. Before is nil if the entry was created,
+// and After is nil if the entry was removed.
+type EntryChange struct {
+	Before *Entry
+	After  *Entry
+}
+
+// EntryOverlap is a pair of entries whose time spans overlap. First starts
+// before or at the same time as Second.
+type EntryOverlap struct {
+	First  Entry
+	Second Entry
+}
+
+// ResolveEntryOverlaps holds parameters used when resolving overlapping
+// entries.
+type ResolveEntryOverlaps struct {
+	// Search decides which entries to resolve overlaps between. The name
+	// highlighting fields are ignored.
+	Search SearchEntry
+	// Policy decides how overlaps are resolved. Must be either
+	// OverlapPolicyTrim or OverlapPolicySplit.
+	Policy OverlapPolicy
+}
+
 // NewEntry holds parameters used when creating a new entry.
 type NewEntry struct {
-	Name               string
+	Name            string
+	Note            string
+	Tags            []string
+	ProjectIDOrZero uint
+	// Project references a project by name and client instead of by ID, and
+	// creates the project if it does not already exist. Ignored if
+	// ProjectIDOrZero is set.
+	Project            *NewProject
 	Start              *time.Time
 	End                *time.Time
 	StartAfterIDOrZero uint
@@ -151,6 +470,150 @@ type StartedEntry struct {
 	Stopped *Entry
 }
 
+// SearchProject holds parameters used when searching for list of projects.
+type SearchProject struct {
+	// Name filters the results to only include projects with this exact name.
+	Name string
+	// Client filters the results to only include projects belonging to the
+	// client with this exact name.
+	Client string
+}
+
+// NewProject holds parameters used when creating a new project.
+type NewProject struct {
+	Name   string
+	Client string
+}
+
+// EditProject holds parameters used when editing a project.
+type EditProject struct {
+	// ID of the project to edit.
+	ID uint
+	// Name is the new project name.
+	//
+	// No change to the project name is applied if this is set to nil.
+	Name *string
+	// Client is the new client name of the project. Set to a pointer to an
+	// empty string to make the project no longer belong to any client.
+	//
+	// No change to the project client is applied if this is set to nil.
+	Client *string
+}
+
+// UpdatedProject is the response from an edited project, with values for
+// before the edits were applied and after they were applied.
+type UpdatedProject struct {
+	Before Project
+	After  Project
+}
+
+// SearchTemplate holds parameters used when searching for list of templates.
+type SearchTemplate struct {
+	// Name filters the results to only include templates with this exact
+	// name.
+	Name string
+}
+
+// NewTemplate holds parameters used when creating a new template.
+type NewTemplate struct {
+	Name string
+	// EntryName is the name of entries created from the template. If left
+	// empty, then the template name is used.
+	EntryName string
+	// Duration is the duration of entries created from the template. Entries
+	// are created as active entries if this is zero.
+	Duration time.Duration
+	Tags     []string
+	// Recurrence is the schedule of the template, or nil if the template does
+	// not recur.
+	Recurrence *Recurrence
+}
+
+// EditTemplate holds parameters used when editing a template.
+type EditTemplate struct {
+	// ID of the template to edit.
+	ID uint
+	// Name is the new template name.
+	//
+	// No change to the template name is applied if this is set to nil.
+	Name *string
+	// EntryName is the new name of entries created from the template.
+	//
+	// No change to the entry name is applied if this is set to nil.
+	EntryName *string
+	// Duration is the new duration of entries created from the template.
+	//
+	// No change to the duration is applied if this is set to nil.
+	Duration *time.Duration
+	// Tags is a list of tags to add to the template.
+	Tags []string
+	// RemoveTags is a list of tags to remove from the template. Tags in both
+	// Tags and RemoveTags are removed.
+	RemoveTags []string
+	// Recurrence is the new schedule of the template.
+	//
+	// No change to the schedule is applied if this is set to nil.
+	Recurrence *Recurrence
+	// RemoveRecurrence makes the template no longer recur. Recurrence is
+	// ignored if this is set.
+	RemoveRecurrence bool
+}
+
+// UpdatedTemplate is the response from an edited template, with values for
+// before the edits were applied and after they were applied.
+type UpdatedTemplate struct {
+	Before Template
+	After  Template
+}
+
+// NewGoal holds parameters used when creating a new goal.
+type NewGoal struct {
+	Period GoalPeriod
+	Target time.Duration
+	// ProjectIDOrZero is the ID of the project that the goal tracks entries
+	// of. All entries are tracked if this is set to zero.
+	ProjectIDOrZero uint
+}
+
+// EditGoal holds parameters used when editing a goal.
+type EditGoal struct {
+	// ID of the goal to edit.
+	ID uint
+	// Period is the new period of the goal.
+	//
+	// No change to the goal period is applied if this is set to nil.
+	Period *GoalPeriod
+	// Target is the new target duration of the goal.
+	//
+	// No change to the goal target is applied if this is set to nil.
+	Target *time.Duration
+	// ProjectIDOrZero is the ID of the new project the goal tracks.
+	//
+	// No change to the goal's project is applied if this is set to zero.
+	ProjectIDOrZero uint
+	// RemoveProject changes the goal to track all entries, instead of only
+	// the entries of a project. ProjectIDOrZero is ignored if this is set.
+	RemoveProject bool
+}
+
+// UpdatedGoal is the response from an edited goal, with values for before the
+// edits were applied and after they were applied.
+type UpdatedGoal struct {
+	Before Goal
+	After  Goal
+}
+
+// SearchGoalProgress holds parameters used when getting the progress of goals.
+type SearchGoalProgress struct {
+	// At is a time within the day and week to get the progress of. If left
+	// as nil, then the current time is used.
+	At *time.Time
+	// TimeZone is the IANA time zone name, such as "Europe/Stockholm", used
+	// when deciding the day and week boundaries. If left empty, then the
+	// local time zone of the Dinkur client or daemon is used.
+	TimeZone string
+}
+
 // StreamedEntry holds a entry and its event type.
 type StreamedEntry struct {
 	Entry Entry
@@ -162,6 +625,64 @@ type StreamedStatus struct {
 	Status Status
 }
 
+// StreamedPendingAway is an event of away time that started waiting to be
+// resolved, or that has been resolved.
+type StreamedPendingAway struct {
+	// PendingAway is the away time waiting to be resolved. Only set for
+	// EventCreated events.
+	PendingAway *PendingAway
+	Event       EventType
+}
+
+// ResolveAway holds parameters used when resolving pending away time.
+type ResolveAway struct {
+	Action AwayAction
+	// Parts are the new entries to save the away time as, starting from the
+	// time the user went away. Must hold exactly one part when using
+	// AwayActionSaveAsNew, and at least one part when using AwayActionSplit.
+	Parts []AwayPart
+}
+
+// AwayPart is a new entry to save a part of the away time as. Each part
+// starts when the previous part ends.
+type AwayPart struct {
+	Name            string
+	Tags            []string
+	ProjectIDOrZero uint
+	// End is when the part ends. Must be set for all parts except the last,
+	// which is left active if End is nil. Not used by AwayActionSaveAsNew,
+	// which always leaves its new entry active.
+	End *time.Time
+}
+
+// ResolvedAway holds the entries that were changed when resolving pending
+// away time.
+type ResolvedAway struct {
+	// Stopped is the previously active entry, if it was stopped.
+	Stopped *Entry
+	// Created are the new entries of the away time, if any.
+	Created []Entry
+}
+
+// NewFocus holds parameters used when starting a new focus timer.
+type NewFocus struct {
+	Name            string
+	Tags            []string
+	ProjectIDOrZero uint
+	Work            time.Duration
+	Break           time.Duration
+	Cycles          uint
+	// LogBreaks makes the focus timer create an entry for each break, named
+	// BreakName, instead of leaving no entry active during the breaks.
+	LogBreaks bool
+	BreakName string
+}
+
+// StreamedFocus is an event holding an updated focus timer state.
+type StreamedFocus struct {
+	Focus Focus
+}
+
 // EditStatus holds values used when updating the current status.
 type EditStatus struct {
 	AFKSince  *time.Time // set if currently AFK
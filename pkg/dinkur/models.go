@@ -19,7 +19,11 @@
 
 package dinkur
 
-import "time"
+import (
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/timeutil"
+)
 
 // TimeFields contains time metadata fields used by multiple other models.
 type TimeFields struct {
@@ -42,10 +46,21 @@ type Entry struct {
 	CommonFields `yaml:",inline"`
 	// Name of the entry.
 	Name string `json:"name" yaml:"name" xml:"Name"`
+	// Note is a longer free-text description of the entry, such as a link to
+	// a ticket or what was done, or empty if the entry has no note.
+	Note string `json:"note,omitempty" yaml:"note,omitempty" xml:"Note,omitempty"`
 	// Start time of the entry.
 	Start time.Time `json:"start" yaml:"start" xml:"Start"`
 	// End time of the entry, or nil if the entry is still active.
 	End *time.Time `json:"end" yaml:"end" xml:"End"`
+	// Tags is the sorted list of tags attached to this entry.
+	Tags []string `json:"tags" yaml:"tags" xml:"Tags>Tag"`
+	// Project is the project this entry references, or nil if the entry does
+	// not reference any project.
+	Project *Project `json:"project" yaml:"project" xml:"Project"`
+	// DeletedAt is when the entry was moved to the trash, or nil if the entry
+	// is not trashed.
+	DeletedAt *time.Time `json:"deletedAt,omitempty" yaml:"deletedAt,omitempty" xml:"DeletedAt,omitempty"`
 }
 
 // Elapsed returns the duration of the entry. If the entry is currently active,
@@ -60,6 +75,219 @@ func (t Entry) Elapsed() time.Duration {
 	return end.Sub(t.Start)
 }
 
+// TagsEqual reports whether both lists contain the same tags in the same
+// order. Tags are always stored sorted, so the tags of two entries or
+// templates can be compared using this.
+func TagsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Project is a named collection of entries, optionally belonging to a client.
+type Project struct {
+	CommonFields `yaml:",inline"`
+	// Name of the project.
+	Name string `json:"name" yaml:"name" xml:"Name"`
+	// Client is the name of the client this project belongs to, or empty if
+	// the project does not belong to any client.
+	Client string `json:"client" yaml:"client" xml:"Client"`
+}
+
+// String returns the project name, prefixed with the client name and a slash
+// if the project belongs to a client.
+func (p Project) String() string {
+	if p.Client == "" {
+		return p.Name
+	}
+	return p.Client + "/" + p.Name
+}
+
+// Template is a reusable set of default values for new entries. Templates can
+// optionally recur on a schedule, where entries are added automatically.
+type Template struct {
+	CommonFields `yaml:",inline"`
+	// Name of the template.
+	Name string `json:"name" yaml:"name" xml:"Name"`
+	// EntryName is the name of entries created from the template.
+	EntryName string `json:"entryName" yaml:"entryName" xml:"EntryName"`
+	// Duration is the duration of entries created from the template, or zero
+	// if entries are created as active entries.
+	Duration time.Duration `json:"duration" yaml:"duration" xml:"Duration"`
+	// Tags is the list of tags attached to entries created from the template.
+	Tags []string `json:"tags" yaml:"tags" xml:"Tags>Tag"`
+	// Recurrence is the schedule of the template, or nil if the template does
+	// not recur.
+	Recurrence *Recurrence `json:"recurrence,omitempty" yaml:"recurrence,omitempty" xml:"Recurrence,omitempty"`
+}
+
+// Recurrence is the schedule of a recurring template.
+type Recurrence struct {
+	// Rule is the recurrence rule, in a subset of the iCalendar RRULE format,
+	// such as "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR".
+	Rule string `json:"rule" yaml:"rule" xml:"Rule"`
+	// StartTime is the time of day that recurring entries start at, as a
+	// duration since midnight.
+	StartTime time.Duration `json:"startTime" yaml:"startTime" xml:"StartTime"`
+	// Since is the first date the template recurs on, as midnight in the
+	// local time zone. If left as the zero value when creating or editing a
+	// template, then today's date is used.
+	Since time.Time `json:"since" yaml:"since" xml:"Since"`
+	// Exceptions is a list of dates that the template does not recur on, as
+	// midnight in the local time zone.
+	Exceptions []time.Time `json:"exceptions,omitempty" yaml:"exceptions,omitempty" xml:"Exceptions>Exception,omitempty"`
+}
+
+// GoalPeriod is an enumeration of the time spans that goals are tracked over.
+type GoalPeriod byte
+
+const (
+	// GoalPeriodDay tracks the goal per day, from midnight to midnight.
+	GoalPeriodDay GoalPeriod = iota
+	// GoalPeriodWeek tracks the goal per week, from Monday to Sunday.
+	GoalPeriodWeek
+)
+
+func (p GoalPeriod) String() string {
+	switch p {
+	case GoalPeriodDay:
+		return "day"
+	case GoalPeriodWeek:
+		return "week"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, and is used when encoding
+// goals as JSON, YAML, or XML.
+func (p GoalPeriod) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// Span returns the day or week that contains the given time.
+func (p GoalPeriod) Span(now time.Time) timeutil.TimeSpan {
+	if p == GoalPeriodWeek {
+		return timeutil.Week(now)
+	}
+	return timeutil.Day(now)
+}
+
+// Goal is a target duration to track per day or week, either for all entries
+// or only for the entries of a project.
+type Goal struct {
+	CommonFields `yaml:",inline"`
+	// Period is whether the goal is tracked per day or per week.
+	Period GoalPeriod `json:"period" yaml:"period" xml:"Period"`
+	// Target is the duration to track per period.
+	Target time.Duration `json:"target" yaml:"target" xml:"Target"`
+	// Project is the project that the goal tracks entries of, or nil if the
+	// goal tracks all entries.
+	Project *Project `json:"project" yaml:"project" xml:"Project"`
+}
+
+// GoalProgress is the tracked duration towards a goal within a single day or
+// week.
+type GoalProgress struct {
+	// Goal is the goal that the progress is tracked towards.
+	Goal Goal `json:"goal" yaml:"goal" xml:"Goal"`
+	// Start of the goal's day or week.
+	Start time.Time `json:"start" yaml:"start" xml:"Start"`
+	// End of the goal's day or week.
+	End time.Time `json:"end" yaml:"end" xml:"End"`
+	// Tracked is the sum of the durations of the goal's entries within the
+	// day or week, where active entries are counted up until now.
+	Tracked time.Duration `json:"tracked" yaml:"tracked" xml:"Tracked"`
+}
+
+// Ratio returns the tracked duration divided by the goal's target duration,
+// where 1 means the goal is reached.
+func (p GoalProgress) Ratio() float64 {
+	if p.Goal.Target <= 0 {
+		return 0
+	}
+	return float64(p.Tracked) / float64(p.Goal.Target)
+}
+
+// EntrySummaryGroup is an enumeration of how entries are bucketed when
+// summarizing their durations.
+type EntrySummaryGroup byte
+
+const (
+	// EntrySummaryGroupDay buckets entries by the day they started.
+	EntrySummaryGroupDay EntrySummaryGroup = iota
+	// EntrySummaryGroupWeek buckets entries by the ISO week they started,
+	// where weeks start on Mondays.
+	EntrySummaryGroupWeek
+	// EntrySummaryGroupMonth buckets entries by the month they started.
+	EntrySummaryGroupMonth
+	// EntrySummaryGroupName buckets entries by their name.
+	EntrySummaryGroupName
+)
+
+func (g EntrySummaryGroup) String() string {
+	switch g {
+	case EntrySummaryGroupDay:
+		return "day"
+	case EntrySummaryGroupWeek:
+		return "week"
+	case EntrySummaryGroupMonth:
+		return "month"
+	case EntrySummaryGroupName:
+		return "name"
+	default:
+		return "unknown"
+	}
+}
+
+// EntrySummary is the summed up duration of a bucket of entries.
+type EntrySummary struct {
+	// Key identifies the bucket, such as "2021-12-24" when grouped by day,
+	// "2021-W51" when grouped by week, "2021-12" when grouped by month, or the
+	// entry name when grouped by name.
+	Key string `json:"key" yaml:"key" xml:"Key"`
+	// Start of the bucket's time span, or nil when grouped by name.
+	Start *time.Time `json:"start" yaml:"start" xml:"Start"`
+	// End of the bucket's time span, or nil when grouped by name.
+	End *time.Time `json:"end" yaml:"end" xml:"End"`
+	// Entries is the number of entries in the bucket. An entry that crosses
+	// a day, week, or month boundary is counted in each bucket it overlaps.
+	Entries uint `json:"entries" yaml:"entries" xml:"Entries"`
+	// Duration is the sum of all the entries' durations in the bucket, where
+	// active entries are counted up until now. Only the part of an entry that
+	// is within the bucket's time span is counted.
+	Duration time.Duration `json:"duration" yaml:"duration" xml:"Duration"`
+}
+
+// EntryGap is a span of unaccounted time between two entries, within working
+// hours. A gap between two entries may be split into multiple gaps when it
+// spans outside working hours, such as over night.
+type EntryGap struct {
+	// Start of the gap. This is the same as the end of the previous entry,
+	// unless the gap was trimmed to the start of working hours.
+	Start time.Time `json:"start" yaml:"start" xml:"Start"`
+	// End of the gap. This is the same as the start of the next entry, unless
+	// the gap was trimmed to the end of working hours.
+	End time.Time `json:"end" yaml:"end" xml:"End"`
+	// Previous is the last entry that ended before the gap, or nil if the gap
+	// starts at the start of the searched time span.
+	Previous *Entry `json:"previous" yaml:"previous" xml:"Previous"`
+	// Next is the first entry that started after the gap, or nil if the gap
+	// ends at the end of the searched time span.
+	Next *Entry `json:"next" yaml:"next" xml:"Next"`
+}
+
+// Duration returns the duration of the gap.
+func (g EntryGap) Duration() time.Duration {
+	return g.End.Sub(g.Start)
+}
+
 // EventType is the type of a streamed event.
 type EventType byte
 
@@ -73,6 +301,9 @@ const (
 	EventUpdated
 	// EventDeleted means the subject was just deleted.
 	EventDeleted
+	// EventRestored means the subject was just restored after previously
+	// having been deleted.
+	EventRestored
 )
 
 func (ev EventType) String() string {
@@ -83,6 +314,81 @@ func (ev EventType) String() string {
 		return "updated"
 	case EventDeleted:
 		return "deleted"
+	case EventRestored:
+		return "restored"
+	default:
+		return "unknown"
+	}
+}
+
+// JournalAction is the kind of change to entries recorded in the journal used
+// when undoing and redoing changes.
+type JournalAction byte
+
+const (
+	// JournalActionUnknown means the remote Dinkur daemon or client sent an
+	// undefined journal action.
+	JournalActionUnknown JournalAction = iota
+	// JournalActionCreate means one or more entries were created, and may
+	// also have stopped the previously active entry.
+	JournalActionCreate
+	// JournalActionUpdate means an entry was edited.
+	JournalActionUpdate
+	// JournalActionDelete means an entry was removed.
+	JournalActionDelete
+	// JournalActionStop means the active entry was stopped.
+	JournalActionStop
+	// JournalActionRestore means an entry was restored from the trash.
+	JournalActionRestore
+)
+
+func (a JournalAction) String() string {
+	switch a {
+	case JournalActionCreate:
+		return "create"
+	case JournalActionUpdate:
+		return "update"
+	case JournalActionDelete:
+		return "delete"
+	case JournalActionStop:
+		return "stop"
+	case JournalActionRestore:
+		return "restore"
+	default:
+		return "unknown"
+	}
+}
+
+// OverlapPolicy is an enumeration of how to handle an entry that is created or
+// edited so that it overlaps other entries.
+type OverlapPolicy byte
+
+const (
+	// OverlapPolicyAllow leaves overlapping entries as-is.
+	OverlapPolicyAllow OverlapPolicy = iota
+	// OverlapPolicyReject fails the change with ErrEntryOverlaps.
+	OverlapPolicyReject
+	// OverlapPolicyTrim shortens the overlapped entries so they end when the
+	// changed entry starts, or start when it ends. Overlapped entries that
+	// are entirely covered by the changed entry are removed.
+	OverlapPolicyTrim
+	// OverlapPolicySplit is the same as OverlapPolicyTrim, except that an
+	// overlapped entry that covers the entire changed entry is split in two
+	// around it, instead of being trimmed to end when the changed entry
+	// starts.
+	OverlapPolicySplit
+)
+
+func (p OverlapPolicy) String() string {
+	switch p {
+	case OverlapPolicyAllow:
+		return "allow"
+	case OverlapPolicyReject:
+		return "reject"
+	case OverlapPolicyTrim:
+		return "trim"
+	case OverlapPolicySplit:
+		return "split"
 	default:
 		return "unknown"
 	}
@@ -91,6 +397,111 @@ func (ev EventType) String() string {
 // Status holds data about the user's status, such as if they're currently AFK.
 type Status struct {
 	TimeFields
-	AFKSince  *time.Time // set if currently AFK
-	BackSince *time.Time // set if returned from being AFK
+	AFKSince      *time.Time // set if currently AFK
+	BackSince     *time.Time // set if returned from being AFK
+	LastSeenAlive *time.Time // set by the daemon while it is running
+	// AutoStoppedEntryIDOrZero is set if the daemon stopped the active entry
+	// after the user had been AFK for too long.
+	AutoStoppedEntryIDOrZero uint
+}
+
+// PendingAway is the time the user was away while having an active entry,
+// which is waiting for the user to decide what to do with it.
+type PendingAway struct {
+	// AFKSince is when the user went away.
+	AFKSince time.Time
+	// BackSince is when the user returned.
+	BackSince time.Time
+	// ActiveEntry is the entry that was active while the user was away. This
+	// may also be an entry that the daemon stopped automatically after the
+	// user had been AFK for too long, in which case its End is set.
+	ActiveEntry Entry
+}
+
+// AwayAction is an enumeration of ways to resolve pending away time.
+type AwayAction byte
+
+const (
+	// AwayActionKeep leaves the active entry as-is, including the away time
+	// in the active entry. An automatically stopped entry is made active again.
+	AwayActionKeep AwayAction = iota
+	// AwayActionDiscard discards the away time by stopping the active entry
+	// at the time the user went away.
+	AwayActionDiscard
+	// AwayActionSaveAsNew stops the active entry at the time the user went
+	// away, and saves the away time as a new active entry.
+	AwayActionSaveAsNew
+	// AwayActionSplit stops the active entry at the time the user went away,
+	// and splits the away time into multiple consecutive new entries.
+	AwayActionSplit
+)
+
+func (a AwayAction) String() string {
+	switch a {
+	case AwayActionKeep:
+		return "keep"
+	case AwayActionDiscard:
+		return "discard"
+	case AwayActionSaveAsNew:
+		return "save as new"
+	case AwayActionSplit:
+		return "split"
+	default:
+		return "unknown"
+	}
+}
+
+// FocusPhase is an enumeration of the phases of a focus timer.
+type FocusPhase byte
+
+const (
+	// FocusPhaseIdle means no focus timer is running.
+	FocusPhaseIdle FocusPhase = iota
+	// FocusPhaseWork means the focus timer is in a work interval, during
+	// which a work entry is active.
+	FocusPhaseWork
+	// FocusPhaseBreak means the focus timer is in a break between two work
+	// intervals.
+	FocusPhaseBreak
+)
+
+func (p FocusPhase) String() string {
+	switch p {
+	case FocusPhaseIdle:
+		return "idle"
+	case FocusPhaseWork:
+		return "work"
+	case FocusPhaseBreak:
+		return "break"
+	default:
+		return "unknown"
+	}
+}
+
+// Focus holds the state of a focus timer, where work entries are started and
+// stopped in fixed intervals with breaks in between, also known as the
+// Pomodoro technique.
+type Focus struct {
+	Phase         FocusPhase
+	Name          string        // name of the work entries
+	Cycle         uint          // current work interval, starting at 1
+	Cycles        uint          // total number of work intervals
+	Work          time.Duration // duration of each work interval
+	Break         time.Duration // duration of each break
+	PhaseStart    time.Time     // when the current phase started
+	PhaseEnd      time.Time     // when the current phase ends
+	EntryIDOrZero uint          // ID of the current work or break entry, or 0
+}
+
+// Remaining returns the time left until the current phase ends, or zero if no
+// focus timer is running.
+func (f Focus) Remaining() time.Duration {
+	if f.Phase == FocusPhaseIdle {
+		return 0
+	}
+	remaining := time.Until(f.PhaseEnd)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
 }
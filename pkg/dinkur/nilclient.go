@@ -21,6 +21,7 @@ package dinkur
 
 import (
 	"context"
+	"io"
 	"time"
 )
 
@@ -58,6 +59,18 @@ func (*NilClient) GetEntryList(context.Context, SearchEntry) ([]Entry, error) {
 	return nil, ErrClientIsNil
 }
 
+// GetEntrySummary is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) GetEntrySummary(context.Context, SearchEntrySummary) ([]EntrySummary, error) {
+	return nil, ErrClientIsNil
+}
+
+// GetEntryGaps is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) GetEntryGaps(context.Context, SearchEntryGaps) ([]EntryGap, error) {
+	return nil, ErrClientIsNil
+}
+
 // UpdateEntry is a dummy implementation of the dinkur.Client that only returns
 // the "client is nil" error.
 func (*NilClient) UpdateEntry(context.Context, EditEntry) (UpdatedEntry, error) {
@@ -70,12 +83,30 @@ func (*NilClient) DeleteEntry(context.Context, uint) (Entry, error) {
 	return Entry{}, ErrClientIsNil
 }
 
+// UpdateEntries is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) UpdateEntries(context.Context, BulkEditEntry) ([]UpdatedEntry, error) {
+	return nil, ErrClientIsNil
+}
+
+// DeleteEntries is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) DeleteEntries(context.Context, BulkDeleteEntry) ([]Entry, error) {
+	return nil, ErrClientIsNil
+}
+
 // CreateEntry is a dummy implementation of the dinkur.Client that only returns
 // the "client is nil" error.
 func (*NilClient) CreateEntry(context.Context, NewEntry) (StartedEntry, error) {
 	return StartedEntry{}, ErrClientIsNil
 }
 
+// CreateEntries is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) CreateEntries(context.Context, []NewEntry) ([]StartedEntry, error) {
+	return nil, ErrClientIsNil
+}
+
 // GetActiveEntry is a dummy implementation of the dinkur.Client that only returns
 // the "client is nil" error.
 func (*NilClient) GetActiveEntry(context.Context) (*Entry, error) {
@@ -94,6 +125,108 @@ func (*NilClient) StreamEntry(context.Context) (<-chan StreamedEntry, error) {
 	return nil, ErrClientIsNil
 }
 
+// GetProject is a dummy implementation of the dinkur.Client that only returns
+// the "client is nil" error.
+func (*NilClient) GetProject(context.Context, uint) (Project, error) {
+	return Project{}, ErrClientIsNil
+}
+
+// GetProjectList is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) GetProjectList(context.Context, SearchProject) ([]Project, error) {
+	return nil, ErrClientIsNil
+}
+
+// CreateProject is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) CreateProject(context.Context, NewProject) (Project, error) {
+	return Project{}, ErrClientIsNil
+}
+
+// UpdateProject is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) UpdateProject(context.Context, EditProject) (UpdatedProject, error) {
+	return UpdatedProject{}, ErrClientIsNil
+}
+
+// DeleteProject is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) DeleteProject(context.Context, uint) (Project, error) {
+	return Project{}, ErrClientIsNil
+}
+
+// GetTemplate is a dummy implementation of the dinkur.Client that only returns
+// the "client is nil" error.
+func (*NilClient) GetTemplate(context.Context, uint) (Template, error) {
+	return Template{}, ErrClientIsNil
+}
+
+// GetTemplateList is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) GetTemplateList(context.Context, SearchTemplate) ([]Template, error) {
+	return nil, ErrClientIsNil
+}
+
+// CreateTemplate is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) CreateTemplate(context.Context, NewTemplate) (Template, error) {
+	return Template{}, ErrClientIsNil
+}
+
+// UpdateTemplate is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) UpdateTemplate(context.Context, EditTemplate) (UpdatedTemplate, error) {
+	return UpdatedTemplate{}, ErrClientIsNil
+}
+
+// DeleteTemplate is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) DeleteTemplate(context.Context, uint) (Template, error) {
+	return Template{}, ErrClientIsNil
+}
+
+// MaterializeRecurringEntries is a dummy implementation of the dinkur.Client
+// that only returns the "client is nil" error.
+func (*NilClient) MaterializeRecurringEntries(context.Context, time.Time) ([]Entry, error) {
+	return nil, ErrClientIsNil
+}
+
+// GetGoal is a dummy implementation of the dinkur.Client that only returns the
+// "client is nil" error.
+func (*NilClient) GetGoal(context.Context, uint) (Goal, error) {
+	return Goal{}, ErrClientIsNil
+}
+
+// GetGoalList is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) GetGoalList(context.Context) ([]Goal, error) {
+	return nil, ErrClientIsNil
+}
+
+// CreateGoal is a dummy implementation of the dinkur.Client that only returns
+// the "client is nil" error.
+func (*NilClient) CreateGoal(context.Context, NewGoal) (Goal, error) {
+	return Goal{}, ErrClientIsNil
+}
+
+// UpdateGoal is a dummy implementation of the dinkur.Client that only returns
+// the "client is nil" error.
+func (*NilClient) UpdateGoal(context.Context, EditGoal) (UpdatedGoal, error) {
+	return UpdatedGoal{}, ErrClientIsNil
+}
+
+// DeleteGoal is a dummy implementation of the dinkur.Client that only returns
+// the "client is nil" error.
+func (*NilClient) DeleteGoal(context.Context, uint) (Goal, error) {
+	return Goal{}, ErrClientIsNil
+}
+
+// GetGoalProgress is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) GetGoalProgress(context.Context, SearchGoalProgress) ([]GoalProgress, error) {
+	return nil, ErrClientIsNil
+}
+
 // StreamStatus is a dummy implementation of the dinkur.Client that only
 // returns the "client is nil" error.
 func (*NilClient) StreamStatus(context.Context) (<-chan StreamedStatus, error) {
@@ -111,3 +244,75 @@ func (*NilClient) SetStatus(context.Context, EditStatus) error {
 func (*NilClient) GetStatus(context.Context) (Status, error) {
 	return Status{}, ErrClientIsNil
 }
+
+// GetPendingAway is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) GetPendingAway(context.Context) (*PendingAway, error) {
+	return nil, ErrClientIsNil
+}
+
+// StreamPendingAway is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) StreamPendingAway(context.Context) (<-chan StreamedPendingAway, error) {
+	return nil, ErrClientIsNil
+}
+
+// ResolveAway is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) ResolveAway(context.Context, ResolveAway) (ResolvedAway, error) {
+	return ResolvedAway{}, ErrClientIsNil
+}
+
+// Backup is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) Backup(context.Context, io.Writer) error {
+	return ErrClientIsNil
+}
+
+// Restore is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) Restore(context.Context, io.Reader) error {
+	return ErrClientIsNil
+}
+
+// Undo is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) Undo(context.Context) (JournalResult, error) {
+	return JournalResult{}, ErrClientIsNil
+}
+
+// Redo is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) Redo(context.Context) (JournalResult, error) {
+	return JournalResult{}, ErrClientIsNil
+}
+
+// GetTrashedEntryList is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) GetTrashedEntryList(context.Context) ([]Entry, error) {
+	return nil, ErrClientIsNil
+}
+
+// RestoreEntry is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) RestoreEntry(context.Context, uint) (Entry, error) {
+	return Entry{}, ErrClientIsNil
+}
+
+// PurgeTrashedEntries is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) PurgeTrashedEntries(context.Context, time.Time) ([]Entry, error) {
+	return nil, ErrClientIsNil
+}
+
+// GetEntryOverlaps is a dummy implementation of the dinkur.Client that only
+// returns the "client is nil" error.
+func (*NilClient) GetEntryOverlaps(context.Context, SearchEntry) ([]EntryOverlap, error) {
+	return nil, ErrClientIsNil
+}
+
+// ResolveEntryOverlaps is a dummy implementation of the dinkur.Client that
+// only returns the "client is nil" error.
+func (*NilClient) ResolveEntryOverlaps(context.Context, ResolveEntryOverlaps) ([]EntryChange, error) {
+	return nil, ErrClientIsNil
+}
@@ -0,0 +1,104 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurclient
+
+import (
+	"context"
+	"io"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+)
+
+func (c *client) GetPendingAway(ctx context.Context) (*dinkur.PendingAway, error) {
+	res, err := invoke(ctx, c, c.away.GetPendingAway, &dinkurapiv1.GetPendingAwayRequest{})
+	if err != nil {
+		return nil, err
+	}
+	pending, err := fromgrpc.PendingAwayPtr(res.PendingAway)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return pending, nil
+}
+
+func (c *client) StreamPendingAway(ctx context.Context) (<-chan dinkur.StreamedPendingAway, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	stream, err := c.away.StreamPendingAway(ctx, &dinkurapiv1.StreamPendingAwayRequest{})
+	if err != nil {
+		return nil, convError(err)
+	}
+	pendingChan := make(chan dinkur.StreamedPendingAway)
+	go func() {
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					log.Error().
+						WithError(convError(err)).
+						Message("Error when streaming pending away time. Closing stream.")
+				}
+				close(pendingChan)
+				return
+			}
+			if res == nil {
+				continue
+			}
+			const logWarnMsg = "Error when streaming pending away time. Ignoring message."
+			pending, err := fromgrpc.PendingAwayPtr(res.PendingAway)
+			if err != nil {
+				log.Warn().WithError(convError(err)).
+					Message(logWarnMsg)
+				continue
+			}
+			pendingChan <- dinkur.StreamedPendingAway{
+				PendingAway: pending,
+				Event:       fromgrpc.Event(res.Event),
+			}
+		}
+	}()
+	return pendingChan, nil
+}
+
+func (c *client) ResolveAway(ctx context.Context, resolve dinkur.ResolveAway) (dinkur.ResolvedAway, error) {
+	res, err := invoke(ctx, c, c.away.ResolveAway, &dinkurapiv1.ResolveAwayRequest{
+		Action: togrpc.AwayAction(resolve.Action),
+		Parts:  togrpc.AwayPartSlice(resolve.Parts),
+	})
+	if err != nil {
+		return dinkur.ResolvedAway{}, err
+	}
+	stopped, err := fromgrpc.EntryPtr(res.Stopped)
+	if err != nil {
+		return dinkur.ResolvedAway{}, convError(err)
+	}
+	created, err := fromgrpc.EntrySlice(res.Created)
+	if err != nil {
+		return dinkur.ResolvedAway{}, convError(err)
+	}
+	return dinkur.ResolvedAway{
+		Stopped: stopped,
+		Created: created,
+	}, nil
+}
@@ -0,0 +1,97 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurclient
+
+import (
+	"context"
+	"io"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+)
+
+// restoreChunkSize is the maximum number of bytes sent per restore message.
+const restoreChunkSize = 64 * 1024
+
+func (c *client) Backup(ctx context.Context, w io.Writer) error {
+	if err := c.assertConnected(); err != nil {
+		return err
+	}
+	stream, err := c.backups.Backup(ctx, &dinkurapiv1.BackupRequest{})
+	if err != nil {
+		return convError(err)
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return convError(err)
+		}
+		if res == nil {
+			continue
+		}
+		if _, err := w.Write(res.Chunk); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *client) Restore(ctx context.Context, r io.Reader) error {
+	if err := c.assertConnected(); err != nil {
+		return err
+	}
+	// Cancelling the stream, instead of closing it, makes sure the daemon
+	// never restores a partially read backup.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.backups.Restore(ctx)
+	if err != nil {
+		return convError(err)
+	}
+	buf := make([]byte, restoreChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&dinkurapiv1.RestoreRequest{Chunk: buf[:n]}); sendErr != nil {
+				if sendErr == io.EOF {
+					// The server closed the stream early. The actual error
+					// is returned by CloseAndRecv.
+					break
+				}
+				return convError(sendErr)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return convError(err)
+	}
+	if res == nil {
+		return ErrResponseIsNil
+	}
+	return nil
+}
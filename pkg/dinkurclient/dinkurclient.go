@@ -25,12 +25,12 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/dinkur/dinkur/pkg/dinkur"
 	"github.com/iver-wharf/wharf-core/v2/pkg/logger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 
 	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
@@ -47,10 +47,39 @@ var (
 var log = logger.NewScoped("client")
 
 // Options for the Dinkur client.
-type Options struct{}
+type Options struct {
+	// Token is the authentication token sent as a bearer token on every
+	// request. Takes precedence over TokenFile if set.
+	Token string
+	// TokenFile is the path to a file containing the authentication token.
+	// Only used if Token is empty.
+	TokenFile string
+	// TLS enables connecting to the daemon over TLS.
+	TLS bool
+	// TLSCAFile is the path to a PEM-encoded file of certificate authorities
+	// used to verify the daemon's certificate. If empty, then the system's
+	// certificate authorities are used.
+	TLSCAFile string
+	// TLSServerName overrides the hostname used to verify the daemon's
+	// certificate. If empty, then the hostname of the server address is used.
+	TLSServerName string
+	// TLSTrustOnFirstUse disables verifying the daemon's certificate using
+	// certificate authorities. Instead the certificate's fingerprint is pinned
+	// in the TLSPinFile on the first connection, and all later connections
+	// must use the same certificate. Useful with self-signed certificates.
+	// Ignored if TLSCAFile is set.
+	TLSTrustOnFirstUse bool
+	// TLSPinFile is the path to the file where certificate fingerprints are
+	// pinned when using TLSTrustOnFirstUse.
+	TLSPinFile string
+}
 
 // NewClient returns a new dinkur.Client-compatible implementation that uses
 // gRPC towards a remote Dinkur daemon to perform all dinkur.Client entries.
+//
+// The server address is either a hostname/IP and port, such as
+// "localhost:59122", or a Unix domain socket path prefixed with "unix://",
+// such as "unix:///run/user/1000/dinkur.sock".
 func NewClient(serverAddr string, opt Options) dinkur.Client {
 	return &client{
 		Options:    opt,
@@ -63,14 +92,20 @@ type client struct {
 	serverAddr string
 	conn       *grpc.ClientConn
 	entryer    dinkurapiv1.EntriesClient
+	projects   dinkurapiv1.ProjectsClient
+	templates  dinkurapiv1.TemplatesClient
+	goals      dinkurapiv1.GoalsClient
 	statuses   dinkurapiv1.StatusesClient
+	away       dinkurapiv1.AwayResolutionClient
+	backups    dinkurapiv1.BackupsClient
+	focus      dinkurapiv1.FocusClient
 }
 
 func (c *client) assertConnected() error {
 	if c == nil {
 		return dinkur.ErrClientIsNil
 	}
-	if c.conn == nil || c.entryer == nil || c.statuses == nil {
+	if c.conn == nil || c.entryer == nil || c.projects == nil || c.templates == nil || c.goals == nil || c.statuses == nil || c.away == nil || c.backups == nil || c.focus == nil {
 		return dinkur.ErrNotConnected
 	}
 	return nil
@@ -80,17 +115,36 @@ func (c *client) Connect(ctx context.Context) error {
 	if c == nil {
 		return dinkur.ErrClientIsNil
 	}
-	if c.conn != nil || c.entryer != nil || c.statuses != nil {
+	if c.conn != nil || c.entryer != nil || c.projects != nil || c.templates != nil || c.goals != nil || c.statuses != nil || c.away != nil || c.backups != nil || c.focus != nil {
 		return dinkur.ErrAlreadyConnected
 	}
-	// TODO: add credentials via opts args
-	conn, err := grpc.DialContext(ctx, c.serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	token, err := c.readToken()
+	if err != nil {
+		return err
+	}
+	creds, err := c.transportCredentials()
+	if err != nil {
+		return err
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+	}
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials(token)))
+	}
+	conn, err := grpc.DialContext(ctx, c.serverAddr, opts...)
 	if err != nil {
 		return convError(err)
 	}
 	c.conn = conn
 	c.entryer = dinkurapiv1.NewEntriesClient(conn)
+	c.projects = dinkurapiv1.NewProjectsClient(conn)
+	c.templates = dinkurapiv1.NewTemplatesClient(conn)
+	c.goals = dinkurapiv1.NewGoalsClient(conn)
 	c.statuses = dinkurapiv1.NewStatusesClient(conn)
+	c.away = dinkurapiv1.NewAwayResolutionClient(conn)
+	c.backups = dinkurapiv1.NewBackupsClient(conn)
+	c.focus = dinkurapiv1.NewFocusClient(conn)
 	return nil
 }
 
@@ -100,6 +154,13 @@ func (c *client) Close() (err error) {
 		c.conn = nil
 	}
 	c.entryer = nil
+	c.projects = nil
+	c.templates = nil
+	c.goals = nil
+	c.statuses = nil
+	c.away = nil
+	c.backups = nil
+	c.focus = nil
 	return
 }
 
@@ -128,6 +189,16 @@ func convError(err error) error {
 	switch s.Code() {
 	case codes.NotFound:
 		return remessagedErr{s.Message(), dinkur.ErrNotFound}
+	case codes.AlreadyExists:
+		if strings.HasSuffix(s.Message(), dinkur.ErrTemplateExists.Error()) {
+			return remessagedErr{s.Message(), dinkur.ErrTemplateExists}
+		}
+		if strings.HasSuffix(s.Message(), dinkur.ErrGoalExists.Error()) {
+			return remessagedErr{s.Message(), dinkur.ErrGoalExists}
+		}
+		return remessagedErr{s.Message(), dinkur.ErrProjectExists}
+	case codes.Unauthenticated:
+		return remessagedErr{s.Message(), dinkur.ErrUnauthenticated}
 	default:
 		return remessagedErr{fmt.Sprintf("grpc error code %[1]d %[1]q: %[2]s", s.Code(), s.Message()), err}
 	}
@@ -65,7 +65,19 @@ func (c *client) GetEntry(ctx context.Context, id uint) (dinkur.Entry, error) {
 }
 
 func (c *client) GetEntryList(ctx context.Context, search dinkur.SearchEntry) ([]dinkur.Entry, error) {
-	res, err := invoke(ctx, c, c.entryer.GetEntryList, &dinkurapiv1.GetEntryListRequest{
+	res, err := invoke(ctx, c, c.entryer.GetEntryList, getEntryListRequest(search))
+	if err != nil {
+		return nil, convError(err)
+	}
+	entries, err := fromgrpc.EntrySlice(res.Entries)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return entries, nil
+}
+
+func getEntryListRequest(search dinkur.SearchEntry) *dinkurapiv1.GetEntryListRequest {
+	return &dinkurapiv1.GetEntryListRequest{
 		Start:              togrpc.TimestampPtr(search.Start),
 		End:                togrpc.TimestampPtr(search.End),
 		Limit:              uint64(search.Limit),
@@ -73,15 +85,49 @@ func (c *client) GetEntryList(ctx context.Context, search dinkur.SearchEntry) ([
 		NameFuzzy:          search.NameFuzzy,
 		NameHighlightStart: search.NameHighlightStart,
 		NameHighlightEnd:   search.NameHighlightEnd,
+		Tags:               search.Tags,
+		ExcludeTags:        search.ExcludeTags,
+		ProjectIdOrZero:    uint64(search.ProjectIDOrZero),
+	}
+}
+
+func (c *client) GetEntrySummary(ctx context.Context, search dinkur.SearchEntrySummary) ([]dinkur.EntrySummary, error) {
+	res, err := invoke(ctx, c, c.entryer.GetEntrySummary, &dinkurapiv1.GetEntrySummaryRequest{
+		Start:     togrpc.TimestampPtr(search.Start),
+		End:       togrpc.TimestampPtr(search.End),
+		Shorthand: togrpc.Shorthand(search.Shorthand),
+		GroupBy:   togrpc.EntrySummaryGroup(search.GroupBy),
+		TimeZone:  search.TimeZone,
 	})
 	if err != nil {
 		return nil, convError(err)
 	}
-	entries, err := fromgrpc.EntrySlice(res.Entries)
+	summaries, err := fromgrpc.EntrySummarySlice(res.Summaries)
 	if err != nil {
 		return nil, convError(err)
 	}
-	return entries, nil
+	return summaries, nil
+}
+
+func (c *client) GetEntryGaps(ctx context.Context, search dinkur.SearchEntryGaps) ([]dinkur.EntryGap, error) {
+	res, err := invoke(ctx, c, c.entryer.GetEntryGaps, &dinkurapiv1.GetEntryGapsRequest{
+		Start:          togrpc.TimestampPtr(search.Start),
+		End:            togrpc.TimestampPtr(search.End),
+		Shorthand:      togrpc.Shorthand(search.Shorthand),
+		WorkHoursStart: togrpc.Duration(search.WorkHoursStart),
+		WorkHoursEnd:   togrpc.Duration(search.WorkHoursEnd),
+		WorkDays:       togrpc.WeekdaySlice(search.WorkDays),
+		MinDuration:    togrpc.Duration(search.MinDuration),
+		TimeZone:       search.TimeZone,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	gaps, err := fromgrpc.EntryGapSlice(res.Gaps)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return gaps, nil
 }
 
 func (c *client) UpdateEntry(ctx context.Context, edit dinkur.EditEntry) (dinkur.UpdatedEntry, error) {
@@ -96,6 +142,13 @@ func (c *client) UpdateEntry(ctx context.Context, edit dinkur.EditEntry) (dinkur
 		StartAfterIdOrZero: uint64(edit.StartAfterIDOrZero),
 		EndBeforeIdOrZero:  uint64(edit.EndBeforeIDOrZero),
 		StartAfterLast:     edit.StartAfterLast,
+		Tags:               edit.Tags,
+		RemoveTags:         edit.RemoveTags,
+		ProjectIdOrZero:    uint64(edit.ProjectIDOrZero),
+		RemoveProject:      edit.RemoveProject,
+		Note:               conv.DerefOrZero(edit.Note),
+		AppendNote:         edit.AppendNote,
+		RemoveNote:         edit.Note != nil && *edit.Note == "",
 	})
 	if err != nil {
 		return dinkur.UpdatedEntry{}, convError(err)
@@ -128,18 +181,104 @@ func (c *client) DeleteEntry(ctx context.Context, id uint) (dinkur.Entry, error)
 	return entry, nil
 }
 
+func (c *client) UpdateEntries(ctx context.Context, edit dinkur.BulkEditEntry) ([]dinkur.UpdatedEntry, error) {
+	res, err := invoke(ctx, c, c.entryer.UpdateEntries, &dinkurapiv1.UpdateEntriesRequest{
+		Search:         getEntryListRequest(edit.Search),
+		Name:           conv.DerefOrZero(edit.Name),
+		ReplaceNameOld: edit.ReplaceNameOld,
+		ReplaceNameNew: edit.ReplaceNameNew,
+		Shift:          togrpc.Duration(edit.Shift),
+		DryRun:         edit.DryRun,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	updates := make([]dinkur.UpdatedEntry, len(res.Updated))
+	for i, change := range res.Updated {
+		entryBefore, err := fromgrpc.EntryPtrNoNil(change.GetBefore())
+		if err != nil {
+			return nil, fmt.Errorf("updated entry %d before: %w", i+1, convError(err))
+		}
+		entryAfter, err := fromgrpc.EntryPtrNoNil(change.GetAfter())
+		if err != nil {
+			return nil, fmt.Errorf("updated entry %d after: %w", i+1, convError(err))
+		}
+		updates[i] = dinkur.UpdatedEntry{
+			Before: entryBefore,
+			After:  entryAfter,
+		}
+	}
+	return updates, nil
+}
+
+func (c *client) DeleteEntries(ctx context.Context, del dinkur.BulkDeleteEntry) ([]dinkur.Entry, error) {
+	res, err := invoke(ctx, c, c.entryer.DeleteEntries, &dinkurapiv1.DeleteEntriesRequest{
+		Search: getEntryListRequest(del.Search),
+		DryRun: del.DryRun,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	entries, err := fromgrpc.EntrySlice(res.DeletedEntries)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return entries, nil
+}
+
 func (c *client) CreateEntry(ctx context.Context, entry dinkur.NewEntry) (dinkur.StartedEntry, error) {
-	res, err := invoke(ctx, c, c.entryer.CreateEntry, &dinkurapiv1.CreateEntryRequest{
+	res, err := invoke(ctx, c, c.entryer.CreateEntry, createEntryRequest(entry))
+	if err != nil {
+		return dinkur.StartedEntry{}, convError(err)
+	}
+	return startedEntryFromResponse(res)
+}
+
+func (c *client) CreateEntries(ctx context.Context, entries []dinkur.NewEntry) ([]dinkur.StartedEntry, error) {
+	req := &dinkurapiv1.CreateEntriesRequest{
+		Entries: make([]*dinkurapiv1.CreateEntryRequest, len(entries)),
+	}
+	for i, entry := range entries {
+		req.Entries[i] = createEntryRequest(entry)
+	}
+	res, err := invoke(ctx, c, c.entryer.CreateEntries, req)
+	if err != nil {
+		return nil, convError(err)
+	}
+	startedEntries := make([]dinkur.StartedEntry, 0, len(res.Entries))
+	for i, entryRes := range res.Entries {
+		if entryRes == nil {
+			continue
+		}
+		startedEntry, err := startedEntryFromResponse(entryRes)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+		startedEntries = append(startedEntries, startedEntry)
+	}
+	return startedEntries, nil
+}
+
+func createEntryRequest(entry dinkur.NewEntry) *dinkurapiv1.CreateEntryRequest {
+	req := &dinkurapiv1.CreateEntryRequest{
 		Name:               entry.Name,
+		Note:               entry.Note,
 		Start:              togrpc.TimestampPtr(entry.Start),
 		End:                togrpc.TimestampPtr(entry.End),
 		StartAfterIdOrZero: uint64(entry.StartAfterIDOrZero),
 		EndBeforeIdOrZero:  uint64(entry.EndBeforeIDOrZero),
 		StartAfterLast:     entry.StartAfterLast,
-	})
-	if err != nil {
-		return dinkur.StartedEntry{}, convError(err)
+		Tags:               entry.Tags,
+		ProjectIdOrZero:    uint64(entry.ProjectIDOrZero),
 	}
+	if entry.Project != nil {
+		req.ProjectName = entry.Project.Name
+		req.ProjectClient = entry.Project.Client
+	}
+	return req
+}
+
+func startedEntryFromResponse(res *dinkurapiv1.CreateEntryResponse) (dinkur.StartedEntry, error) {
 	prevEntry, err := fromgrpc.EntryPtr(res.PreviouslyActiveEntry)
 	if err != nil {
 		return dinkur.StartedEntry{}, fmt.Errorf("stopped entry: %w", convError(err))
@@ -224,3 +363,102 @@ func (c *client) StreamEntry(ctx context.Context) (<-chan dinkur.StreamedEntry,
 	}()
 	return entryChan, nil
 }
+
+func (c *client) Undo(ctx context.Context) (dinkur.JournalResult, error) {
+	res, err := invoke(ctx, c, c.entryer.Undo, &dinkurapiv1.UndoRequest{})
+	if err != nil {
+		return dinkur.JournalResult{}, convError(err)
+	}
+	changes, err := fromgrpc.EntryChangeSlice(res.Changes)
+	if err != nil {
+		return dinkur.JournalResult{}, convError(err)
+	}
+	return dinkur.JournalResult{
+		Action:  fromgrpc.JournalAction(res.Action),
+		Changes: changes,
+	}, nil
+}
+
+func (c *client) Redo(ctx context.Context) (dinkur.JournalResult, error) {
+	res, err := invoke(ctx, c, c.entryer.Redo, &dinkurapiv1.RedoRequest{})
+	if err != nil {
+		return dinkur.JournalResult{}, convError(err)
+	}
+	changes, err := fromgrpc.EntryChangeSlice(res.Changes)
+	if err != nil {
+		return dinkur.JournalResult{}, convError(err)
+	}
+	return dinkur.JournalResult{
+		Action:  fromgrpc.JournalAction(res.Action),
+		Changes: changes,
+	}, nil
+}
+
+func (c *client) GetTrashedEntryList(ctx context.Context) ([]dinkur.Entry, error) {
+	res, err := invoke(ctx, c, c.entryer.GetTrashedEntryList, &dinkurapiv1.GetTrashedEntryListRequest{})
+	if err != nil {
+		return nil, convError(err)
+	}
+	entries, err := fromgrpc.EntrySlice(res.Entries)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return entries, nil
+}
+
+func (c *client) RestoreEntry(ctx context.Context, id uint) (dinkur.Entry, error) {
+	res, err := invoke(ctx, c, c.entryer.RestoreEntry, &dinkurapiv1.RestoreEntryRequest{
+		Id: uint64(id),
+	})
+	if err != nil {
+		return dinkur.Entry{}, convError(err)
+	}
+	entry, err := fromgrpc.EntryPtrNoNil(res.RestoredEntry)
+	if err != nil {
+		return dinkur.Entry{}, convError(err)
+	}
+	return entry, nil
+}
+
+func (c *client) PurgeTrashedEntries(ctx context.Context, deletedBefore time.Time) ([]dinkur.Entry, error) {
+	res, err := invoke(ctx, c, c.entryer.PurgeTrashedEntries, &dinkurapiv1.PurgeTrashedEntriesRequest{
+		DeletedBefore: togrpc.Timestamp(deletedBefore),
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	entries, err := fromgrpc.EntrySlice(res.PurgedEntries)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return entries, nil
+}
+
+func (c *client) GetEntryOverlaps(ctx context.Context, search dinkur.SearchEntry) ([]dinkur.EntryOverlap, error) {
+	res, err := invoke(ctx, c, c.entryer.GetEntryOverlaps, &dinkurapiv1.GetEntryOverlapsRequest{
+		Search: getEntryListRequest(search),
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	overlaps, err := fromgrpc.EntryOverlapSlice(res.Overlaps)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return overlaps, nil
+}
+
+func (c *client) ResolveEntryOverlaps(ctx context.Context, resolve dinkur.ResolveEntryOverlaps) ([]dinkur.EntryChange, error) {
+	res, err := invoke(ctx, c, c.entryer.ResolveEntryOverlaps, &dinkurapiv1.ResolveEntryOverlapsRequest{
+		Search: getEntryListRequest(resolve.Search),
+		Policy: togrpc.OverlapPolicy(resolve.Policy),
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	changes, err := fromgrpc.EntryChangeSlice(res.Changes)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return changes, nil
+}
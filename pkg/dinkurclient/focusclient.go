@@ -0,0 +1,102 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurclient
+
+import (
+	"context"
+	"io"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+)
+
+func (c *client) StartFocus(ctx context.Context, focus dinkur.NewFocus) (dinkur.Focus, error) {
+	res, err := invoke(ctx, c, c.focus.StartFocus, &dinkurapiv1.StartFocusRequest{
+		Name:            focus.Name,
+		Tags:            focus.Tags,
+		ProjectIdOrZero: uint64(focus.ProjectIDOrZero),
+		Work:            togrpc.Duration(focus.Work),
+		Break:           togrpc.Duration(focus.Break),
+		Cycles:          uint64(focus.Cycles),
+		LogBreaks:       focus.LogBreaks,
+		BreakName:       focus.BreakName,
+	})
+	if err != nil {
+		return dinkur.Focus{}, err
+	}
+	return fromgrpc.FocusPtrNoNil(res.Focus)
+}
+
+func (c *client) StopFocus(ctx context.Context) (dinkur.Focus, error) {
+	res, err := invoke(ctx, c, c.focus.StopFocus, &dinkurapiv1.StopFocusRequest{})
+	if err != nil {
+		return dinkur.Focus{}, err
+	}
+	return fromgrpc.FocusPtrNoNil(res.Focus)
+}
+
+func (c *client) GetFocus(ctx context.Context) (dinkur.Focus, error) {
+	res, err := invoke(ctx, c, c.focus.GetFocus, &dinkurapiv1.GetFocusRequest{})
+	if err != nil {
+		return dinkur.Focus{}, err
+	}
+	return fromgrpc.FocusPtrNoNil(res.Focus)
+}
+
+func (c *client) StreamFocus(ctx context.Context) (<-chan dinkur.StreamedFocus, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	stream, err := c.focus.StreamFocus(ctx, &dinkurapiv1.StreamFocusRequest{})
+	if err != nil {
+		return nil, convError(err)
+	}
+	focusChan := make(chan dinkur.StreamedFocus)
+	go func() {
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF {
+					log.Error().
+						WithError(convError(err)).
+						Message("Error when streaming focus timer. Closing stream.")
+				}
+				close(focusChan)
+				return
+			}
+			if res == nil {
+				continue
+			}
+			const logWarnMsg = "Error when streaming focus timer. Ignoring message."
+			focus, err := fromgrpc.FocusPtrNoNil(res.Focus)
+			if err != nil {
+				log.Warn().WithError(convError(err)).
+					Message(logWarnMsg)
+				continue
+			}
+			focusChan <- dinkur.StreamedFocus{
+				Focus: focus,
+			}
+		}
+	}()
+	return focusChan, nil
+}
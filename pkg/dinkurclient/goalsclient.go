@@ -0,0 +1,132 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+)
+
+func (c *client) GetGoal(ctx context.Context, id uint) (dinkur.Goal, error) {
+	res, err := invoke(ctx, c, c.goals.GetGoal, &dinkurapiv1.GetGoalRequest{
+		Id: uint64(id),
+	})
+	if err != nil {
+		return dinkur.Goal{}, convError(err)
+	}
+	goal, err := fromgrpc.GoalPtrNoNil(res.Goal)
+	if err != nil {
+		return dinkur.Goal{}, convError(err)
+	}
+	return goal, nil
+}
+
+func (c *client) GetGoalList(ctx context.Context) ([]dinkur.Goal, error) {
+	res, err := invoke(ctx, c, c.goals.GetGoalList, &dinkurapiv1.GetGoalListRequest{})
+	if err != nil {
+		return nil, convError(err)
+	}
+	goals, err := fromgrpc.GoalSlice(res.Goals)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return goals, nil
+}
+
+func (c *client) CreateGoal(ctx context.Context, goal dinkur.NewGoal) (dinkur.Goal, error) {
+	res, err := invoke(ctx, c, c.goals.CreateGoal, &dinkurapiv1.CreateGoalRequest{
+		Period:          togrpc.GoalPeriod(goal.Period),
+		Target:          togrpc.Duration(goal.Target),
+		ProjectIdOrZero: uint64(goal.ProjectIDOrZero),
+	})
+	if err != nil {
+		return dinkur.Goal{}, convError(err)
+	}
+	newGoal, err := fromgrpc.GoalPtrNoNil(res.CreatedGoal)
+	if err != nil {
+		return dinkur.Goal{}, convError(err)
+	}
+	return newGoal, nil
+}
+
+func (c *client) UpdateGoal(ctx context.Context, edit dinkur.EditGoal) (dinkur.UpdatedGoal, error) {
+	req := &dinkurapiv1.UpdateGoalRequest{
+		Id:              uint64(edit.ID),
+		ProjectIdOrZero: uint64(edit.ProjectIDOrZero),
+		RemoveProject:   edit.RemoveProject,
+	}
+	if edit.Period != nil {
+		req.Period = togrpc.GoalPeriod(*edit.Period)
+	}
+	if edit.Target != nil {
+		req.Target = togrpc.Duration(*edit.Target)
+	}
+	res, err := invoke(ctx, c, c.goals.UpdateGoal, req)
+	if err != nil {
+		return dinkur.UpdatedGoal{}, convError(err)
+	}
+	goalBefore, err := fromgrpc.GoalPtrNoNil(res.Before)
+	if err != nil {
+		return dinkur.UpdatedGoal{}, fmt.Errorf("goal before: %w", convError(err))
+	}
+	goalAfter, err := fromgrpc.GoalPtrNoNil(res.After)
+	if err != nil {
+		return dinkur.UpdatedGoal{}, fmt.Errorf("goal after: %w", convError(err))
+	}
+	return dinkur.UpdatedGoal{
+		Before: goalBefore,
+		After:  goalAfter,
+	}, nil
+}
+
+func (c *client) DeleteGoal(ctx context.Context, id uint) (dinkur.Goal, error) {
+	res, err := invoke(ctx, c, c.goals.DeleteGoal, &dinkurapiv1.DeleteGoalRequest{
+		Id: uint64(id),
+	})
+	if err != nil {
+		return dinkur.Goal{}, convError(err)
+	}
+	goal, err := fromgrpc.GoalPtrNoNil(res.DeletedGoal)
+	if err != nil {
+		return dinkur.Goal{}, convError(err)
+	}
+	return goal, nil
+}
+
+func (c *client) GetGoalProgress(ctx context.Context, search dinkur.SearchGoalProgress) ([]dinkur.GoalProgress, error) {
+	res, err := invoke(ctx, c, c.goals.GetGoalProgress, &dinkurapiv1.GetGoalProgressRequest{
+		At:       togrpc.TimestampPtr(search.At),
+		TimeZone: search.TimeZone,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	progress, err := fromgrpc.GoalProgressSlice(res.Progress)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return progress, nil
+}
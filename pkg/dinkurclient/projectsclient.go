@@ -0,0 +1,116 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+)
+
+func (c *client) GetProject(ctx context.Context, id uint) (dinkur.Project, error) {
+	res, err := invoke(ctx, c, c.projects.GetProject, &dinkurapiv1.GetProjectRequest{
+		Id: uint64(id),
+	})
+	if err != nil {
+		return dinkur.Project{}, convError(err)
+	}
+	project, err := fromgrpc.ProjectPtrNoNil(res.Project)
+	if err != nil {
+		return dinkur.Project{}, convError(err)
+	}
+	return project, nil
+}
+
+func (c *client) GetProjectList(ctx context.Context, search dinkur.SearchProject) ([]dinkur.Project, error) {
+	res, err := invoke(ctx, c, c.projects.GetProjectList, &dinkurapiv1.GetProjectListRequest{
+		Name:   search.Name,
+		Client: search.Client,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	projects, err := fromgrpc.ProjectSlice(res.Projects)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return projects, nil
+}
+
+func (c *client) CreateProject(ctx context.Context, project dinkur.NewProject) (dinkur.Project, error) {
+	res, err := invoke(ctx, c, c.projects.CreateProject, &dinkurapiv1.CreateProjectRequest{
+		Name:   project.Name,
+		Client: project.Client,
+	})
+	if err != nil {
+		return dinkur.Project{}, convError(err)
+	}
+	newProject, err := fromgrpc.ProjectPtrNoNil(res.CreatedProject)
+	if err != nil {
+		return dinkur.Project{}, convError(err)
+	}
+	return newProject, nil
+}
+
+func (c *client) UpdateProject(ctx context.Context, edit dinkur.EditProject) (dinkur.UpdatedProject, error) {
+	req := &dinkurapiv1.UpdateProjectRequest{
+		Id:     uint64(edit.ID),
+		Name:   conv.DerefOrZero(edit.Name),
+		Client: conv.DerefOrZero(edit.Client),
+	}
+	if edit.Client != nil && *edit.Client == "" {
+		req.RemoveClient = true
+	}
+	res, err := invoke(ctx, c, c.projects.UpdateProject, req)
+	if err != nil {
+		return dinkur.UpdatedProject{}, convError(err)
+	}
+	projectBefore, err := fromgrpc.ProjectPtrNoNil(res.Before)
+	if err != nil {
+		return dinkur.UpdatedProject{}, fmt.Errorf("project before: %w", convError(err))
+	}
+	projectAfter, err := fromgrpc.ProjectPtrNoNil(res.After)
+	if err != nil {
+		return dinkur.UpdatedProject{}, fmt.Errorf("project after: %w", convError(err))
+	}
+	return dinkur.UpdatedProject{
+		Before: projectBefore,
+		After:  projectAfter,
+	}, nil
+}
+
+func (c *client) DeleteProject(ctx context.Context, id uint) (dinkur.Project, error) {
+	res, err := invoke(ctx, c, c.projects.DeleteProject, &dinkurapiv1.DeleteProjectRequest{
+		Id: uint64(id),
+	})
+	if err != nil {
+		return dinkur.Project{}, convError(err)
+	}
+	project, err := fromgrpc.ProjectPtrNoNil(res.DeletedProject)
+	if err != nil {
+		return dinkur.Project{}, convError(err)
+	}
+	return project, nil
+}
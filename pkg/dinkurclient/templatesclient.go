@@ -0,0 +1,141 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+)
+
+func (c *client) GetTemplate(ctx context.Context, id uint) (dinkur.Template, error) {
+	res, err := invoke(ctx, c, c.templates.GetTemplate, &dinkurapiv1.GetTemplateRequest{
+		Id: uint64(id),
+	})
+	if err != nil {
+		return dinkur.Template{}, convError(err)
+	}
+	template, err := fromgrpc.TemplatePtrNoNil(res.Template)
+	if err != nil {
+		return dinkur.Template{}, convError(err)
+	}
+	return template, nil
+}
+
+func (c *client) GetTemplateList(ctx context.Context, search dinkur.SearchTemplate) ([]dinkur.Template, error) {
+	res, err := invoke(ctx, c, c.templates.GetTemplateList, &dinkurapiv1.GetTemplateListRequest{
+		Name: search.Name,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	templates, err := fromgrpc.TemplateSlice(res.Templates)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return templates, nil
+}
+
+func (c *client) CreateTemplate(ctx context.Context, template dinkur.NewTemplate) (dinkur.Template, error) {
+	req := &dinkurapiv1.CreateTemplateRequest{
+		Name:       template.Name,
+		EntryName:  template.EntryName,
+		Tags:       template.Tags,
+		Recurrence: togrpc.RecurrencePtr(template.Recurrence),
+	}
+	if template.Duration != 0 {
+		req.Duration = togrpc.Duration(template.Duration)
+	}
+	res, err := invoke(ctx, c, c.templates.CreateTemplate, req)
+	if err != nil {
+		return dinkur.Template{}, convError(err)
+	}
+	newTemplate, err := fromgrpc.TemplatePtrNoNil(res.CreatedTemplate)
+	if err != nil {
+		return dinkur.Template{}, convError(err)
+	}
+	return newTemplate, nil
+}
+
+func (c *client) UpdateTemplate(ctx context.Context, edit dinkur.EditTemplate) (dinkur.UpdatedTemplate, error) {
+	req := &dinkurapiv1.UpdateTemplateRequest{
+		Id:               uint64(edit.ID),
+		Name:             conv.DerefOrZero(edit.Name),
+		EntryName:        conv.DerefOrZero(edit.EntryName),
+		Tags:             edit.Tags,
+		RemoveTags:       edit.RemoveTags,
+		Recurrence:       togrpc.RecurrencePtr(edit.Recurrence),
+		RemoveRecurrence: edit.RemoveRecurrence,
+	}
+	if edit.Duration != nil {
+		req.Duration = togrpc.Duration(*edit.Duration)
+	}
+	res, err := invoke(ctx, c, c.templates.UpdateTemplate, req)
+	if err != nil {
+		return dinkur.UpdatedTemplate{}, convError(err)
+	}
+	templateBefore, err := fromgrpc.TemplatePtrNoNil(res.Before)
+	if err != nil {
+		return dinkur.UpdatedTemplate{}, fmt.Errorf("template before: %w", convError(err))
+	}
+	templateAfter, err := fromgrpc.TemplatePtrNoNil(res.After)
+	if err != nil {
+		return dinkur.UpdatedTemplate{}, fmt.Errorf("template after: %w", convError(err))
+	}
+	return dinkur.UpdatedTemplate{
+		Before: templateBefore,
+		After:  templateAfter,
+	}, nil
+}
+
+func (c *client) DeleteTemplate(ctx context.Context, id uint) (dinkur.Template, error) {
+	res, err := invoke(ctx, c, c.templates.DeleteTemplate, &dinkurapiv1.DeleteTemplateRequest{
+		Id: uint64(id),
+	})
+	if err != nil {
+		return dinkur.Template{}, convError(err)
+	}
+	template, err := fromgrpc.TemplatePtrNoNil(res.DeletedTemplate)
+	if err != nil {
+		return dinkur.Template{}, convError(err)
+	}
+	return template, nil
+}
+
+func (c *client) MaterializeRecurringEntries(ctx context.Context, until time.Time) ([]dinkur.Entry, error) {
+	res, err := invoke(ctx, c, c.templates.MaterializeRecurringEntries, &dinkurapiv1.MaterializeRecurringEntriesRequest{
+		Until: togrpc.Timestamp(until),
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	entries, err := fromgrpc.EntrySlice(res.Entries)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,157 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurclient
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Errors specific to TLS connections.
+var (
+	ErrTLSCAFileInvalid     = errors.New("no valid certificates found in CA file")
+	ErrTLSPinFileNotSet     = errors.New("trust-on-first-use requires a pin file")
+	ErrTLSNoPeerCertificate = errors.New("server did not present any certificate")
+	ErrTLSPinMismatch       = errors.New("server certificate does not match the pinned certificate")
+)
+
+func (c *client) transportCredentials() (credentials.TransportCredentials, error) {
+	if !c.TLS {
+		return insecure.NewCredentials(), nil
+	}
+	tlsConfig := &tls.Config{
+		ServerName: c.TLSServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if c.TLSCAFile != "" {
+		pool, err := readCertPool(c.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read CA file: %w", err)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	// Trust-on-first-use is only applied when no certificate authorities are
+	// configured, so an explicit CA file always takes precedence.
+	if c.TLSTrustOnFirstUse && c.TLSCAFile == "" {
+		if c.TLSPinFile == "" {
+			return nil, ErrTLSPinFileNotSet
+		}
+		// The certificate chain is not verified against any certificate
+		// authority. Instead the certificate is compared to the certificate
+		// that was pinned on the first connection to this server.
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return ErrTLSNoPeerCertificate
+			}
+			return verifyPinnedCert(c.TLSPinFile, c.serverAddr, rawCerts[0])
+		}
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+func readCertPool(path string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("%w: %s", ErrTLSCAFileInvalid, path)
+	}
+	return pool, nil
+}
+
+// verifyPinnedCert compares the certificate's fingerprint with the one pinned
+// for the server address in the pin file, or pins it if the server address has
+// no pinned fingerprint.
+//
+// The pin file contains one server per line, with the server address and the
+// certificate's SHA-256 fingerprint separated by whitespace.
+func verifyPinnedCert(pinFile, serverAddr string, rawCert []byte) error {
+	sum := sha256.Sum256(rawCert)
+	fingerprint := hex.EncodeToString(sum[:])
+	pinned, err := readPinnedFingerprint(pinFile, serverAddr)
+	if err != nil {
+		return fmt.Errorf("read pin file: %w", err)
+	}
+	if pinned == "" {
+		if err := appendPinnedFingerprint(pinFile, serverAddr, fingerprint); err != nil {
+			return fmt.Errorf("write pin file: %w", err)
+		}
+		log.Warn().
+			WithString("address", serverAddr).
+			WithString("sha256", fingerprint).
+			WithString("file", pinFile).
+			Message("Trusting and pinning server certificate on first use.")
+		return nil
+	}
+	if !strings.EqualFold(pinned, fingerprint) {
+		return fmt.Errorf("%w: address %s, expected sha256 %s, got %s",
+			ErrTLSPinMismatch, serverAddr, pinned, fingerprint)
+	}
+	return nil
+}
+
+func readPinnedFingerprint(pinFile, serverAddr string) (string, error) {
+	file, err := os.Open(pinFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == serverAddr {
+			return fields[1], nil
+		}
+	}
+	return "", scanner.Err()
+}
+
+func appendPinnedFingerprint(pinFile, serverAddr, fingerprint string) error {
+	if err := os.MkdirAll(filepath.Dir(pinFile), 0700); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(pinFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(file, "%s %s\n", serverAddr, fingerprint); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
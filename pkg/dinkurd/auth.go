@@ -0,0 +1,125 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ErrTokenFileEmpty is returned when reading a token file that exists but does
+// not contain any token.
+var ErrTokenFileEmpty = errors.New("token file is empty")
+
+const (
+	authMetadataKey    = "authorization"
+	authBearerPrefix   = "Bearer "
+	generatedTokenSize = 32
+)
+
+// ReadOrCreateTokenFile reads the authentication token from a file. If the
+// file does not exist, then a new random token is generated and written to the
+// file, only readable by the current user.
+func ReadOrCreateTokenFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err == nil {
+		token := strings.TrimSpace(string(b))
+		if token == "" {
+			return "", fmt.Errorf("%w: %s", ErrTokenFileEmpty, path)
+		}
+		return token, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+	token, err := generateToken()
+	if err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", fmt.Errorf("create token directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", fmt.Errorf("create token file: %w", err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(token + "\n"); err != nil {
+		return "", fmt.Errorf("write token file: %w", err)
+	}
+	log.Info().WithString("file", path).Message("Generated new authentication token.")
+	return token, nil
+}
+
+func generateToken() (string, error) {
+	b := make([]byte, generatedTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (d *daemon) authUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := d.authenticate(ctx); err != nil {
+		log.Debug().WithString("method", info.FullMethod).Message("Rejected unauthenticated request.")
+		return nil, convError(err)
+	}
+	return handler(ctx, req)
+}
+
+func (d *daemon) authStreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := d.authenticate(stream.Context()); err != nil {
+		log.Debug().WithString("method", info.FullMethod).Message("Rejected unauthenticated stream.")
+		return convError(err)
+	}
+	return handler(srv, stream)
+}
+
+func (d *daemon) authenticate(ctx context.Context) error {
+	if d.Token == "" {
+		return nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return dinkur.ErrUnauthenticated
+	}
+	for _, value := range md.Get(authMetadataKey) {
+		token, ok := strings.CutPrefix(value, authBearerPrefix)
+		if !ok {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(token), []byte(d.Token)) == 1 {
+			return nil
+		}
+	}
+	return dinkur.ErrUnauthenticated
+}
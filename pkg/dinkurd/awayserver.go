@@ -0,0 +1,100 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+)
+
+func (d *daemon) GetPendingAway(ctx context.Context, req *dinkurapiv1.GetPendingAwayRequest) (*dinkurapiv1.GetPendingAwayResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	pending, err := d.client.GetPendingAway(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetPendingAwayResponse{
+		PendingAway: togrpc.PendingAwayPtr(pending),
+	}, nil
+}
+
+func (d *daemon) StreamPendingAway(req *dinkurapiv1.StreamPendingAwayRequest, stream dinkurapiv1.AwayResolution_StreamPendingAwayServer) error {
+	if err := d.assertConnected(); err != nil {
+		return convError(err)
+	}
+	if req == nil {
+		return convError(ErrRequestIsNil)
+	}
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	ch, err := d.client.StreamPendingAway(ctx)
+	if err != nil {
+		return convError(err)
+	}
+	for ev := range ch {
+		if err := stream.Send(&dinkurapiv1.StreamPendingAwayResponse{
+			PendingAway: togrpc.PendingAwayPtr(ev.PendingAway),
+			Event:       togrpc.Event(ev.Event),
+		}); err != nil {
+			return convError(err)
+		}
+	}
+	return nil
+}
+
+func (d *daemon) ResolveAway(ctx context.Context, req *dinkurapiv1.ResolveAwayRequest) (*dinkurapiv1.ResolveAwayResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	action, err := fromgrpc.AwayAction(req.Action)
+	if err != nil {
+		return nil, convError(err)
+	}
+	parts, err := fromgrpc.AwayPartSlice(req.Parts)
+	if err != nil {
+		return nil, convError(err)
+	}
+	resolved, err := d.client.ResolveAway(ctx, dinkur.ResolveAway{
+		Action: action,
+		Parts:  parts,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	// the client cleared the status, so the next time the user goes AFK must
+	// be treated as new AFK time, instead of as a continuation
+	d.lastStatus = dinkur.EditStatus{}
+	return &dinkurapiv1.ResolveAwayResponse{
+		Stopped: togrpc.EntryPtr(resolved.Stopped),
+		Created: togrpc.EntrySlice(resolved.Created),
+	}, nil
+}
@@ -0,0 +1,90 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"bufio"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+)
+
+// backupChunkSize is the maximum number of bytes sent per backup message.
+const backupChunkSize = 64 * 1024
+
+func (d *daemon) Backup(req *dinkurapiv1.BackupRequest, stream dinkurapiv1.Backups_BackupServer) error {
+	if err := d.assertConnected(); err != nil {
+		return convError(err)
+	}
+	if req == nil {
+		return convError(ErrRequestIsNil)
+	}
+	w := bufio.NewWriterSize(backupStreamWriter{stream}, backupChunkSize)
+	if err := d.client.Backup(stream.Context(), w); err != nil {
+		return convError(err)
+	}
+	if err := w.Flush(); err != nil {
+		return convError(err)
+	}
+	return nil
+}
+
+func (d *daemon) Restore(stream dinkurapiv1.Backups_RestoreServer) error {
+	if err := d.assertConnected(); err != nil {
+		return convError(err)
+	}
+	ctx := stream.Context()
+	if err := d.client.Restore(ctx, &restoreStreamReader{stream: stream}); err != nil {
+		return convError(err)
+	}
+	d.onEntryMutation(ctx)
+	return stream.SendAndClose(&dinkurapiv1.RestoreResponse{})
+}
+
+type backupStreamWriter struct {
+	stream dinkurapiv1.Backups_BackupServer
+}
+
+func (w backupStreamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&dinkurapiv1.BackupResponse{Chunk: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+type restoreStreamReader struct {
+	stream dinkurapiv1.Backups_RestoreServer
+	buf    []byte
+}
+
+func (r *restoreStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if req == nil {
+			return 0, ErrRequestIsNil
+		}
+		r.buf = req.Chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
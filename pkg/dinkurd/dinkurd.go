@@ -27,6 +27,7 @@ import (
 	"math"
 	"net"
 	"sync"
+	"time"
 
 	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
 	"github.com/dinkur/dinkur/pkg/afkdetect"
@@ -34,7 +35,9 @@ import (
 	"github.com/iver-wharf/wharf-core/v2/pkg/logger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
+	"gopkg.in/typ.v4/chans"
 )
 
 // Errors that are specific to the Dinkur gRPC server daemon.
@@ -42,7 +45,9 @@ var (
 	ErrUintTooLarge   = fmt.Errorf("unsigned int value is too large, maximum: %d", uint64(math.MaxUint))
 	ErrDaemonIsNil    = errors.New("daemon is nil")
 	ErrRequestIsNil   = errors.New("grpc request was nil")
+	ErrSearchIsNil    = errors.New("grpc request search was nil")
 	ErrAlreadyServing = errors.New("daemon instance is already running")
+	ErrTLSIncomplete  = errors.New("both TLS certificate and key files must be set")
 )
 
 var log = logger.NewScoped("daemon")
@@ -54,13 +59,44 @@ func convError(err error) error {
 	case errors.Is(err, dinkur.ErrNotFound):
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, ErrRequestIsNil),
+		errors.Is(err, ErrSearchIsNil),
 		errors.Is(err, ErrUintTooLarge),
 		errors.Is(err, dinkur.ErrLimitTooLarge),
 		errors.Is(err, dinkur.ErrEntryEndBeforeStart),
-		errors.Is(err, dinkur.ErrEntryNameEmpty):
+		errors.Is(err, dinkur.ErrEntryNameEmpty),
+		errors.Is(err, dinkur.ErrEntryTagEmpty),
+		errors.Is(err, dinkur.ErrEntryTagInvalid),
+		errors.Is(err, dinkur.ErrProjectNameEmpty),
+		errors.Is(err, dinkur.ErrProjectNameInvalid),
+		errors.Is(err, dinkur.ErrTemplateNameEmpty),
+		errors.Is(err, dinkur.ErrRecurrenceInvalid),
+		errors.Is(err, dinkur.ErrGoalPeriodInvalid),
+		errors.Is(err, dinkur.ErrGoalTargetInvalid),
+		errors.Is(err, dinkur.ErrSummaryGroupInvalid),
+		errors.Is(err, dinkur.ErrSummaryRangeTooLarge),
+		errors.Is(err, dinkur.ErrTimeZoneInvalid),
+		errors.Is(err, dinkur.ErrBackupInvalid),
+		errors.Is(err, dinkur.ErrBackupTooNew),
+		errors.Is(err, dinkur.ErrOverlapPolicyInvalid),
+		errors.Is(err, dinkur.ErrFocusInvalid),
+		errors.Is(err, dinkur.ErrAwayInvalid),
+		errors.Is(err, dinkur.ErrWorkHoursInvalid):
 		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, dinkur.ErrUnauthenticated):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, dinkur.ErrProjectExists),
+		errors.Is(err, dinkur.ErrTemplateExists),
+		errors.Is(err, dinkur.ErrGoalExists):
+		return status.Error(codes.AlreadyExists, err.Error())
 	case errors.Is(err, dinkur.ErrNotConnected),
+		errors.Is(err, dinkur.ErrNothingToUndo),
+		errors.Is(err, dinkur.ErrNothingToRedo),
+		errors.Is(err, dinkur.ErrEntryOverlaps),
 		errors.Is(err, dinkur.ErrAlreadyConnected),
+		errors.Is(err, dinkur.ErrFocusActive),
+		errors.Is(err, dinkur.ErrFocusNotActive),
+		errors.Is(err, dinkur.ErrAwayNotPending),
+		errors.Is(err, dinkur.ErrProjectHasGoals),
 		errors.Is(err, dinkur.ErrClientIsNil):
 		return status.Error(codes.FailedPrecondition, err.Error())
 	default:
@@ -71,14 +107,51 @@ func convError(err error) error {
 // Options for the daemon server.
 type Options struct {
 	// BindAddress is the hostname/IP and port to bind the server to.
-	// Use 0.0.0.0 for IP to allow any IP address.
+	// Use 0.0.0.0 for IP to allow any IP address. Use a path prefixed with
+	// "unix://" to bind to a Unix domain socket instead, such as
+	// "unix:///run/user/1000/dinkur.sock".
 	BindAddress string
+	// Token is the authentication token that clients must send as a bearer
+	// token in the "authorization" metadata of every request. If empty, then
+	// no authentication is required.
+	Token string
+	// TLSCertFile is the path to a PEM-encoded TLS certificate file. The gRPC
+	// API is served over TLS if both TLSCertFile and TLSKeyFile are set.
+	TLSCertFile string
+	// TLSKeyFile is the path to a PEM-encoded TLS private key file belonging
+	// to the TLSCertFile certificate.
+	TLSKeyFile string
+	// MaterializeRecurring enables adding entries from recurring templates
+	// when their scheduled start time is reached. The added entries are
+	// published to any entry stream subscribers.
+	MaterializeRecurring bool
+	// MaterializeInterval is how often to check for recurring entries to add.
+	// Only used if MaterializeRecurring is enabled.
+	MaterializeInterval time.Duration
+	// HeartbeatInterval is how often the daemon stores the time it was last
+	// seen alive in the database, which is used to detect if the computer was
+	// rebooted while an entry was active.
+	HeartbeatInterval time.Duration
+	// AutoStopAfterAFK enables automatically stopping the active entry when
+	// the user has been AFK for this long. The entry is stopped at the time the
+	// user went AFK. Zero disables automatic stopping.
+	AutoStopAfterAFK time.Duration
+	// AutoRestartAfterAFK enables starting a new entry with the same name,
+	// note, tags, and project as the automatically stopped entry when the
+	// user returns. If disabled, the away time is instead left for the user
+	// to resolve. Only used if AutoStopAfterAFK is set.
+	AutoRestartAfterAFK bool
+	// AFK contains the options for the AFK-detector, such as which
+	// AFK-detector hooks to use.
+	AFK afkdetect.Options
 }
 
 // DefaultOptions values are used for any zero values used when creating a new
 // daemon instance.
 var DefaultOptions = Options{
-	BindAddress: "localhost:59122",
+	BindAddress:         "localhost:59122",
+	MaterializeInterval: time.Minute,
+	HeartbeatInterval:   time.Minute,
 }
 
 // Daemon is the Dinkur daemon service interface.
@@ -103,17 +176,37 @@ func NewDaemon(client dinkur.Client, opt Options) Daemon {
 	if opt.BindAddress == "" {
 		opt.BindAddress = DefaultOptions.BindAddress
 	}
+	if opt.MaterializeInterval == 0 {
+		opt.MaterializeInterval = DefaultOptions.MaterializeInterval
+	}
+	if opt.HeartbeatInterval == 0 {
+		opt.HeartbeatInterval = DefaultOptions.HeartbeatInterval
+	}
 	return &daemon{
 		Options:     opt,
 		client:      client,
-		afkDetector: afkdetect.New(),
+		afkDetector: afkdetect.New(opt.AFK),
+		focusObs: &chans.PubSub[dinkur.Focus]{
+			PubTimeoutAfter: 10 * time.Second,
+			OnPubTimeout: func(focus dinkur.Focus) {
+				log.Warn().
+					WithStringer("phase", focus.Phase).
+					Message("Timed out sending focus event.")
+			},
+		},
 	}
 }
 
 type daemon struct {
 	Options
 	dinkurapiv1.UnimplementedEntriesServer
+	dinkurapiv1.UnimplementedProjectsServer
+	dinkurapiv1.UnimplementedTemplatesServer
+	dinkurapiv1.UnimplementedGoalsServer
 	dinkurapiv1.UnimplementedStatusesServer
+	dinkurapiv1.UnimplementedAwayResolutionServer
+	dinkurapiv1.UnimplementedBackupsServer
+	dinkurapiv1.UnimplementedFocusServer
 
 	client     dinkur.Client
 	grpcServer *grpc.Server
@@ -123,6 +216,11 @@ type daemon struct {
 	closeMutex  sync.Mutex
 
 	lastStatus dinkur.EditStatus
+
+	focusMutex  sync.Mutex
+	focus       dinkur.Focus
+	focusCancel context.CancelFunc
+	focusObs    *chans.PubSub[dinkur.Focus]
 }
 
 func (d *daemon) onEntryMutation(ctx context.Context) {
@@ -146,11 +244,27 @@ func (d *daemon) Serve(ctx context.Context) error {
 	if d.grpcServer != nil || d.listener != nil {
 		return ErrAlreadyServing
 	}
-	lis, err := net.Listen("tcp", d.BindAddress)
+	serverOpts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(d.authUnaryInterceptor),
+		grpc.StreamInterceptor(d.authStreamInterceptor),
+	}
+	if d.TLSCertFile != "" || d.TLSKeyFile != "" {
+		if d.TLSCertFile == "" || d.TLSKeyFile == "" {
+			return ErrTLSIncomplete
+		}
+		creds, err := credentials.NewServerTLSFromFile(d.TLSCertFile, d.TLSKeyFile)
+		if err != nil {
+			return fmt.Errorf("load TLS certificate: %w", err)
+		}
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	} else {
+		log.Debug().Message("No TLS certificate set. Serving without TLS.")
+	}
+	lis, err := listen(d.BindAddress)
 	if err != nil {
-		return fmt.Errorf("bind hostname and port: %w", err)
+		return err
 	}
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(serverOpts...)
 	d.listener = lis
 	d.grpcServer = grpcServer
 	defer d.Close()
@@ -159,9 +273,19 @@ func (d *daemon) Serve(ctx context.Context) error {
 		d.Close()
 	}(ctx, d)
 	dinkurapiv1.RegisterEntriesServer(grpcServer, d)
+	dinkurapiv1.RegisterProjectsServer(grpcServer, d)
+	dinkurapiv1.RegisterTemplatesServer(grpcServer, d)
+	dinkurapiv1.RegisterGoalsServer(grpcServer, d)
 	dinkurapiv1.RegisterStatusesServer(grpcServer, d)
+	dinkurapiv1.RegisterAwayResolutionServer(grpcServer, d)
+	dinkurapiv1.RegisterBackupsServer(grpcServer, d)
+	dinkurapiv1.RegisterFocusServer(grpcServer, d)
 	d.updateAFKStatusAsWeAreStarting(ctx)
 	go d.listenForAFK(ctx)
+	go d.storeHeartbeats(ctx)
+	if d.MaterializeRecurring {
+		go d.materializeRecurringEntries(ctx)
+	}
 	if err := d.afkDetector.StartDetecting(); err != nil {
 		return fmt.Errorf("start afk detector: %w", err)
 	}
@@ -171,6 +295,14 @@ func (d *daemon) Serve(ctx context.Context) error {
 func (d *daemon) Close() (finalErr error) {
 	d.closeMutex.Lock()
 	defer d.closeMutex.Unlock()
+	d.focusMutex.Lock()
+	d.cancelFocusTimer()
+	d.focus = dinkur.Focus{}
+	d.focusMutex.Unlock()
+	if err := d.focusObs.UnsubAll(); err != nil {
+		log.Error().WithError(err).Message("Closing focus streams in Dinkur daemon.")
+		finalErr = err
+	}
 	if srv := d.grpcServer; srv != nil {
 		srv.GracefulStop()
 	}
@@ -199,11 +331,36 @@ func (d *daemon) updateAFKStatusAsWeAreStarting(ctx context.Context) {
 		AFKSince:  status.AFKSince,
 		BackSince: status.BackSince,
 	}
+	// Any automatically stopped entry is not restarted here, as the user may
+	// not be at the computer when the daemon starts. It is instead kept in
+	// the status until the AFK-detector reports that the user has returned.
 	entry, err := d.client.GetActiveEntry(ctx)
-	if err != nil || entry == nil {
+	if err != nil {
 		d.markAsNotAFK(ctx)
 		return
 	}
+	if entry == nil {
+		if d.hasPendingAutoStoppedEntry(ctx) {
+			d.markAsReturnedFromAFK(ctx)
+		} else {
+			d.markAsNotAFK(ctx)
+		}
+		return
+	}
+	// The AFK status is set when the daemon is closed gracefully. If it is
+	// not set, then the daemon was killed, such as when the computer was
+	// rebooted, and the last heartbeat is the best guess of when the user left.
+	if d.lastStatus.AFKSince == nil && status.LastSeenAlive != nil &&
+		entry.Start.Before(*status.LastSeenAlive) {
+		log.Info().
+			WithTime("lastSeenAlive", *status.LastSeenAlive).
+			Message("Entry was active while daemon was not running. Marking as AFK since last heartbeat.")
+		d.markAsAFK(ctx, *status.LastSeenAlive)
+	}
+	if untilAutoStop, ok := d.untilAutoStop(); ok && untilAutoStop <= 0 {
+		d.autoStopActiveEntry(ctx)
+		return
+	}
 	d.markAsReturnedFromAFK(ctx)
 }
 
@@ -213,7 +370,64 @@ func (d *daemon) updateAFKStatusAsWeAreClosing() {
 	if err != nil || entry == nil {
 		return
 	}
-	d.markAsAFK(context.Background())
+	d.markAsAFK(context.Background(), time.Now())
+}
+
+// lastSeenAliveSetter is implemented by the database client. It is not part of
+// the dinkur.Client interface, as only the daemon itself should store its own
+// heartbeats, so it is not exposed via the gRPC API either.
+type lastSeenAliveSetter interface {
+	SetLastSeenAlive(ctx context.Context, lastSeenAlive time.Time) error
+}
+
+func (d *daemon) storeHeartbeats(ctx context.Context) {
+	dbClient, ok := d.client.(lastSeenAliveSetter)
+	if !ok {
+		log.Warn().Messagef("Client %T cannot store heartbeats. Reboots will not be detected as AFK.", d.client)
+		return
+	}
+	log.Debug().
+		WithDuration("interval", d.HeartbeatInterval).
+		Message("Storing heartbeats...")
+	ticker := time.NewTicker(d.HeartbeatInterval)
+	defer ticker.Stop()
+	done := ctx.Done()
+	for {
+		if err := dbClient.SetLastSeenAlive(ctx, time.Now()); err != nil {
+			log.Warn().WithError(err).Message("Failed to store heartbeat.")
+		}
+		select {
+		case <-ticker.C:
+		case <-done:
+			return
+		}
+	}
+}
+
+func (d *daemon) materializeRecurringEntries(ctx context.Context) {
+	log.Debug().
+		WithDuration("interval", d.MaterializeInterval).
+		Message("Materializing recurring entries...")
+	ticker := time.NewTicker(d.MaterializeInterval)
+	defer ticker.Stop()
+	done := ctx.Done()
+	for {
+		entries, err := d.client.MaterializeRecurringEntries(ctx, time.Now())
+		if err != nil {
+			log.Warn().WithError(err).Message("Failed to materialize recurring entries.")
+		}
+		for _, entry := range entries {
+			log.Info().
+				WithUint("id", entry.ID).
+				WithString("name", entry.Name).
+				Message("Added recurring entry.")
+		}
+		select {
+		case <-ticker.C:
+		case <-done:
+			return
+		}
+	}
 }
 
 func (d *daemon) listenForAFK(ctx context.Context) {
@@ -222,10 +436,20 @@ func (d *daemon) listenForAFK(ctx context.Context) {
 	stoppedChan := d.afkDetector.StoppedObs().Sub()
 	defer d.afkDetector.StartedObs().Unsub(startedChan)
 	defer d.afkDetector.StoppedObs().Unsub(stoppedChan)
+	var autoStopTimer *time.Timer
+	var autoStopChan <-chan time.Time
+	stopAutoStopTimer := func() {
+		if autoStopTimer != nil {
+			autoStopTimer.Stop()
+			autoStopTimer = nil
+			autoStopChan = nil
+		}
+	}
+	defer stopAutoStopTimer()
 	done := ctx.Done()
 	for {
 		select {
-		case <-startedChan:
+		case ev := <-startedChan:
 			entry, err := d.client.GetActiveEntry(ctx)
 			if err != nil {
 				log.Warn().WithError(err).
@@ -233,12 +457,33 @@ func (d *daemon) listenForAFK(ctx context.Context) {
 				continue
 			}
 			if entry == nil {
-				d.markAsNotAFK(ctx)
+				if d.hasPendingAutoStoppedEntry(ctx) {
+					d.markAsAFK(ctx, ev.AFKSince)
+				} else {
+					d.markAsNotAFK(ctx)
+				}
+				continue
+			}
+			d.markAsAFK(ctx, ev.AFKSince)
+			stopAutoStopTimer()
+			untilAutoStop, ok := d.untilAutoStop()
+			if !ok {
 				continue
 			}
-			d.markAsAFK(ctx)
+			if untilAutoStop <= 0 {
+				// such as when the computer has been suspended
+				d.autoStopActiveEntry(ctx)
+				continue
+			}
+			autoStopTimer = time.NewTimer(untilAutoStop)
+			autoStopChan = autoStopTimer.C
+		case <-autoStopChan:
+			autoStopTimer = nil
+			autoStopChan = nil
+			d.autoStopActiveEntry(ctx)
 		case <-stoppedChan:
-			d.markAsReturnedFromAFK(ctx)
+			stopAutoStopTimer()
+			d.markAsReturnedFromAFKOrRestart(ctx)
 		case <-done:
 			return
 		}
@@ -22,6 +22,7 @@ package dinkurd
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
 	"github.com/dinkur/dinkur/pkg/conv"
@@ -92,6 +93,20 @@ func (d *daemon) GetEntryList(ctx context.Context, req *dinkurapiv1.GetEntryList
 	if req == nil {
 		return nil, convError(ErrRequestIsNil)
 	}
+	search, err := searchEntryFromRequest(req)
+	if err != nil {
+		return nil, convError(err)
+	}
+	entries, err := d.client.GetEntryList(ctx, search)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetEntryListResponse{
+		Entries: togrpc.EntrySlice(entries),
+	}, nil
+}
+
+func searchEntryFromRequest(req *dinkurapiv1.GetEntryListRequest) (dinkur.SearchEntry, error) {
 	search := dinkur.SearchEntry{
 		Start:              fromgrpc.TimePtr(req.Start),
 		End:                fromgrpc.TimePtr(req.End),
@@ -99,18 +114,69 @@ func (d *daemon) GetEntryList(ctx context.Context, req *dinkurapiv1.GetEntryList
 		NameFuzzy:          req.NameFuzzy,
 		NameHighlightStart: req.NameHighlightStart,
 		NameHighlightEnd:   req.NameHighlightEnd,
+		Tags:               req.Tags,
+		ExcludeTags:        req.ExcludeTags,
 	}
 	var err error
 	search.Limit, err = conv.Uint64ToUint(req.Limit)
 	if err != nil {
+		return dinkur.SearchEntry{}, err
+	}
+	search.ProjectIDOrZero, err = conv.Uint64ToUint(req.ProjectIdOrZero)
+	if err != nil {
+		return dinkur.SearchEntry{}, err
+	}
+	return search, nil
+}
+
+func (d *daemon) GetEntrySummary(ctx context.Context, req *dinkurapiv1.GetEntrySummaryRequest) (*dinkurapiv1.GetEntrySummaryResponse, error) {
+	if err := d.assertConnected(); err != nil {
 		return nil, convError(err)
 	}
-	entries, err := d.client.GetEntryList(ctx, search)
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	groupBy, err := fromgrpc.EntrySummaryGroup(req.GroupBy)
 	if err != nil {
 		return nil, convError(err)
 	}
-	return &dinkurapiv1.GetEntryListResponse{
-		Entries: togrpc.EntrySlice(entries),
+	summaries, err := d.client.GetEntrySummary(ctx, dinkur.SearchEntrySummary{
+		Start:     fromgrpc.TimePtr(req.Start),
+		End:       fromgrpc.TimePtr(req.End),
+		Shorthand: fromgrpc.Shorthand(req.Shorthand),
+		GroupBy:   groupBy,
+		TimeZone:  req.TimeZone,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetEntrySummaryResponse{
+		Summaries: togrpc.EntrySummarySlice(summaries),
+	}, nil
+}
+
+func (d *daemon) GetEntryGaps(ctx context.Context, req *dinkurapiv1.GetEntryGapsRequest) (*dinkurapiv1.GetEntryGapsResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	gaps, err := d.client.GetEntryGaps(ctx, dinkur.SearchEntryGaps{
+		Start:          fromgrpc.TimePtr(req.Start),
+		End:            fromgrpc.TimePtr(req.End),
+		Shorthand:      fromgrpc.Shorthand(req.Shorthand),
+		WorkHoursStart: fromgrpc.DurationOrZero(req.WorkHoursStart),
+		WorkHoursEnd:   fromgrpc.DurationOrZero(req.WorkHoursEnd),
+		WorkDays:       fromgrpc.WeekdaySlice(req.WorkDays),
+		MinDuration:    fromgrpc.DurationOrZero(req.MinDuration),
+		TimeZone:       req.TimeZone,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetEntryGapsResponse{
+		Gaps: togrpc.EntryGapSlice(gaps),
 	}, nil
 }
 
@@ -121,31 +187,89 @@ func (d *daemon) CreateEntry(ctx context.Context, req *dinkurapiv1.CreateEntryRe
 	if req == nil {
 		return nil, convError(ErrRequestIsNil)
 	}
-	startAfterID, err := conv.Uint64ToUint(req.StartAfterIdOrZero)
+	newEntry, err := newEntryFromRequest(req)
 	if err != nil {
 		return nil, convError(err)
 	}
-	endBeforeID, err := conv.Uint64ToUint(req.EndBeforeIdOrZero)
+	startedEntry, err := d.client.CreateEntry(ctx, newEntry)
+	if err != nil {
+		return nil, convError(err)
+	}
+	d.onEntryMutation(ctx)
+	return createEntryResponse(startedEntry), nil
+}
+
+func (d *daemon) CreateEntries(ctx context.Context, req *dinkurapiv1.CreateEntriesRequest) (*dinkurapiv1.CreateEntriesResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	newEntries := make([]dinkur.NewEntry, len(req.Entries))
+	for i, entryReq := range req.Entries {
+		if entryReq == nil {
+			return nil, convError(fmt.Errorf("entry %d: %w", i+1, ErrRequestIsNil))
+		}
+		newEntry, err := newEntryFromRequest(entryReq)
+		if err != nil {
+			return nil, convError(fmt.Errorf("entry %d: %w", i+1, err))
+		}
+		newEntries[i] = newEntry
+	}
+	startedEntries, err := d.client.CreateEntries(ctx, newEntries)
 	if err != nil {
 		return nil, convError(err)
 	}
-	newEntry := dinkur.NewEntry{
+	d.onEntryMutation(ctx)
+	res := &dinkurapiv1.CreateEntriesResponse{
+		Entries: make([]*dinkurapiv1.CreateEntryResponse, len(startedEntries)),
+	}
+	for i, startedEntry := range startedEntries {
+		res.Entries[i] = createEntryResponse(startedEntry)
+	}
+	return res, nil
+}
+
+func newEntryFromRequest(req *dinkurapiv1.CreateEntryRequest) (dinkur.NewEntry, error) {
+	startAfterID, err := conv.Uint64ToUint(req.StartAfterIdOrZero)
+	if err != nil {
+		return dinkur.NewEntry{}, err
+	}
+	endBeforeID, err := conv.Uint64ToUint(req.EndBeforeIdOrZero)
+	if err != nil {
+		return dinkur.NewEntry{}, err
+	}
+	projectID, err := conv.Uint64ToUint(req.ProjectIdOrZero)
+	if err != nil {
+		return dinkur.NewEntry{}, err
+	}
+	return dinkur.NewEntry{
 		Name:               req.Name,
+		Note:               req.Note,
 		Start:              fromgrpc.TimePtr(req.Start),
 		End:                fromgrpc.TimePtr(req.End),
 		StartAfterIDOrZero: startAfterID,
 		EndBeforeIDOrZero:  endBeforeID,
 		StartAfterLast:     req.StartAfterLast,
+		Tags:               req.Tags,
+		ProjectIDOrZero:    projectID,
+		Project:            newProjectFromRequest(req.ProjectName, req.ProjectClient),
+	}, nil
+}
+
+func newProjectFromRequest(name, client string) *dinkur.NewProject {
+	if name == "" {
+		return nil
 	}
-	startedEntry, err := d.client.CreateEntry(ctx, newEntry)
-	if err != nil {
-		return nil, convError(err)
-	}
-	d.onEntryMutation(ctx)
+	return &dinkur.NewProject{Name: name, Client: client}
+}
+
+func createEntryResponse(startedEntry dinkur.StartedEntry) *dinkurapiv1.CreateEntryResponse {
 	return &dinkurapiv1.CreateEntryResponse{
 		PreviouslyActiveEntry: togrpc.EntryPtr(startedEntry.Stopped),
 		CreatedEntry:          togrpc.EntryPtr(&startedEntry.Started),
-	}, nil
+	}
 }
 
 func (d *daemon) UpdateEntry(ctx context.Context, req *dinkurapiv1.UpdateEntryRequest) (*dinkurapiv1.UpdateEntryResponse, error) {
@@ -167,6 +291,10 @@ func (d *daemon) UpdateEntry(ctx context.Context, req *dinkurapiv1.UpdateEntryRe
 	if err != nil {
 		return nil, convError(err)
 	}
+	projectID, err := conv.Uint64ToUint(req.ProjectIdOrZero)
+	if err != nil {
+		return nil, convError(err)
+	}
 	edit := dinkur.EditEntry{
 		Name:               conv.ZeroAsNil(req.Name),
 		Start:              fromgrpc.TimePtr(req.Start),
@@ -178,6 +306,15 @@ func (d *daemon) UpdateEntry(ctx context.Context, req *dinkurapiv1.UpdateEntryRe
 		StartAfterIDOrZero: startAfterID,
 		EndBeforeIDOrZero:  endBeforeID,
 		StartAfterLast:     req.StartAfterLast,
+		Tags:               req.Tags,
+		RemoveTags:         req.RemoveTags,
+		ProjectIDOrZero:    projectID,
+		RemoveProject:      req.RemoveProject,
+		Note:               conv.ZeroAsNil(req.Note),
+		AppendNote:         req.AppendNote,
+	}
+	if req.RemoveNote {
+		edit.Note = new(string)
 	}
 	update, err := d.client.UpdateEntry(ctx, edit)
 	if err != nil {
@@ -211,6 +348,75 @@ func (d *daemon) DeleteEntry(ctx context.Context, req *dinkurapiv1.DeleteEntryRe
 	}, nil
 }
 
+func (d *daemon) UpdateEntries(ctx context.Context, req *dinkurapiv1.UpdateEntriesRequest) (*dinkurapiv1.UpdateEntriesResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	if req.Search == nil {
+		return nil, convError(ErrSearchIsNil)
+	}
+	search, err := searchEntryFromRequest(req.Search)
+	if err != nil {
+		return nil, convError(err)
+	}
+	updates, err := d.client.UpdateEntries(ctx, dinkur.BulkEditEntry{
+		Search:         search,
+		Name:           conv.ZeroAsNil(req.Name),
+		ReplaceNameOld: req.ReplaceNameOld,
+		ReplaceNameNew: req.ReplaceNameNew,
+		Shift:          fromgrpc.DurationOrZero(req.Shift),
+		DryRun:         req.DryRun,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	if !req.DryRun {
+		d.onEntryMutation(ctx)
+	}
+	updated := make([]*dinkurapiv1.EntryChange, len(updates))
+	for i := range updates {
+		updated[i] = &dinkurapiv1.EntryChange{
+			Before: togrpc.EntryPtr(&updates[i].Before),
+			After:  togrpc.EntryPtr(&updates[i].After),
+		}
+	}
+	return &dinkurapiv1.UpdateEntriesResponse{
+		Updated: updated,
+	}, nil
+}
+
+func (d *daemon) DeleteEntries(ctx context.Context, req *dinkurapiv1.DeleteEntriesRequest) (*dinkurapiv1.DeleteEntriesResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	if req.Search == nil {
+		return nil, convError(ErrSearchIsNil)
+	}
+	search, err := searchEntryFromRequest(req.Search)
+	if err != nil {
+		return nil, convError(err)
+	}
+	deletedEntries, err := d.client.DeleteEntries(ctx, dinkur.BulkDeleteEntry{
+		Search: search,
+		DryRun: req.DryRun,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	if !req.DryRun {
+		d.onEntryMutation(ctx)
+	}
+	return &dinkurapiv1.DeleteEntriesResponse{
+		DeletedEntries: togrpc.EntrySlice(deletedEntries),
+	}, nil
+}
+
 func (d *daemon) StopActiveEntry(ctx context.Context, req *dinkurapiv1.StopActiveEntryRequest) (*dinkurapiv1.StopActiveEntryResponse, error) {
 	if err := d.assertConnected(); err != nil {
 		return nil, convError(err)
@@ -251,3 +457,146 @@ func (d *daemon) StreamEntry(req *dinkurapiv1.StreamEntryRequest, stream dinkura
 	}
 	return nil
 }
+
+func (d *daemon) Undo(ctx context.Context, req *dinkurapiv1.UndoRequest) (*dinkurapiv1.UndoResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	result, err := d.client.Undo(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	d.onEntryMutation(ctx)
+	return &dinkurapiv1.UndoResponse{
+		Action:  togrpc.JournalAction(result.Action),
+		Changes: togrpc.EntryChangeSlice(result.Changes),
+	}, nil
+}
+
+func (d *daemon) Redo(ctx context.Context, req *dinkurapiv1.RedoRequest) (*dinkurapiv1.RedoResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	result, err := d.client.Redo(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	d.onEntryMutation(ctx)
+	return &dinkurapiv1.RedoResponse{
+		Action:  togrpc.JournalAction(result.Action),
+		Changes: togrpc.EntryChangeSlice(result.Changes),
+	}, nil
+}
+
+func (d *daemon) GetTrashedEntryList(ctx context.Context, req *dinkurapiv1.GetTrashedEntryListRequest) (*dinkurapiv1.GetTrashedEntryListResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	entries, err := d.client.GetTrashedEntryList(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetTrashedEntryListResponse{
+		Entries: togrpc.EntrySlice(entries),
+	}, nil
+}
+
+func (d *daemon) RestoreEntry(ctx context.Context, req *dinkurapiv1.RestoreEntryRequest) (*dinkurapiv1.RestoreEntryResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	id, err := conv.Uint64ToUint(req.Id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	restoredEntry, err := d.client.RestoreEntry(ctx, id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	d.onEntryMutation(ctx)
+	return &dinkurapiv1.RestoreEntryResponse{
+		RestoredEntry: togrpc.EntryPtr(&restoredEntry),
+	}, nil
+}
+
+func (d *daemon) PurgeTrashedEntries(ctx context.Context, req *dinkurapiv1.PurgeTrashedEntriesRequest) (*dinkurapiv1.PurgeTrashedEntriesResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	purgedEntries, err := d.client.PurgeTrashedEntries(ctx, fromgrpc.TimeOrNow(req.DeletedBefore))
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.PurgeTrashedEntriesResponse{
+		PurgedEntries: togrpc.EntrySlice(purgedEntries),
+	}, nil
+}
+
+func (d *daemon) GetEntryOverlaps(ctx context.Context, req *dinkurapiv1.GetEntryOverlapsRequest) (*dinkurapiv1.GetEntryOverlapsResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	if req.Search == nil {
+		return nil, convError(ErrSearchIsNil)
+	}
+	search, err := searchEntryFromRequest(req.Search)
+	if err != nil {
+		return nil, convError(err)
+	}
+	overlaps, err := d.client.GetEntryOverlaps(ctx, search)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetEntryOverlapsResponse{
+		Overlaps: togrpc.EntryOverlapSlice(overlaps),
+	}, nil
+}
+
+func (d *daemon) ResolveEntryOverlaps(ctx context.Context, req *dinkurapiv1.ResolveEntryOverlapsRequest) (*dinkurapiv1.ResolveEntryOverlapsResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	if req.Search == nil {
+		return nil, convError(ErrSearchIsNil)
+	}
+	search, err := searchEntryFromRequest(req.Search)
+	if err != nil {
+		return nil, convError(err)
+	}
+	policy, err := fromgrpc.OverlapPolicy(req.Policy)
+	if err != nil {
+		return nil, convError(err)
+	}
+	changes, err := d.client.ResolveEntryOverlaps(ctx, dinkur.ResolveEntryOverlaps{
+		Search: search,
+		Policy: policy,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	d.onEntryMutation(ctx)
+	return &dinkurapiv1.ResolveEntryOverlapsResponse{
+		Changes: togrpc.EntryChangeSlice(changes),
+	}, nil
+}
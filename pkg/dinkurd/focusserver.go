@@ -0,0 +1,306 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+)
+
+const defaultFocusBreakName = "Break"
+
+func (d *daemon) StartFocus(ctx context.Context, req *dinkurapiv1.StartFocusRequest) (*dinkurapiv1.StartFocusResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	projectID, err := conv.Uint64ToUint(req.ProjectIdOrZero)
+	if err != nil {
+		return nil, convError(err)
+	}
+	cycles, err := conv.Uint64ToUint(req.Cycles)
+	if err != nil {
+		return nil, convError(err)
+	}
+	focus, err := d.startFocus(ctx, dinkur.NewFocus{
+		Name:            req.Name,
+		Tags:            req.Tags,
+		ProjectIDOrZero: projectID,
+		Work:            fromgrpc.DurationOrZero(req.Work),
+		Break:           fromgrpc.DurationOrZero(req.Break),
+		Cycles:          cycles,
+		LogBreaks:       req.LogBreaks,
+		BreakName:       req.BreakName,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.StartFocusResponse{
+		Focus: togrpc.Focus(focus),
+	}, nil
+}
+
+func (d *daemon) StopFocus(ctx context.Context, req *dinkurapiv1.StopFocusRequest) (*dinkurapiv1.StopFocusResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	focus, err := d.stopFocus(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.StopFocusResponse{
+		Focus: togrpc.Focus(focus),
+	}, nil
+}
+
+func (d *daemon) GetFocus(ctx context.Context, req *dinkurapiv1.GetFocusRequest) (*dinkurapiv1.GetFocusResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	d.focusMutex.Lock()
+	focus := d.focus
+	d.focusMutex.Unlock()
+	return &dinkurapiv1.GetFocusResponse{
+		Focus: togrpc.Focus(focus),
+	}, nil
+}
+
+func (d *daemon) StreamFocus(req *dinkurapiv1.StreamFocusRequest, stream dinkurapiv1.Focus_StreamFocusServer) error {
+	if err := d.assertConnected(); err != nil {
+		return convError(err)
+	}
+	if req == nil {
+		return convError(ErrRequestIsNil)
+	}
+	ch := d.focusObs.Sub()
+	defer func() {
+		if err := d.focusObs.Unsub(ch); err != nil {
+			log.Warn().WithError(err).Message("Failed to unsub focus.")
+		}
+	}()
+	done := stream.Context().Done()
+	for {
+		select {
+		case focus, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&dinkurapiv1.StreamFocusResponse{
+				Focus: togrpc.Focus(focus),
+			}); err != nil {
+				return convError(err)
+			}
+		case <-done:
+			return nil
+		}
+	}
+}
+
+func (d *daemon) startFocus(ctx context.Context, newFocus dinkur.NewFocus) (dinkur.Focus, error) {
+	if newFocus.Name == "" {
+		return dinkur.Focus{}, dinkur.ErrEntryNameEmpty
+	}
+	if newFocus.Work <= 0 || newFocus.Break < 0 || newFocus.Cycles == 0 {
+		return dinkur.Focus{}, dinkur.ErrFocusInvalid
+	}
+	if newFocus.BreakName == "" {
+		newFocus.BreakName = defaultFocusBreakName
+	}
+	d.focusMutex.Lock()
+	defer d.focusMutex.Unlock()
+	if d.focus.Phase != dinkur.FocusPhaseIdle {
+		return dinkur.Focus{}, dinkur.ErrFocusActive
+	}
+	now := time.Now()
+	started, err := d.client.CreateEntry(ctx, focusWorkEntry(newFocus, now))
+	if err != nil {
+		return dinkur.Focus{}, fmt.Errorf("start focus work entry: %w", err)
+	}
+	d.focus = dinkur.Focus{
+		Phase:         dinkur.FocusPhaseWork,
+		Name:          newFocus.Name,
+		Cycle:         1,
+		Cycles:        newFocus.Cycles,
+		Work:          newFocus.Work,
+		Break:         newFocus.Break,
+		PhaseStart:    now,
+		PhaseEnd:      now.Add(newFocus.Work),
+		EntryIDOrZero: started.Started.ID,
+	}
+	timerCtx, cancel := context.WithCancel(context.Background())
+	d.focusCancel = cancel
+	go d.runFocusTimer(timerCtx, newFocus)
+	d.focusObs.PubWait(d.focus)
+	return d.focus, nil
+}
+
+func (d *daemon) stopFocus(ctx context.Context) (dinkur.Focus, error) {
+	d.focusMutex.Lock()
+	defer d.focusMutex.Unlock()
+	focus := d.focus
+	if focus.Phase == dinkur.FocusPhaseIdle {
+		return dinkur.Focus{}, dinkur.ErrFocusNotActive
+	}
+	d.cancelFocusTimer()
+	if err := d.stopFocusEntry(ctx, time.Now()); err != nil {
+		log.Warn().WithError(err).Message("Failed to stop focus entry.")
+	}
+	d.focus = dinkur.Focus{}
+	d.focusObs.PubWait(d.focus)
+	return focus, nil
+}
+
+func (d *daemon) cancelFocusTimer() {
+	if d.focusCancel != nil {
+		d.focusCancel()
+		d.focusCancel = nil
+	}
+}
+
+func (d *daemon) runFocusTimer(ctx context.Context, newFocus dinkur.NewFocus) {
+	log.Debug().
+		WithString("name", newFocus.Name).
+		WithDuration("work", newFocus.Work).
+		WithDuration("break", newFocus.Break).
+		WithUint("cycles", newFocus.Cycles).
+		Message("Started focus timer.")
+	d.focusMutex.Lock()
+	phaseEnd := d.focus.PhaseEnd
+	d.focusMutex.Unlock()
+	timer := time.NewTimer(time.Until(phaseEnd))
+	defer timer.Stop()
+	done := ctx.Done()
+	for {
+		select {
+		case <-timer.C:
+		case <-done:
+			return
+		}
+		phaseEnd, ok := d.nextFocusPhase(ctx, newFocus)
+		if !ok {
+			return
+		}
+		timer.Reset(time.Until(phaseEnd))
+	}
+}
+
+// nextFocusPhase moves the focus timer to its next phase, and returns when the
+// new phase ends, or false if the focus timer is done or was stopped.
+func (d *daemon) nextFocusPhase(ctx context.Context, newFocus dinkur.NewFocus) (time.Time, bool) {
+	d.focusMutex.Lock()
+	defer d.focusMutex.Unlock()
+	if ctx.Err() != nil {
+		// stopped while waiting for the lock
+		return time.Time{}, false
+	}
+	focus := d.focus
+	boundary := focus.PhaseEnd
+	if err := d.stopFocusEntry(ctx, boundary); err != nil {
+		log.Warn().WithError(err).Message("Failed to stop focus entry.")
+	}
+	focus.EntryIDOrZero = 0
+	focus.PhaseStart = boundary
+	switch {
+	case focus.Phase == dinkur.FocusPhaseWork && focus.Cycle >= focus.Cycles:
+		log.Info().
+			WithString("name", focus.Name).
+			WithUint("cycles", focus.Cycles).
+			Message("Focus timer is done.")
+		d.focusCancel = nil
+		d.focus = dinkur.Focus{}
+		d.focusObs.PubWait(d.focus)
+		return time.Time{}, false
+	case focus.Phase == dinkur.FocusPhaseWork && focus.Break > 0:
+		focus.Phase = dinkur.FocusPhaseBreak
+		focus.PhaseEnd = boundary.Add(focus.Break)
+		if newFocus.LogBreaks {
+			started, err := d.client.CreateEntry(ctx, dinkur.NewEntry{
+				Name:  newFocus.BreakName,
+				Start: &boundary,
+			})
+			if err != nil {
+				log.Warn().WithError(err).Message("Failed to start focus break entry.")
+			} else {
+				focus.EntryIDOrZero = started.Started.ID
+			}
+		}
+	default:
+		focus.Cycle++
+		focus.Phase = dinkur.FocusPhaseWork
+		focus.PhaseEnd = boundary.Add(focus.Work)
+		started, err := d.client.CreateEntry(ctx, focusWorkEntry(newFocus, boundary))
+		if err != nil {
+			log.Warn().WithError(err).Message("Failed to start focus work entry.")
+		} else {
+			focus.EntryIDOrZero = started.Started.ID
+		}
+	}
+	log.Debug().
+		WithStringer("phase", focus.Phase).
+		WithUint("cycle", focus.Cycle).
+		WithTime("end", focus.PhaseEnd).
+		Message("Focus timer changed phase.")
+	d.focus = focus
+	d.focusObs.PubWait(d.focus)
+	return focus.PhaseEnd, true
+}
+
+// stopFocusEntry stops the active entry, but only if it is the entry started
+// by the focus timer, so that entries started by the user are left as-is.
+func (d *daemon) stopFocusEntry(ctx context.Context, end time.Time) error {
+	if d.focus.EntryIDOrZero == 0 {
+		return nil
+	}
+	active, err := d.client.GetActiveEntry(ctx)
+	if err != nil {
+		return fmt.Errorf("get active entry: %w", err)
+	}
+	if active == nil || active.ID != d.focus.EntryIDOrZero {
+		return nil
+	}
+	if _, err := d.client.StopActiveEntry(ctx, end); err != nil {
+		return fmt.Errorf("stop focus entry: %w", err)
+	}
+	return nil
+}
+
+func focusWorkEntry(newFocus dinkur.NewFocus, start time.Time) dinkur.NewEntry {
+	return dinkur.NewEntry{
+		Name:            newFocus.Name,
+		Tags:            newFocus.Tags,
+		ProjectIDOrZero: newFocus.ProjectIDOrZero,
+		Start:           &start,
+	}
+}
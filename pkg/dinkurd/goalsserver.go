@@ -0,0 +1,174 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+)
+
+func (d *daemon) GetGoal(ctx context.Context, req *dinkurapiv1.GetGoalRequest) (*dinkurapiv1.GetGoalResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	id, err := conv.Uint64ToUint(req.Id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	goal, err := d.client.GetGoal(ctx, id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetGoalResponse{
+		Goal: togrpc.GoalPtr(&goal),
+	}, nil
+}
+
+func (d *daemon) GetGoalList(ctx context.Context, req *dinkurapiv1.GetGoalListRequest) (*dinkurapiv1.GetGoalListResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	goals, err := d.client.GetGoalList(ctx)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetGoalListResponse{
+		Goals: togrpc.GoalSlice(goals),
+	}, nil
+}
+
+func (d *daemon) CreateGoal(ctx context.Context, req *dinkurapiv1.CreateGoalRequest) (*dinkurapiv1.CreateGoalResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	period, err := fromgrpc.GoalPeriod(req.Period)
+	if err != nil {
+		return nil, convError(err)
+	}
+	projectID, err := conv.Uint64ToUint(req.ProjectIdOrZero)
+	if err != nil {
+		return nil, convError(err)
+	}
+	goal, err := d.client.CreateGoal(ctx, dinkur.NewGoal{
+		Period:          period,
+		Target:          fromgrpc.DurationOrZero(req.Target),
+		ProjectIDOrZero: projectID,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.CreateGoalResponse{
+		CreatedGoal: togrpc.GoalPtr(&goal),
+	}, nil
+}
+
+func (d *daemon) UpdateGoal(ctx context.Context, req *dinkurapiv1.UpdateGoalRequest) (*dinkurapiv1.UpdateGoalResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	id, err := conv.Uint64ToUint(req.Id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	projectID, err := conv.Uint64ToUint(req.ProjectIdOrZero)
+	if err != nil {
+		return nil, convError(err)
+	}
+	edit := dinkur.EditGoal{
+		ID:              id,
+		ProjectIDOrZero: projectID,
+		RemoveProject:   req.RemoveProject,
+	}
+	if req.Period != dinkurapiv1.GoalPeriod_GOAL_PERIOD_UNSPECIFIED {
+		period, err := fromgrpc.GoalPeriod(req.Period)
+		if err != nil {
+			return nil, convError(err)
+		}
+		edit.Period = &period
+	}
+	if req.Target != nil {
+		target := req.Target.AsDuration()
+		edit.Target = &target
+	}
+	update, err := d.client.UpdateGoal(ctx, edit)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.UpdateGoalResponse{
+		Before: togrpc.GoalPtr(&update.Before),
+		After:  togrpc.GoalPtr(&update.After),
+	}, nil
+}
+
+func (d *daemon) DeleteGoal(ctx context.Context, req *dinkurapiv1.DeleteGoalRequest) (*dinkurapiv1.DeleteGoalResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	id, err := conv.Uint64ToUint(req.Id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	goal, err := d.client.DeleteGoal(ctx, id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.DeleteGoalResponse{
+		DeletedGoal: togrpc.GoalPtr(&goal),
+	}, nil
+}
+
+func (d *daemon) GetGoalProgress(ctx context.Context, req *dinkurapiv1.GetGoalProgressRequest) (*dinkurapiv1.GetGoalProgressResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	progress, err := d.client.GetGoalProgress(ctx, dinkur.SearchGoalProgress{
+		At:       fromgrpc.TimePtr(req.At),
+		TimeZone: req.TimeZone,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetGoalProgressResponse{
+		Progress: togrpc.GoalProgressSlice(progress),
+	}, nil
+}
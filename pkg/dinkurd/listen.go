@@ -0,0 +1,95 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Errors specific to listening on Unix domain sockets.
+var (
+	ErrSocketInUse     = errors.New("socket is already in use by another process")
+	ErrNotASocket      = errors.New("file exists but is not a socket")
+	ErrUnsafeSocketDir = errors.New("socket directory is accessible by other users")
+)
+
+const unixAddressPrefix = "unix://"
+
+// listen binds to a TCP/IP address, or to a Unix domain socket if the address
+// is prefixed with "unix://".
+func listen(addr string) (net.Listener, error) {
+	path, ok := strings.CutPrefix(addr, unixAddressPrefix)
+	if !ok {
+		lis, err := net.Listen("tcp", addr)
+		if err != nil {
+			return nil, fmt.Errorf("bind hostname and port: %w", err)
+		}
+		return lis, nil
+	}
+	lis, err := listenUnix(path)
+	if err != nil {
+		return nil, fmt.Errorf("bind unix socket: %w", err)
+	}
+	return lis, nil
+}
+
+// listenUnix creates a Unix domain socket only accessible by the current user.
+// Any stale socket left behind by a previous daemon that did not shut down
+// gracefully is removed first. The socket file is removed when the listener
+// is closed.
+func listenUnix(path string) (net.Listener, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	if err := checkSocketDir(dir); err != nil {
+		return nil, err
+	}
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+	return listenUnixPrivate(path)
+}
+
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode()&fs.ModeSocket == 0 {
+		return fmt.Errorf("%w: %s", ErrNotASocket, path)
+	}
+	conn, err := net.Dial("unix", path)
+	if err == nil {
+		conn.Close()
+		return fmt.Errorf("%w: %s", ErrSocketInUse, path)
+	}
+	log.Info().WithString("path", path).Message("Removing stale socket.")
+	return os.Remove(path)
+}
@@ -0,0 +1,36 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build !unix
+
+package dinkurd
+
+import "net"
+
+// listenUnixPrivate creates the socket. There is no umask outside of Unix, so
+// the socket gets the default permissions of the directory it is created in.
+func listenUnixPrivate(path string) (net.Listener, error) {
+	return net.Listen("unix", path)
+}
+
+// checkSocketDir does nothing outside of Unix, where the directory is instead
+// protected by its access control list.
+func checkSocketDir(string) error {
+	return nil
+}
@@ -0,0 +1,63 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build unix
+
+package dinkurd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+)
+
+// listenUnixPrivate creates the socket while the umask only allows the current
+// user to access it, so there is no window between creating the socket and
+// changing its permissions where other users could connect to it. The umask is
+// process-wide, which is fine as the daemon only does this once on startup.
+func listenUnixPrivate(path string) (net.Listener, error) {
+	oldMask := syscall.Umask(0177)
+	defer syscall.Umask(oldMask)
+	return net.Listen("unix", path)
+}
+
+// checkSocketDir refuses to use a directory that is not owned by the current
+// user, or that other users may write to, as they could then replace the
+// socket with their own.
+func checkSocketDir(dir string) error {
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%w: %s is not a directory", ErrUnsafeSocketDir, dir)
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fmt.Errorf("%w: cannot get owner of %s", ErrUnsafeSocketDir, dir)
+	}
+	if int(stat.Uid) != os.Getuid() {
+		return fmt.Errorf("%w: %s is owned by user ID %d", ErrUnsafeSocketDir, dir, stat.Uid)
+	}
+	if info.Mode().Perm()&0022 != 0 {
+		return fmt.Errorf("%w: %s is writable by group or others (%s)", ErrUnsafeSocketDir, dir, info.Mode().Perm())
+	}
+	return nil
+}
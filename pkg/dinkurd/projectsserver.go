@@ -0,0 +1,137 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+	"gopkg.in/typ.v4"
+)
+
+func (d *daemon) GetProject(ctx context.Context, req *dinkurapiv1.GetProjectRequest) (*dinkurapiv1.GetProjectResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	id, err := conv.Uint64ToUint(req.Id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	project, err := d.client.GetProject(ctx, id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetProjectResponse{
+		Project: togrpc.ProjectPtr(&project),
+	}, nil
+}
+
+func (d *daemon) GetProjectList(ctx context.Context, req *dinkurapiv1.GetProjectListRequest) (*dinkurapiv1.GetProjectListResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	projects, err := d.client.GetProjectList(ctx, dinkur.SearchProject{
+		Name:   req.Name,
+		Client: req.Client,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetProjectListResponse{
+		Projects: togrpc.ProjectSlice(projects),
+	}, nil
+}
+
+func (d *daemon) CreateProject(ctx context.Context, req *dinkurapiv1.CreateProjectRequest) (*dinkurapiv1.CreateProjectResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	project, err := d.client.CreateProject(ctx, dinkur.NewProject{
+		Name:   req.Name,
+		Client: req.Client,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.CreateProjectResponse{
+		CreatedProject: togrpc.ProjectPtr(&project),
+	}, nil
+}
+
+func (d *daemon) UpdateProject(ctx context.Context, req *dinkurapiv1.UpdateProjectRequest) (*dinkurapiv1.UpdateProjectResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	id, err := conv.Uint64ToUint(req.Id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	edit := dinkur.EditProject{
+		ID:     id,
+		Name:   conv.ZeroAsNil(req.Name),
+		Client: conv.ZeroAsNil(req.Client),
+	}
+	if req.RemoveClient {
+		edit.Client = typ.Ref("")
+	}
+	update, err := d.client.UpdateProject(ctx, edit)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.UpdateProjectResponse{
+		Before: togrpc.ProjectPtr(&update.Before),
+		After:  togrpc.ProjectPtr(&update.After),
+	}, nil
+}
+
+func (d *daemon) DeleteProject(ctx context.Context, req *dinkurapiv1.DeleteProjectRequest) (*dinkurapiv1.DeleteProjectResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	id, err := conv.Uint64ToUint(req.Id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	project, err := d.client.DeleteProject(ctx, id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.DeleteProjectResponse{
+		DeletedProject: togrpc.ProjectPtr(&project),
+	}, nil
+}
@@ -21,6 +21,7 @@ package dinkurd
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
@@ -115,7 +116,39 @@ func (d *daemon) markAsReturnedFromAFK(ctx context.Context) {
 	d.lastStatus = newStatus
 }
 
-func (d *daemon) markAsAFK(ctx context.Context) {
+// markAsReturnedFromAFKOrRestart restarts the automatically stopped entry, if
+// any and if enabled, or otherwise marks the user as returned from being AFK.
+func (d *daemon) markAsReturnedFromAFKOrRestart(ctx context.Context) {
+	if d.AutoRestartAfterAFK {
+		status, err := d.client.GetStatus(ctx)
+		if err != nil {
+			log.Warn().WithError(err).
+				Message("Failed to get status when marking as returned from AFK.")
+		} else if status.AutoStoppedEntryIDOrZero != 0 {
+			d.restartAutoStoppedEntry(ctx, status.AutoStoppedEntryIDOrZero)
+			return
+		}
+	}
+	d.markAsReturnedFromAFK(ctx)
+}
+
+// hasPendingAutoStoppedEntry reports whether there is an automatically stopped
+// entry that is not to be restarted automatically. The AFK status must then be
+// kept, so the user can resolve the away time of that entry.
+func (d *daemon) hasPendingAutoStoppedEntry(ctx context.Context) bool {
+	if d.AutoRestartAfterAFK {
+		return false
+	}
+	status, err := d.client.GetStatus(ctx)
+	if err != nil {
+		log.Warn().WithError(err).
+			Message("Failed to get status when checking for automatically stopped entry.")
+		return false
+	}
+	return status.AutoStoppedEntryIDOrZero != 0
+}
+
+func (d *daemon) markAsAFK(ctx context.Context, afkSince time.Time) {
 	lastStatus := d.lastStatus
 	if lastStatus.AFKSince != nil && lastStatus.BackSince == nil {
 		return
@@ -125,8 +158,130 @@ func (d *daemon) markAsAFK(ctx context.Context) {
 		BackSince: nil,
 	}
 	if newStatus.AFKSince == nil {
-		newStatus.AFKSince = typ.Ref(time.Now())
+		newStatus.AFKSince = typ.Ref(afkSince)
 	}
 	d.client.SetStatus(ctx, newStatus)
 	d.lastStatus = newStatus
 }
+
+// untilAutoStop returns the time left until the active entry should be
+// automatically stopped, which is negative if it should already have been
+// stopped, or false if automatic stopping is disabled or the user is not AFK.
+func (d *daemon) untilAutoStop() (time.Duration, bool) {
+	afkSince := d.lastStatus.AFKSince
+	if d.AutoStopAfterAFK <= 0 || afkSince == nil || d.lastStatus.BackSince != nil {
+		return 0, false
+	}
+	return d.AutoStopAfterAFK - time.Since(*afkSince), true
+}
+
+// autoStoppedEntrySetter is implemented by the database client. Like
+// lastSeenAliveSetter, it is not part of the dinkur.Client interface, as only
+// the daemon itself should decide which entry it has automatically stopped.
+type autoStoppedEntrySetter interface {
+	SetAutoStoppedEntry(ctx context.Context, entryIDOrZero uint) error
+}
+
+func (d *daemon) setAutoStoppedEntry(ctx context.Context, entryIDOrZero uint) error {
+	dbClient, ok := d.client.(autoStoppedEntrySetter)
+	if !ok {
+		return fmt.Errorf("client %T cannot store the automatically stopped entry", d.client)
+	}
+	return dbClient.SetAutoStoppedEntry(ctx, entryIDOrZero)
+}
+
+// autoStopActiveEntry stops the active entry at the time the user went AFK,
+// and stores the stopped entry in the status. If automatic restarts are
+// enabled, the entry is restarted when the user returns, and the time spent AFK
+// is discarded by marking the user as not AFK. Otherwise the AFK status is
+// kept, so the user is asked how to resolve the away time upon returning.
+func (d *daemon) autoStopActiveEntry(ctx context.Context) {
+	afkSince := d.lastStatus.AFKSince
+	if afkSince == nil {
+		return
+	}
+	activeEntry, err := d.client.GetActiveEntry(ctx)
+	if err != nil {
+		log.Warn().WithError(err).
+			Message("Failed to get active entry when automatically stopping it.")
+		return
+	}
+	if activeEntry == nil {
+		d.markAsNotAFK(ctx)
+		return
+	}
+	end := *afkSince
+	if end.Before(activeEntry.Start) {
+		end = activeEntry.Start
+	}
+	stoppedEntry, err := d.client.StopActiveEntry(ctx, end)
+	if err != nil {
+		log.Warn().WithError(err).
+			Message("Failed to automatically stop active entry.")
+		return
+	}
+	if stoppedEntry == nil {
+		d.markAsNotAFK(ctx)
+		return
+	}
+	log.Info().
+		WithUint("id", stoppedEntry.ID).
+		WithString("name", stoppedEntry.Name).
+		WithTime("end", end).
+		Message("Automatically stopped active entry after being AFK.")
+	if err := d.setAutoStoppedEntry(ctx, stoppedEntry.ID); err != nil {
+		log.Warn().WithError(err).
+			Message("Failed to store automatically stopped entry in status.")
+	}
+	if d.AutoRestartAfterAFK {
+		d.markAsNotAFK(ctx)
+	}
+}
+
+// restartAutoStoppedEntry starts a new entry with the same name, note, tags,
+// and project as the automatically stopped entry, if no other entry has been
+// started since. The stopped entry is then cleared from the status.
+func (d *daemon) restartAutoStoppedEntry(ctx context.Context, entryID uint) {
+	defer func() {
+		if err := d.setAutoStoppedEntry(ctx, 0); err != nil {
+			log.Warn().WithError(err).
+				Message("Failed to clear automatically stopped entry from status.")
+		}
+	}()
+	activeEntry, err := d.client.GetActiveEntry(ctx)
+	if err != nil {
+		log.Warn().WithError(err).
+			Message("Failed to get active entry when restarting automatically stopped entry.")
+		return
+	}
+	if activeEntry != nil {
+		log.Debug().WithUint("id", activeEntry.ID).
+			Message("Another entry is active. Skipping restart of automatically stopped entry.")
+		return
+	}
+	entry, err := d.client.GetEntry(ctx, entryID)
+	if err != nil {
+		log.Warn().WithError(err).WithUint("id", entryID).
+			Message("Failed to get automatically stopped entry.")
+		return
+	}
+	newEntry := dinkur.NewEntry{
+		Name:  entry.Name,
+		Note:  entry.Note,
+		Tags:  entry.Tags,
+		Start: typ.Ref(time.Now()),
+	}
+	if entry.Project != nil {
+		newEntry.ProjectIDOrZero = entry.Project.ID
+	}
+	startedEntry, err := d.client.CreateEntry(ctx, newEntry)
+	if err != nil {
+		log.Warn().WithError(err).WithUint("id", entryID).
+			Message("Failed to restart automatically stopped entry.")
+		return
+	}
+	log.Info().
+		WithUint("id", startedEntry.Started.ID).
+		WithString("name", startedEntry.Started.Name).
+		Message("Restarted automatically stopped entry after returning from AFK.")
+}
@@ -0,0 +1,174 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build fts5
+
+package dinkurd
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/dinkurdb"
+	"gopkg.in/typ.v4"
+)
+
+func newTestDaemon(t *testing.T, opt Options) *daemon {
+	t.Helper()
+	client := dinkurdb.NewClient(filepath.Join(t.TempDir(), "dinkur.db"), dinkurdb.Options{})
+	if err := client.Connect(context.Background()); err != nil {
+		t.Fatalf("connect: %s", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return NewDaemon(client, opt).(*daemon)
+}
+
+// startAndAutoStopEntry starts an entry an hour ago, marks the user as AFK
+// since half an hour ago, and then lets the daemon automatically stop it.
+func startAndAutoStopEntry(t *testing.T, d *daemon) (dinkur.Entry, time.Time) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Now()
+	started, err := d.client.CreateEntry(ctx, dinkur.NewEntry{
+		Name:  "work",
+		Note:  "some note",
+		Start: typ.Ref(now.Add(-time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("create entry: %s", err)
+	}
+	afkSince := now.Add(-30 * time.Minute)
+	d.markAsAFK(ctx, afkSince)
+	d.autoStopActiveEntry(ctx)
+
+	activeEntry, err := d.client.GetActiveEntry(ctx)
+	if err != nil {
+		t.Fatalf("get active entry: %s", err)
+	}
+	if activeEntry != nil {
+		t.Fatalf("want no active entry after automatic stop, got #%d", activeEntry.ID)
+	}
+	status := getTestStatus(t, d)
+	if status.AutoStoppedEntryIDOrZero != started.Started.ID {
+		t.Fatalf("want auto-stopped entry #%d, got #%d", started.Started.ID, status.AutoStoppedEntryIDOrZero)
+	}
+	return started.Started, afkSince
+}
+
+func getTestStatus(t *testing.T, d *daemon) dinkur.Status {
+	t.Helper()
+	status, err := d.client.GetStatus(context.Background())
+	if err != nil {
+		t.Fatalf("get status: %s", err)
+	}
+	return status
+}
+
+func TestAutoStopWithAutoRestart(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDaemon(t, Options{
+		AutoStopAfterAFK:    time.Minute,
+		AutoRestartAfterAFK: true,
+	})
+	stopped, _ := startAndAutoStopEntry(t, d)
+	if status := getTestStatus(t, d); status.AFKSince != nil {
+		t.Errorf("want AFK status cleared, got AFK since %s", status.AFKSince)
+	}
+
+	d.markAsReturnedFromAFKOrRestart(ctx)
+
+	activeEntry, err := d.client.GetActiveEntry(ctx)
+	if err != nil {
+		t.Fatalf("get active entry: %s", err)
+	}
+	if activeEntry == nil {
+		t.Fatal("want restarted entry, got no active entry")
+	}
+	if activeEntry.ID == stopped.ID {
+		t.Errorf("want new entry, got the stopped entry #%d", stopped.ID)
+	}
+	if activeEntry.Name != stopped.Name || activeEntry.Note != stopped.Note {
+		t.Errorf("want name %q and note %q, got name %q and note %q",
+			stopped.Name, stopped.Note, activeEntry.Name, activeEntry.Note)
+	}
+	if status := getTestStatus(t, d); status.AutoStoppedEntryIDOrZero != 0 {
+		t.Errorf("want auto-stopped entry cleared, got #%d", status.AutoStoppedEntryIDOrZero)
+	}
+	pending, err := d.client.GetPendingAway(ctx)
+	if err != nil {
+		t.Fatalf("get pending away: %s", err)
+	}
+	if pending != nil {
+		t.Errorf("want no pending away time, got away since %s", pending.AFKSince)
+	}
+}
+
+func TestAutoStopWithoutAutoRestart(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDaemon(t, Options{
+		AutoStopAfterAFK:    time.Minute,
+		AutoRestartAfterAFK: false,
+	})
+	stopped, afkSince := startAndAutoStopEntry(t, d)
+	if status := getTestStatus(t, d); status.AFKSince == nil {
+		t.Error("want AFK status kept, got not AFK")
+	}
+
+	d.markAsReturnedFromAFKOrRestart(ctx)
+
+	activeEntry, err := d.client.GetActiveEntry(ctx)
+	if err != nil {
+		t.Fatalf("get active entry: %s", err)
+	}
+	if activeEntry != nil {
+		t.Fatalf("want no restarted entry, got #%d", activeEntry.ID)
+	}
+	pending, err := d.client.GetPendingAway(ctx)
+	if err != nil {
+		t.Fatalf("get pending away: %s", err)
+	}
+	if pending == nil {
+		t.Fatal("want pending away time, got none")
+	}
+	if pending.ActiveEntry.ID != stopped.ID {
+		t.Errorf("want pending away entry #%d, got #%d", stopped.ID, pending.ActiveEntry.ID)
+	}
+	if !pending.AFKSince.Equal(afkSince) {
+		t.Errorf("want pending away since %s, got %s", afkSince, pending.AFKSince)
+	}
+
+	if _, err := d.client.ResolveAway(ctx, dinkur.ResolveAway{Action: dinkur.AwayActionKeep}); err != nil {
+		t.Fatalf("resolve away: %s", err)
+	}
+	activeEntry, err = d.client.GetActiveEntry(ctx)
+	if err != nil {
+		t.Fatalf("get active entry: %s", err)
+	}
+	if activeEntry == nil || activeEntry.ID != stopped.ID {
+		t.Fatalf("want entry #%d active again after keeping away time, got %v", stopped.ID, activeEntry)
+	}
+	status := getTestStatus(t, d)
+	if status.AutoStoppedEntryIDOrZero != 0 || status.AFKSince != nil {
+		t.Errorf("want status cleared, got auto-stopped entry #%d and AFK since %v",
+			status.AutoStoppedEntryIDOrZero, status.AFKSince)
+	}
+}
@@ -0,0 +1,160 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurd
+
+import (
+	"context"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromgrpc"
+	"github.com/dinkur/dinkur/pkg/togrpc"
+)
+
+func (d *daemon) GetTemplate(ctx context.Context, req *dinkurapiv1.GetTemplateRequest) (*dinkurapiv1.GetTemplateResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	id, err := conv.Uint64ToUint(req.Id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	template, err := d.client.GetTemplate(ctx, id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetTemplateResponse{
+		Template: togrpc.TemplatePtr(&template),
+	}, nil
+}
+
+func (d *daemon) GetTemplateList(ctx context.Context, req *dinkurapiv1.GetTemplateListRequest) (*dinkurapiv1.GetTemplateListResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	templates, err := d.client.GetTemplateList(ctx, dinkur.SearchTemplate{
+		Name: req.Name,
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.GetTemplateListResponse{
+		Templates: togrpc.TemplateSlice(templates),
+	}, nil
+}
+
+func (d *daemon) CreateTemplate(ctx context.Context, req *dinkurapiv1.CreateTemplateRequest) (*dinkurapiv1.CreateTemplateResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	template, err := d.client.CreateTemplate(ctx, dinkur.NewTemplate{
+		Name:       req.Name,
+		EntryName:  req.EntryName,
+		Duration:   fromgrpc.DurationOrZero(req.Duration),
+		Tags:       req.Tags,
+		Recurrence: fromgrpc.RecurrencePtr(req.Recurrence),
+	})
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.CreateTemplateResponse{
+		CreatedTemplate: togrpc.TemplatePtr(&template),
+	}, nil
+}
+
+func (d *daemon) UpdateTemplate(ctx context.Context, req *dinkurapiv1.UpdateTemplateRequest) (*dinkurapiv1.UpdateTemplateResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	id, err := conv.Uint64ToUint(req.Id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	edit := dinkur.EditTemplate{
+		ID:               id,
+		Name:             conv.ZeroAsNil(req.Name),
+		EntryName:        conv.ZeroAsNil(req.EntryName),
+		Tags:             req.Tags,
+		RemoveTags:       req.RemoveTags,
+		Recurrence:       fromgrpc.RecurrencePtr(req.Recurrence),
+		RemoveRecurrence: req.RemoveRecurrence,
+	}
+	if req.Duration != nil {
+		duration := req.Duration.AsDuration()
+		edit.Duration = &duration
+	}
+	update, err := d.client.UpdateTemplate(ctx, edit)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.UpdateTemplateResponse{
+		Before: togrpc.TemplatePtr(&update.Before),
+		After:  togrpc.TemplatePtr(&update.After),
+	}, nil
+}
+
+func (d *daemon) DeleteTemplate(ctx context.Context, req *dinkurapiv1.DeleteTemplateRequest) (*dinkurapiv1.DeleteTemplateResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	id, err := conv.Uint64ToUint(req.Id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	template, err := d.client.DeleteTemplate(ctx, id)
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.DeleteTemplateResponse{
+		DeletedTemplate: togrpc.TemplatePtr(&template),
+	}, nil
+}
+
+func (d *daemon) MaterializeRecurringEntries(ctx context.Context, req *dinkurapiv1.MaterializeRecurringEntriesRequest) (*dinkurapiv1.MaterializeRecurringEntriesResponse, error) {
+	if err := d.assertConnected(); err != nil {
+		return nil, convError(err)
+	}
+	if req == nil {
+		return nil, convError(ErrRequestIsNil)
+	}
+	entries, err := d.client.MaterializeRecurringEntries(ctx, fromgrpc.TimeOrNow(req.Until))
+	if err != nil {
+		return nil, convError(err)
+	}
+	return &dinkurapiv1.MaterializeRecurringEntriesResponse{
+		Entries: togrpc.EntrySlice(entries),
+	}, nil
+}
@@ -0,0 +1,333 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromdb"
+	"gopkg.in/typ.v4/slices"
+)
+
+func (c *client) GetPendingAway(ctx context.Context) (*dinkur.PendingAway, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	pending, err := c.withContext(ctx).pendingDBAway()
+	if err != nil || pending == nil {
+		return nil, err
+	}
+	return fromPendingDBAway(*pending), nil
+}
+
+func (c *client) StreamPendingAway(ctx context.Context) (<-chan dinkur.StreamedPendingAway, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	pending, err := c.withContext(ctx).pendingDBAway()
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan dinkur.StreamedPendingAway)
+	go func() {
+		done := ctx.Done()
+		dbStatusChan := c.statusObs.Sub()
+		defer close(ch)
+		defer func() {
+			if err := c.statusObs.Unsub(dbStatusChan); err != nil {
+				log.Warn().WithError(err).Message("Failed to unsub status.")
+			}
+		}()
+		wasPending := pending != nil
+		for {
+			select {
+			case ev, ok := <-dbStatusChan:
+				if !ok {
+					return
+				}
+				pending, err := c.withContext(ctx).pendingDBAwayFromStatus(ev.dbStatus)
+				if err != nil {
+					log.Warn().WithError(err).Message("Failed to get pending away time.")
+					continue
+				}
+				switch {
+				case pending != nil && !wasPending:
+					ch <- dinkur.StreamedPendingAway{
+						PendingAway: fromPendingDBAway(*pending),
+						Event:       dinkur.EventCreated,
+					}
+				case pending == nil && wasPending:
+					ch <- dinkur.StreamedPendingAway{
+						Event: dinkur.EventDeleted,
+					}
+				}
+				wasPending = pending != nil
+			case <-done:
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+type pendingDBAway struct {
+	afkSince    time.Time
+	backSince   time.Time
+	activeEntry dbmodel.Entry
+}
+
+func fromPendingDBAway(pending pendingDBAway) *dinkur.PendingAway {
+	return &dinkur.PendingAway{
+		AFKSince:    pending.afkSince.Local(),
+		BackSince:   pending.backSince.Local(),
+		ActiveEntry: fromdb.Entry(pending.activeEntry),
+	}
+}
+
+func (c *client) pendingDBAway() (*pendingDBAway, error) {
+	dbStatus, err := c.getDBStatusAtom()
+	if err != nil {
+		return nil, err
+	}
+	return c.pendingDBAwayFromStatus(dbStatus)
+}
+
+func (c *client) pendingDBAwayFromStatus(dbStatus dbmodel.Status) (*pendingDBAway, error) {
+	if dbStatus.AFKSince == nil || dbStatus.BackSince == nil {
+		return nil, nil
+	}
+	activeDBEntry, err := c.activeDBEntry()
+	if err != nil {
+		return nil, err
+	}
+	if activeDBEntry == nil {
+		// The daemon may have stopped the entry when the user had been AFK for
+		// too long, in which case the away time is resolved using that entry.
+		activeDBEntry, err = c.autoStoppedDBEntry(dbStatus)
+		if err != nil || activeDBEntry == nil {
+			return nil, err
+		}
+	}
+	return &pendingDBAway{
+		afkSince:    dbStatus.AFKSince.UTC(),
+		backSince:   dbStatus.BackSince.UTC(),
+		activeEntry: *activeDBEntry,
+	}, nil
+}
+
+func (c *client) autoStoppedDBEntry(dbStatus dbmodel.Status) (*dbmodel.Entry, error) {
+	if dbStatus.AutoStoppedEntryID == nil {
+		return nil, nil
+	}
+	var dbEntry dbmodel.Entry
+	err := c.preloadDBEntry().First(&dbEntry, *dbStatus.AutoStoppedEntryID).Error
+	if err != nil {
+		return nil, nilNotFoundError(err)
+	}
+	return &dbEntry, nil
+}
+
+func (c *client) ResolveAway(ctx context.Context, resolve dinkur.ResolveAway) (dinkur.ResolvedAway, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.ResolvedAway{}, err
+	}
+	if err := validateResolveAway(resolve); err != nil {
+		return dinkur.ResolvedAway{}, err
+	}
+	resolved, err := c.withContext(ctx).resolveDBAway(resolve)
+	if err != nil {
+		return dinkur.ResolvedAway{}, err
+	}
+	if resolved.stopped != nil {
+		c.entryObs.PubWait(entryEvent{
+			dbEntry: *resolved.stopped,
+			event:   dinkur.EventUpdated,
+		})
+	}
+	if resolved.reopened != nil {
+		c.entryObs.PubWait(entryEvent{
+			dbEntry: *resolved.reopened,
+			event:   dinkur.EventUpdated,
+		})
+	}
+	for _, startedEntry := range resolved.started {
+		c.pubStartedDBEntry(startedEntry)
+	}
+	if resolved.statusChanged {
+		c.statusObs.PubWait(statusEvent{resolved.dbStatus})
+	}
+	return dinkur.ResolvedAway{
+		Stopped: fromdb.EntryPtr(resolved.stopped),
+		Created: slices.Map(resolved.started, func(startedEntry startedDBEntry) dinkur.Entry {
+			return fromdb.Entry(startedEntry.started)
+		}),
+	}, nil
+}
+
+type resolvedDBAway struct {
+	stopped       *dbmodel.Entry
+	reopened      *dbmodel.Entry
+	started       []startedDBEntry
+	dbStatus      dbmodel.Status
+	statusChanged bool
+}
+
+func (c *client) resolveDBAway(resolve dinkur.ResolveAway) (resolvedDBAway, error) {
+	var resolved resolvedDBAway
+	err := c.transaction(func(tx *client) error {
+		var err error
+		resolved, err = tx.resolveDBAwayNoTran(resolve)
+		return err
+	})
+	return resolved, err
+}
+
+func (c *client) resolveDBAwayNoTran(resolve dinkur.ResolveAway) (resolvedDBAway, error) {
+	pending, err := c.pendingDBAway()
+	if err != nil {
+		return resolvedDBAway{}, fmt.Errorf("get pending away time: %w", err)
+	}
+	if pending == nil {
+		return resolvedDBAway{}, dinkur.ErrAwayNotPending
+	}
+	var resolved resolvedDBAway
+	// An automatically stopped entry has already been stopped at the time the
+	// user went away, so it is instead reopened if the away time is kept.
+	autoStopped := pending.activeEntry.End != nil
+	switch {
+	case resolve.Action == dinkur.AwayActionKeep && autoStopped:
+		reopened, err := c.reopenDBEntryNoTran(pending.activeEntry)
+		if err != nil {
+			return resolvedDBAway{}, fmt.Errorf("reopen automatically stopped entry: %w", err)
+		}
+		resolved.reopened = &reopened.after
+		if err := c.appendJournalUpdateNoTran(reopened); err != nil {
+			return resolvedDBAway{}, err
+		}
+	case resolve.Action != dinkur.AwayActionKeep:
+		var changes []journalChange
+		stoppedAt := pending.afkSince
+		if stoppedAt.Before(pending.activeEntry.Start) {
+			stoppedAt = pending.activeEntry.Start
+		}
+		if autoStopped {
+			stoppedAt = pending.activeEntry.End.UTC()
+		} else {
+			resolved.stopped, err = c.stopActiveDBEntryNoTran(stoppedAt)
+			if err != nil {
+				return resolvedDBAway{}, fmt.Errorf("stop active entry: %w", err)
+			}
+			changes = append(changes, stoppedJournalChange(*resolved.stopped))
+		}
+		start := stoppedAt
+		for i, part := range resolve.Parts {
+			if resolve.Action == dinkur.AwayActionSaveAsNew {
+				part.End = nil
+			}
+			if part.End != nil && !part.End.After(start) {
+				return resolvedDBAway{}, fmt.Errorf("%w: part %d %q must end after %s",
+					dinkur.ErrAwayInvalid, i+1, part.Name, start.Local().Format(time.RFC3339))
+			}
+			newEntry, err := newDBEntry(dinkur.NewEntry{
+				Name:            part.Name,
+				Tags:            part.Tags,
+				ProjectIDOrZero: part.ProjectIDOrZero,
+				Start:           &start,
+				End:             part.End,
+			})
+			if err != nil {
+				return resolvedDBAway{}, fmt.Errorf("part %d %q: %w", i+1, part.Name, err)
+			}
+			startedEntry, err := c.startDBEntryNoTran(newEntry)
+			if err != nil {
+				return resolvedDBAway{}, fmt.Errorf("part %d %q: %w", i+1, part.Name, err)
+			}
+			resolved.started = append(resolved.started, startedEntry)
+			changes = append(changes, startedJournalChanges(startedEntry)...)
+			if part.End != nil {
+				start = part.End.UTC()
+			}
+		}
+		action := dbmodel.JournalActionStop
+		if len(resolved.started) > 0 {
+			action = dbmodel.JournalActionCreate
+		}
+		if len(changes) > 0 {
+			if err := c.appendJournalNoTran(action, nil, changes); err != nil {
+				return resolvedDBAway{}, err
+			}
+		}
+	}
+	resolved.dbStatus, resolved.statusChanged, err = c.setDBStatusNoTran(dinkur.EditStatus{})
+	if err != nil {
+		return resolvedDBAway{}, fmt.Errorf("clear status: %w", err)
+	}
+	if autoStopped {
+		resolved.dbStatus, _, err = c.setDBStatusAutoStoppedEntryNoTran(0)
+		if err != nil {
+			return resolvedDBAway{}, fmt.Errorf("clear automatically stopped entry: %w", err)
+		}
+		resolved.statusChanged = true
+	}
+	return resolved, nil
+}
+
+func (c *client) reopenDBEntryNoTran(dbEntry dbmodel.Entry) (updatedDBEntry, error) {
+	update := updatedDBEntry{before: dbEntry, after: dbEntry}
+	update.after.End = nil
+	err := c.db.Model(&update.after).
+		Update(dbmodel.EntryFieldEnd, nil).
+		Error
+	if err != nil {
+		return updatedDBEntry{}, err
+	}
+	return update, nil
+}
+
+func validateResolveAway(resolve dinkur.ResolveAway) error {
+	switch resolve.Action {
+	case dinkur.AwayActionKeep, dinkur.AwayActionDiscard:
+		if len(resolve.Parts) > 0 {
+			return fmt.Errorf("%w: action %q does not use any parts", dinkur.ErrAwayInvalid, resolve.Action)
+		}
+	case dinkur.AwayActionSaveAsNew:
+		if len(resolve.Parts) != 1 {
+			return fmt.Errorf("%w: action %q must have exactly one part", dinkur.ErrAwayInvalid, resolve.Action)
+		}
+	case dinkur.AwayActionSplit:
+		if len(resolve.Parts) == 0 {
+			return fmt.Errorf("%w: action %q must have at least one part", dinkur.ErrAwayInvalid, resolve.Action)
+		}
+		for i, part := range resolve.Parts[:len(resolve.Parts)-1] {
+			if part.End == nil {
+				return fmt.Errorf("%w: part %d %q must have an end time, as only the last part may be left active",
+					dinkur.ErrAwayInvalid, i+1, part.Name)
+			}
+		}
+	default:
+		return fmt.Errorf("%w: unknown action: %s", dinkur.ErrAwayInvalid, resolve.Action)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/mattn/go-sqlite3"
+)
+
+// ErrUnexpectedDriverConn is returned when the underlying database connection
+// is not an Sqlite3 connection, which is required when restoring backups.
+var ErrUnexpectedDriverConn = errors.New("unexpected database driver connection type")
+
+func (c *client) Backup(ctx context.Context, w io.Writer) error {
+	if err := c.assertConnected(); err != nil {
+		return err
+	}
+	dir, err := os.MkdirTemp("", "dinkur-backup-")
+	if err != nil {
+		return fmt.Errorf("create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	// VACUUM INTO creates a consistent snapshot without blocking other
+	// readers or writers for longer than a regular read transaction.
+	path := filepath.Join(dir, "dinkur.db")
+	if err := c.withContext(ctx).db.Exec("VACUUM INTO ?", path).Error; err != nil {
+		return fmt.Errorf("vacuum into temporary file: %w", err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open temporary file: %w", err)
+	}
+	defer file.Close()
+	if _, err := io.Copy(w, file); err != nil {
+		return fmt.Errorf("write backup: %w", err)
+	}
+	return nil
+}
+
+func (c *client) Restore(ctx context.Context, r io.Reader) error {
+	if err := c.assertConnected(); err != nil {
+		return err
+	}
+	dir, err := os.MkdirTemp("", "dinkur-restore-")
+	if err != nil {
+		return fmt.Errorf("create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "dinkur.db")
+	if err := writeFile(path, r); err != nil {
+		return fmt.Errorf("read backup: %w", err)
+	}
+	src, err := openBackup(ctx, path, c.Options)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	if err := c.withContext(ctx).restoreFrom(ctx, src); err != nil {
+		return fmt.Errorf("restore backup: %w", err)
+	}
+	c.prevMigChecked = false
+	return nil
+}
+
+func writeFile(path string, r io.Reader) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// openBackup connects to a backup database file, validates it, and migrates it
+// to the latest version if it is outdated.
+func openBackup(ctx context.Context, path string, opt Options) (*client, error) {
+	opt.MkdirAll = false
+	opt.SkipMigrateOnConnect = true
+	src := NewClient(path, opt).(*client)
+	if err := src.Connect(ctx); err != nil {
+		return nil, fmt.Errorf("%w: %v", dinkur.ErrBackupInvalid, err)
+	}
+	if err := src.withContext(ctx).validateBackup(); err != nil {
+		src.Close()
+		return nil, err
+	}
+	if err := src.Migrate(ctx); err != nil {
+		src.Close()
+		return nil, fmt.Errorf("migrate backup: %w", err)
+	}
+	return src, nil
+}
+
+func (c *client) validateBackup() error {
+	var results []string
+	if err := c.db.Raw("PRAGMA quick_check").Scan(&results).Error; err != nil {
+		return fmt.Errorf("%w: %v", dinkur.ErrBackupInvalid, err)
+	}
+	if len(results) != 1 || results[0] != "ok" {
+		return fmt.Errorf("%w: integrity check failed", dinkur.ErrBackupInvalid)
+	}
+	version, err := c.migrationStatus()
+	if err != nil {
+		return fmt.Errorf("%w: check migration status: %v", dinkur.ErrBackupInvalid, err)
+	}
+	log.Debug().
+		WithInt("version", int(version)).
+		WithStringer("status", version).
+		Message("Validated backup.")
+	switch {
+	case version == dbmodel.MigrationNeverApplied:
+		return fmt.Errorf("%w: missing migration version", dinkur.ErrBackupInvalid)
+	case version < dbmodel.MigrationNeverApplied:
+		return fmt.Errorf("%w: unknown migration version", dinkur.ErrBackupInvalid)
+	case version > dbmodel.LatestMigrationVersion:
+		return fmt.Errorf("%w: backup version %d, supported version %d",
+			dinkur.ErrBackupTooNew, version, dbmodel.LatestMigrationVersion)
+	}
+	return nil
+}
+
+// restoreFrom overwrites all pages of the database with the pages from the src
+// database using Sqlite3's online backup API.
+func (c *client) restoreFrom(ctx context.Context, src *client) error {
+	dstSQL, err := c.db.DB()
+	if err != nil {
+		return err
+	}
+	srcSQL, err := src.db.DB()
+	if err != nil {
+		return err
+	}
+	dstConn, err := dstSQL.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer dstConn.Close()
+	srcConn, err := srcSQL.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer srcConn.Close()
+	return dstConn.Raw(func(dstDriverConn any) error {
+		return srcConn.Raw(func(srcDriverConn any) error {
+			dst, ok := dstDriverConn.(*sqlite3.SQLiteConn)
+			if !ok {
+				return fmt.Errorf("%w: %T", ErrUnexpectedDriverConn, dstDriverConn)
+			}
+			src, ok := srcDriverConn.(*sqlite3.SQLiteConn)
+			if !ok {
+				return fmt.Errorf("%w: %T", ErrUnexpectedDriverConn, srcDriverConn)
+			}
+			backup, err := dst.Backup("main", src, "main")
+			if err != nil {
+				return err
+			}
+			if _, err := backup.Step(-1); err != nil {
+				backup.Finish()
+				return err
+			}
+			return backup.Finish()
+		})
+	})
+}
@@ -61,6 +61,9 @@ type Options struct {
 	// DebugLogging enables logging of SQL queries and warnings issued by
 	// GORM.
 	DebugLogging bool
+	// OverlapPolicy decides what to do when an entry is created or edited so
+	// that it overlaps other entries. Overlaps are allowed by default.
+	OverlapPolicy dinkur.OverlapPolicy
 }
 
 // NewClient creates a new dinkur.Client-compatible client that uses an Sqlite3
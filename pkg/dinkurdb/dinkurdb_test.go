@@ -0,0 +1,72 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+//go:build fts5
+
+package dinkurdb
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+func newTestClient(t *testing.T, opt Options) dinkur.Client {
+	t.Helper()
+	c := NewClient(filepath.Join(t.TempDir(), "dinkur.db"), opt)
+	if err := c.Connect(context.Background()); err != nil {
+		t.Fatalf("connect: %s", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// createTestEntry adds an ended entry on a fixed date, where start and end are
+// the hours of the day.
+func createTestEntry(t *testing.T, c dinkur.Client, name string, start, end int, tags ...string) dinkur.Entry {
+	t.Helper()
+	startTime := testDate(start)
+	endTime := testDate(end)
+	started, err := c.CreateEntry(context.Background(), dinkur.NewEntry{
+		Name:  name,
+		Tags:  tags,
+		Start: &startTime,
+		End:   &endTime,
+	})
+	if err != nil {
+		t.Fatalf("create entry %q: %s", name, err)
+	}
+	return started.Started
+}
+
+func testDate(hour int) time.Time {
+	return time.Date(2022, 3, 14, hour, 0, 0, 0, time.UTC)
+}
+
+func getTestEntry(t *testing.T, c dinkur.Client, id uint) dinkur.Entry {
+	t.Helper()
+	entry, err := c.GetEntry(context.Background(), id)
+	if err != nil {
+		t.Fatalf("get entry #%d: %s", id, err)
+	}
+	return entry
+}
@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/dinkur/dinkur/internal/fuzzytime"
@@ -36,6 +37,7 @@ import (
 	"github.com/dinkur/dinkur/pkg/timeutil"
 	"gopkg.in/typ.v4"
 	"gopkg.in/typ.v4/slices"
+	"gorm.io/gorm"
 )
 
 func (c *client) GetActiveEntry(ctx context.Context) (*dinkur.Entry, error) {
@@ -51,13 +53,22 @@ func (c *client) activeDBEntry() (*dbmodel.Entry, error) {
 		return nil, err
 	}
 	var dbEntry dbmodel.Entry
-	err := c.db.Where(dbmodel.Entry{End: nil}, dbmodel.EntryFieldEnd).First(&dbEntry).Error
+	err := c.preloadDBEntry().
+		Where(dbmodel.Entry{End: nil}, dbmodel.EntryFieldEnd).
+		First(&dbEntry).
+		Error
 	if err != nil {
 		return nil, nilNotFoundError(err)
 	}
 	return &dbEntry, nil
 }
 
+func (c *client) preloadDBEntry() *gorm.DB {
+	return c.db.
+		Preload(dbmodel.EntryFieldTags).
+		Preload(dbmodel.EntryFieldProject)
+}
+
 func (c *client) GetEntry(ctx context.Context, id uint) (dinkur.Entry, error) {
 	dbEntry, err := c.withContext(ctx).getDBEntry(id)
 	if err != nil {
@@ -71,7 +82,7 @@ func (c *client) getDBEntry(id uint) (dbmodel.Entry, error) {
 		return dbmodel.Entry{}, err
 	}
 	var dbEntry dbmodel.Entry
-	err := c.db.First(&dbEntry, id).Error
+	err := c.preloadDBEntry().First(&dbEntry, id).Error
 	if err != nil {
 		return dbmodel.Entry{}, err
 	}
@@ -123,10 +134,20 @@ func (c *client) listDBEntries(search dinkur.SearchEntry) ([]dbmodel.Entry, erro
 	if search.Limit > math.MaxInt {
 		return nil, dinkur.ErrLimitTooLarge
 	}
+	includeTags, err := normalizeTags(search.Tags)
+	if err != nil {
+		return nil, err
+	}
+	excludeTags, err := normalizeTags(search.ExcludeTags)
+	if err != nil {
+		return nil, err
+	}
 	var dbEntries []dbmodel.Entry
-	q := c.db.Model(&dbmodel.Entry{}).
-		Order(dbmodel.EntryColumnStart + " DESC").
-		Limit(int(search.Limit))
+	q := c.preloadDBEntry().Model(&dbmodel.Entry{}).
+		Order(dbmodel.EntryColumnStart + " DESC")
+	if search.Limit > 0 {
+		q = q.Limit(int(search.Limit))
+	}
 	switch {
 	case search.Start != nil && search.End != nil:
 		// adding/subtracting 1s to resolve rounding issues, as Sqlite's
@@ -145,16 +166,26 @@ func (c *client) listDBEntries(search dinkur.SearchEntry) ([]dbmodel.Entry, erro
 		if search.NameHighlightStart != "" || search.NameHighlightEnd != "" {
 			q = q.Joins("INNER JOIN entries_idx ON entries.id = entries_idx.rowid").
 				Select(
-					"id, created_at, updated_at, highlight(entries_idx, 0, ?, ?) AS name, start, end",
+					"id, created_at, updated_at, highlight(entries_idx, 0, ?, ?) AS name, highlight(entries_idx, 1, ?, ?) AS note, start, end, project_id, deleted_at",
+					search.NameHighlightStart, search.NameHighlightEnd,
 					search.NameHighlightStart, search.NameHighlightEnd).
-				Where(dbmodel.EntryFTS5ColumnName+" MATCH ?", search.NameFuzzy)
+				Where(dbmodel.EntryFTS5ColumnAll+" MATCH ?", search.NameFuzzy)
 		} else {
 			subQ := c.db.Model(&dbmodel.EntryFTS5{}).
 				Select(dbmodel.EntryFTS5ColumnRowID).
-				Where(dbmodel.EntryFTS5ColumnName+" MATCH ?", search.NameFuzzy)
+				Where(dbmodel.EntryFTS5ColumnAll+" MATCH ?", search.NameFuzzy)
 			q = q.Where(dbmodel.EntryColumnID+" IN (?)", subQ)
 		}
 	}
+	if len(includeTags) > 0 {
+		q = c.whereEntryHasAllTags(q, includeTags)
+	}
+	if len(excludeTags) > 0 {
+		q = c.whereEntryHasNoTags(q, excludeTags)
+	}
+	if search.ProjectIDOrZero != 0 {
+		q = q.Where(dbmodel.EntryColumnProjectID+" = ?", search.ProjectIDOrZero)
+	}
 	if err := q.Find(&dbEntries).Error; err != nil {
 		return nil, err
 	}
@@ -172,6 +203,7 @@ func (c *client) UpdateEntry(ctx context.Context, edit dinkur.EditEntry) (dinkur
 	if err != nil {
 		return dinkur.UpdatedEntry{}, err
 	}
+	c.pubChangedDBEntries(update.overlaps)
 	c.entryObs.PubWait(entryEvent{
 		dbEntry: update.after,
 		event:   dinkur.EventUpdated,
@@ -185,6 +217,9 @@ func (c *client) UpdateEntry(ctx context.Context, edit dinkur.EditEntry) (dinkur
 type updatedDBEntry struct {
 	before dbmodel.Entry
 	after  dbmodel.Entry
+	// overlaps are the changes made to other entries that the updated entry
+	// overlapped.
+	overlaps []changedDBEntry
 }
 
 func (c *client) editDBEntry(edit dinkur.EditEntry) (updatedDBEntry, error) {
@@ -194,10 +229,21 @@ func (c *client) editDBEntry(edit dinkur.EditEntry) (updatedDBEntry, error) {
 	if edit.Start != nil && edit.End != nil && edit.Start.After(*edit.End) {
 		return updatedDBEntry{}, dinkur.ErrEntryEndBeforeStart
 	}
+	var err error
+	if edit.Tags, err = normalizeTags(edit.Tags); err != nil {
+		return updatedDBEntry{}, err
+	}
+	if edit.RemoveTags, err = normalizeTags(edit.RemoveTags); err != nil {
+		return updatedDBEntry{}, err
+	}
 	var update updatedDBEntry
-	err := c.transaction(func(tx *client) (tranErr error) {
-		update, tranErr = tx.editDBEntryNoTran(edit)
-		return
+	err = c.transaction(func(tx *client) error {
+		var err error
+		update, err = tx.editDBEntryNoTran(edit)
+		if err != nil {
+			return err
+		}
+		return tx.appendJournalUpdateNoTran(update)
 	})
 	return update, err
 }
@@ -234,6 +280,14 @@ func (c *client) editDBEntryNoTran(edit dinkur.EditEntry) (updatedDBEntry, error
 		}
 		anyEdit = true
 	}
+	if edit.Note != nil {
+		if edit.AppendNote && dbEntry.Note != "" {
+			dbEntry.Note = fmt.Sprint(dbEntry.Note, "\n", *edit.Note)
+		} else {
+			dbEntry.Note = *edit.Note
+		}
+		anyEdit = true
+	}
 	if edit.Start != nil {
 		dbEntry.Start = edit.Start.UTC()
 		anyEdit = true
@@ -257,17 +311,58 @@ func (c *client) editDBEntryNoTran(edit dinkur.EditEntry) (updatedDBEntry, error
 		dbEntry.End = &t
 		anyEdit = true
 	}
+	if edit.RemoveProject {
+		if dbEntry.ProjectID != nil {
+			dbEntry.ProjectID = nil
+			dbEntry.Project = nil
+			anyEdit = true
+		}
+	} else if edit.ProjectIDOrZero != 0 && conv.DerefOrZero(dbEntry.ProjectID) != edit.ProjectIDOrZero {
+		dbProject, err := c.getDBProject(edit.ProjectIDOrZero)
+		if err != nil {
+			return updatedDBEntry{}, fmt.Errorf("get project by ID: %d: %w", edit.ProjectIDOrZero, err)
+		}
+		dbEntry.ProjectID = &dbProject.ID
+		dbEntry.Project = &dbProject
+		anyEdit = true
+	}
+	var anyTagEdit bool
+	if len(edit.Tags) > 0 || len(edit.RemoveTags) > 0 {
+		oldTags := fromdb.EntryTagNames(entryBeforeEdit.Tags)
+		newTags := slices.Except(slices.Concat(oldTags, edit.Tags), edit.RemoveTags)
+		newTags, err = normalizeTags(newTags)
+		if err != nil {
+			return updatedDBEntry{}, err
+		}
+		if !dinkur.TagsEqual(oldTags, newTags) {
+			dbEntry.Tags = newDBEntryTags(dbEntry.ID, newTags)
+			anyTagEdit = true
+		}
+	}
 	if dbEntry.Elapsed() < 0 {
 		return updatedDBEntry{}, dinkur.ErrEntryEndBeforeStart
 	}
-	if anyEdit {
-		if err := c.db.Save(&dbEntry).Error; err != nil {
+	var overlaps []changedDBEntry
+	if !dbEntryTimesEqual(dbEntry, entryBeforeEdit) {
+		overlaps, err = c.applyOverlapPolicyNoTran(dbEntry, c.OverlapPolicy)
+		if err != nil {
+			return updatedDBEntry{}, err
+		}
+	}
+	if anyEdit || anyTagEdit {
+		if err := c.db.Omit(dbmodel.EntryFieldTags, dbmodel.EntryFieldProject).Save(&dbEntry).Error; err != nil {
 			return updatedDBEntry{}, fmt.Errorf("save updated entry: %w", err)
 		}
 	}
+	if anyTagEdit {
+		if err := c.setDBEntryTagsNoTran(dbEntry.ID, fromdb.EntryTagNames(dbEntry.Tags)); err != nil {
+			return updatedDBEntry{}, fmt.Errorf("save updated entry tags: %w", err)
+		}
+	}
 	return updatedDBEntry{
-		before: entryBeforeEdit,
-		after:  dbEntry,
+		before:   entryBeforeEdit,
+		after:    dbEntry,
+		overlaps: overlaps,
 	}, nil
 }
 
@@ -377,21 +472,36 @@ func (c *client) DeleteEntry(ctx context.Context, id uint) (dinkur.Entry, error)
 
 func (c *client) deleteDBEntry(id uint) (dbmodel.Entry, error) {
 	var dbEntry dbmodel.Entry
-	err := c.transaction(func(tx *client) (tranErr error) {
-		dbEntry, tranErr = tx.deleteDBEntryNoTran(id)
-		return
+	err := c.transaction(func(tx *client) error {
+		var err error
+		dbEntry, err = tx.deleteDBEntryNoTran(id)
+		if err != nil {
+			return err
+		}
+		return tx.appendJournalNoTran(dbmodel.JournalActionDelete, nil,
+			[]journalChange{deletedJournalChange(dbEntry)})
 	})
 	return dbEntry, err
 }
 
+// deleteDBEntryNoTran moves the entry to the trash. The entry keeps its tags
+// and project, so it can be restored later on.
 func (c *client) deleteDBEntryNoTran(id uint) (dbmodel.Entry, error) {
 	dbEntry, err := c.getDBEntry(id)
 	if err != nil {
 		return dbmodel.Entry{}, fmt.Errorf("get entry to delete: %w", err)
 	}
-	if err := c.db.Delete(&dbmodel.Entry{}, id).Error; err != nil {
+	// Not using GORM's Delete, as it would store the timestamp in local time,
+	// which breaks comparisons against other timestamps stored in UTC.
+	now := time.Now().UTC()
+	err = c.db.Model(&dbmodel.Entry{}).
+		Where(dbmodel.EntryColumnID+" = ?", id).
+		Update(dbmodel.EntryColumnDeletedAt, now).
+		Error
+	if err != nil {
 		return dbmodel.Entry{}, fmt.Errorf("delete entry: %w", err)
 	}
+	dbEntry.DeletedAt = gorm.DeletedAt{Time: now, Valid: true}
 	return dbEntry, nil
 }
 
@@ -399,8 +509,58 @@ func (c *client) CreateEntry(ctx context.Context, entry dinkur.NewEntry) (dinkur
 	if err := c.assertConnected(); err != nil {
 		return dinkur.StartedEntry{}, err
 	}
+	newEntry, err := newDBEntry(entry)
+	if err != nil {
+		return dinkur.StartedEntry{}, err
+	}
+	startedEntry, err := c.withContext(ctx).startDBEntry(newEntry)
+	if err != nil {
+		return dinkur.StartedEntry{}, err
+	}
+	c.pubStartedDBEntry(startedEntry)
+	return fromStartedDBEntry(startedEntry), nil
+}
+
+func (c *client) CreateEntries(ctx context.Context, entries []dinkur.NewEntry) ([]dinkur.StartedEntry, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	newEntries := make([]newEntry, len(entries))
+	for i, entry := range entries {
+		newEntry, err := newDBEntry(entry)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d %q: %w", i+1, entry.Name, err)
+		}
+		newEntries[i] = newEntry
+	}
+	var startedEntries []startedDBEntry
+	err := c.withContext(ctx).transaction(func(tx *client) error {
+		var changes []journalChange
+		for i, newEntry := range newEntries {
+			startedEntry, err := tx.importDBEntryNoTran(newEntry)
+			if err != nil {
+				return fmt.Errorf("entry %d %q: %w", i+1, newEntry.Name, err)
+			}
+			startedEntries = append(startedEntries, startedEntry)
+			changes = append(changes, startedJournalChanges(startedEntry)...)
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		return tx.appendJournalNoTran(dbmodel.JournalActionCreate, nil, changes)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, startedEntry := range startedEntries {
+		c.pubStartedDBEntry(startedEntry)
+	}
+	return slices.Map(startedEntries, fromStartedDBEntry), nil
+}
+
+func newDBEntry(entry dinkur.NewEntry) (newEntry, error) {
 	if entry.Name == "" {
-		return dinkur.StartedEntry{}, dinkur.ErrEntryNameEmpty
+		return newEntry{}, dinkur.ErrEntryNameEmpty
 	}
 	var start time.Time
 	if entry.Start != nil {
@@ -409,41 +569,65 @@ func (c *client) CreateEntry(ctx context.Context, entry dinkur.NewEntry) (dinkur
 		start = time.Now()
 	}
 	if entry.End != nil && entry.End.Before(start) {
-		return dinkur.StartedEntry{}, dinkur.ErrEntryEndBeforeStart
+		return newEntry{}, dinkur.ErrEntryEndBeforeStart
 	}
-	newEntry := newEntry{
+	tags, err := normalizeTags(entry.Tags)
+	if err != nil {
+		return newEntry{}, err
+	}
+	var dbProject *dbmodel.Project
+	if entry.ProjectIDOrZero == 0 && entry.Project != nil {
+		dbProject = &dbmodel.Project{
+			Name:   strings.TrimSpace(entry.Project.Name),
+			Client: strings.TrimSpace(entry.Project.Client),
+		}
+		if err := validateProject(*dbProject); err != nil {
+			return newEntry{}, err
+		}
+	}
+	return newEntry{
 		Entry: dbmodel.Entry{
-			Name:  entry.Name,
-			Start: start.UTC(),
-			End:   conv.TimePtrUTC(entry.End),
+			Name:      entry.Name,
+			Note:      entry.Note,
+			Start:     start.UTC(),
+			End:       conv.TimePtrUTC(entry.End),
+			Tags:      newDBEntryTags(0, tags),
+			ProjectID: conv.ZeroAsNil(entry.ProjectIDOrZero),
+			Project:   dbProject,
 		},
 		startAfterIDOrZero: entry.StartAfterIDOrZero,
 		endBeforeIDOrZero:  entry.EndBeforeIDOrZero,
 		startAfterLast:     entry.StartAfterLast,
-	}
-	startedEntry, err := c.withContext(ctx).startDBEntry(newEntry)
-	if err != nil {
-		return dinkur.StartedEntry{}, err
-	}
+	}, nil
+}
+
+func (c *client) pubStartedDBEntry(startedEntry startedDBEntry) {
 	if startedEntry.stopped != nil {
 		c.entryObs.PubWait(entryEvent{
 			dbEntry: *startedEntry.stopped,
 			event:   dinkur.EventUpdated,
 		})
 	}
+	c.pubChangedDBEntries(startedEntry.overlaps)
 	c.entryObs.PubWait(entryEvent{
 		dbEntry: startedEntry.started,
 		event:   dinkur.EventCreated,
 	})
+}
+
+func fromStartedDBEntry(startedEntry startedDBEntry) dinkur.StartedEntry {
 	return dinkur.StartedEntry{
 		Started: fromdb.Entry(startedEntry.started),
 		Stopped: fromdb.EntryPtr(startedEntry.stopped),
-	}, nil
+	}
 }
 
 type startedDBEntry struct {
 	started dbmodel.Entry
 	stopped *dbmodel.Entry
+	// overlaps are the changes made to other entries that the started entry
+	// overlapped.
+	overlaps []changedDBEntry
 }
 
 type newEntry struct {
@@ -455,39 +639,94 @@ type newEntry struct {
 
 func (c *client) startDBEntry(newEntry newEntry) (startedDBEntry, error) {
 	var startedEntry startedDBEntry
-	err := c.transaction(func(tx *client) (tranErr error) {
-		startedEntry, tranErr = tx.startDBEntryNoTran(newEntry)
-		return
+	err := c.transaction(func(tx *client) error {
+		var err error
+		startedEntry, err = tx.startDBEntryNoTran(newEntry)
+		if err != nil {
+			return err
+		}
+		return tx.appendJournalNoTran(dbmodel.JournalActionCreate, nil,
+			startedJournalChanges(startedEntry))
 	})
 	return startedEntry, err
 }
 
 func (c *client) startDBEntryNoTran(newEntry newEntry) (startedDBEntry, error) {
-	startAfterTime, err := c.getTimeToStartAfterOrNow(newEntry.startAfterIDOrZero, newEntry.startAfterLast)
+	newEntry, err := c.resolveNewDBEntryTimesNoTran(newEntry)
 	if err != nil {
 		return startedDBEntry{}, err
 	}
+	previousDBEntry, err := c.stopActiveDBEntryNoTran(newEntry.Start)
+	if err != nil {
+		return startedDBEntry{}, fmt.Errorf("stop previously active entry: %w", err)
+	}
+	return c.createDBEntryNoTran(newEntry, previousDBEntry)
+}
+
+// importDBEntryNoTran creates a new entry just like startDBEntryNoTran, except
+// that the currently active entry is only stopped if the new entry is also
+// active. This lets already ended entries be imported without interfering
+// with any ongoing time tracking.
+func (c *client) importDBEntryNoTran(newEntry newEntry) (startedDBEntry, error) {
+	newEntry, err := c.resolveNewDBEntryTimesNoTran(newEntry)
+	if err != nil {
+		return startedDBEntry{}, err
+	}
+	var previousDBEntry *dbmodel.Entry
+	if newEntry.End == nil {
+		previousDBEntry, err = c.stopActiveDBEntryNoTran(newEntry.Start)
+		if err != nil {
+			return startedDBEntry{}, fmt.Errorf("stop previously active entry: %w", err)
+		}
+	}
+	return c.createDBEntryNoTran(newEntry, previousDBEntry)
+}
+
+func (c *client) resolveNewDBEntryTimesNoTran(newEntry newEntry) (newEntry, error) {
+	startAfterTime, err := c.getTimeToStartAfterOrNow(newEntry.startAfterIDOrZero, newEntry.startAfterLast)
+	if err != nil {
+		return newEntry, err
+	}
 	if startAfterTime != nil {
 		newEntry.Start = *startAfterTime
 	}
 	endBeforeTime, err := c.getTimeToEndBefore(newEntry.endBeforeIDOrZero)
 	if err != nil {
-		return startedDBEntry{}, err
+		return newEntry, err
 	}
 	if endBeforeTime != nil {
 		newEntry.End = endBeforeTime
 	}
-	previousDBEntry, err := c.stopActiveDBEntryNoTran(newEntry.Start)
+	return newEntry, nil
+}
+
+func (c *client) createDBEntryNoTran(newEntry newEntry, previousDBEntry *dbmodel.Entry) (startedDBEntry, error) {
+	if newEntry.ProjectID != nil {
+		dbProject, err := c.getDBProject(*newEntry.ProjectID)
+		if err != nil {
+			return startedDBEntry{}, fmt.Errorf("get project by ID: %d: %w", *newEntry.ProjectID, err)
+		}
+		newEntry.Project = &dbProject
+	} else if newEntry.Project != nil {
+		dbProject, err := c.getOrCreateDBProjectNoTran(*newEntry.Project)
+		if err != nil {
+			return startedDBEntry{}, fmt.Errorf("get or create project by name: %q: %w", newEntry.Project.Name, err)
+		}
+		newEntry.Project = &dbProject
+		newEntry.ProjectID = &dbProject.ID
+	}
+	overlaps, err := c.applyOverlapPolicyNoTran(newEntry.Entry, c.OverlapPolicy)
 	if err != nil {
-		return startedDBEntry{}, fmt.Errorf("stop previously active entry: %w", err)
+		return startedDBEntry{}, err
 	}
-	err = c.db.Create(&newEntry.Entry).Error
+	err = c.db.Omit(dbmodel.EntryFieldProject).Create(&newEntry.Entry).Error
 	if err != nil {
 		return startedDBEntry{}, fmt.Errorf("create new active entry: %w", err)
 	}
 	return startedDBEntry{
-		stopped: previousDBEntry,
-		started: newEntry.Entry,
+		stopped:  previousDBEntry,
+		started:  newEntry.Entry,
+		overlaps: overlaps,
 	}, nil
 }
 
@@ -510,16 +749,25 @@ func (c *client) StopActiveEntry(ctx context.Context, endTime time.Time) (*dinku
 
 func (c *client) stopActiveDBEntry(endTime time.Time) (*dbmodel.Entry, error) {
 	var activeDBEntry *dbmodel.Entry
-	err := c.transaction(func(tx *client) (tranErr error) {
-		activeDBEntry, tranErr = tx.stopActiveDBEntryNoTran(endTime)
-		return
+	err := c.transaction(func(tx *client) error {
+		var err error
+		activeDBEntry, err = tx.stopActiveDBEntryNoTran(endTime)
+		if err != nil || activeDBEntry == nil {
+			return err
+		}
+		return tx.appendJournalNoTran(dbmodel.JournalActionStop, nil,
+			[]journalChange{stoppedJournalChange(*activeDBEntry)})
 	})
 	return activeDBEntry, err
 }
 
 func (c *client) stopActiveDBEntryNoTran(endTime time.Time) (*dbmodel.Entry, error) {
 	var entries []dbmodel.Entry
-	if err := c.db.Where(&dbmodel.Entry{End: nil}, dbmodel.EntryFieldEnd).Find(&entries).Error; err != nil {
+	err := c.preloadDBEntry().
+		Where(&dbmodel.Entry{End: nil}, dbmodel.EntryFieldEnd).
+		Find(&entries).
+		Error
+	if err != nil {
 		return nil, err
 	}
 	if len(entries) == 0 {
@@ -531,7 +779,7 @@ func (c *client) stopActiveDBEntryNoTran(endTime time.Time) (*dbmodel.Entry, err
 		}
 		entries[i].End = &endTime
 	}
-	err := c.db.Model(&dbmodel.Entry{}).
+	err = c.db.Model(&dbmodel.Entry{}).
 		Where(&dbmodel.Entry{End: nil}, dbmodel.EntryFieldEnd).
 		Update(dbmodel.EntryFieldEnd, endTime).
 		Error
@@ -0,0 +1,222 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromdb"
+	"gopkg.in/typ.v4"
+	"gopkg.in/typ.v4/slices"
+)
+
+// errDryRun is returned from inside transactions to roll them back when only
+// doing a dry run.
+var errDryRun = errors.New("dry run")
+
+// dryRunTransaction is the same as transaction, but rolls back the changes
+// if dryRun is set.
+func (c *client) dryRunTransaction(dryRun bool, f func(tx *client) error) error {
+	err := c.transaction(func(tx *client) error {
+		if err := f(tx); err != nil {
+			return err
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if dryRun && errors.Is(err, errDryRun) {
+		return nil
+	}
+	return err
+}
+
+// bulkSearch removes the parts of a search that must not be used when
+// searching for entries to change.
+func bulkSearch(search dinkur.SearchEntry) dinkur.SearchEntry {
+	// highlighting alters the entry names, which would then be saved
+	search.NameHighlightStart = ""
+	search.NameHighlightEnd = ""
+	return search
+}
+
+func (c *client) UpdateEntries(ctx context.Context, edit dinkur.BulkEditEntry) ([]dinkur.UpdatedEntry, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	if edit.Name != nil && *edit.Name == "" {
+		return nil, dinkur.ErrEntryNameEmpty
+	}
+	var updates []updatedDBEntry
+	err := c.withContext(ctx).dryRunTransaction(edit.DryRun, func(tx *client) error {
+		var err error
+		updates, err = tx.editDBEntriesNoTran(edit)
+		if err != nil {
+			return err
+		}
+		if len(updates) == 0 {
+			return nil
+		}
+		changes := slices.Map(updates, updatedJournalChange)
+		for _, update := range updates {
+			changes = append(changes, slices.Map(update.overlaps, changedJournalChange)...)
+		}
+		return tx.appendJournalNoTran(dbmodel.JournalActionUpdate, nil, changes)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !edit.DryRun {
+		for _, update := range updates {
+			c.entryObs.PubWait(entryEvent{
+				dbEntry: update.after,
+				event:   dinkur.EventUpdated,
+			})
+		}
+		for _, update := range updates {
+			c.pubChangedDBEntries(update.overlaps)
+		}
+	}
+	return slices.Map(updates, func(update updatedDBEntry) dinkur.UpdatedEntry {
+		return dinkur.UpdatedEntry{
+			Before: fromdb.Entry(update.before),
+			After:  fromdb.Entry(update.after),
+		}
+	}), nil
+}
+
+func (c *client) editDBEntriesNoTran(edit dinkur.BulkEditEntry) ([]updatedDBEntry, error) {
+	dbEntries, err := c.listDBEntries(bulkSearch(edit.Search))
+	if err != nil {
+		return nil, fmt.Errorf("list entries to edit: %w", err)
+	}
+	var updates []updatedDBEntry
+	for _, dbEntry := range dbEntries {
+		entryBeforeEdit := dbEntry
+		if edit.Name != nil {
+			dbEntry.Name = *edit.Name
+		}
+		if edit.ReplaceNameOld != "" {
+			dbEntry.Name = strings.ReplaceAll(dbEntry.Name, edit.ReplaceNameOld, edit.ReplaceNameNew)
+		}
+		if dbEntry.Name == "" {
+			return nil, fmt.Errorf("entry #%d: %w", dbEntry.ID, dinkur.ErrEntryNameEmpty)
+		}
+		if edit.Shift != 0 {
+			dbEntry.Start = dbEntry.Start.Add(edit.Shift)
+			if dbEntry.End != nil {
+				dbEntry.End = typ.Ref(dbEntry.End.Add(edit.Shift))
+			}
+		}
+		if dbEntry.Name == entryBeforeEdit.Name && edit.Shift == 0 {
+			continue
+		}
+		if dbEntry.Elapsed() < 0 {
+			return nil, fmt.Errorf("entry #%d: %w", dbEntry.ID, dinkur.ErrEntryEndBeforeStart)
+		}
+		if err := c.db.Omit(dbmodel.EntryFieldTags, dbmodel.EntryFieldProject).Save(&dbEntry).Error; err != nil {
+			return nil, fmt.Errorf("save updated entry #%d: %w", dbEntry.ID, err)
+		}
+		updates = append(updates, updatedDBEntry{
+			before: entryBeforeEdit,
+			after:  dbEntry,
+		})
+	}
+	if edit.Shift != 0 {
+		// The overlap policy is only applied once all entries are shifted, as
+		// the shifted entries would otherwise overlap the old times of the
+		// entries that are yet to be shifted.
+		for i := range updates {
+			overlaps, err := c.applyShiftOverlapPolicyNoTran(updates[i].after.ID)
+			if err != nil {
+				return nil, fmt.Errorf("entry #%d: %w", updates[i].after.ID, err)
+			}
+			updates[i].overlaps = overlaps
+		}
+	}
+	return updates, nil
+}
+
+func (c *client) applyShiftOverlapPolicyNoTran(id uint) ([]changedDBEntry, error) {
+	// the entry may have been changed or removed when applying the overlap
+	// policy on the previously shifted entries, so it has to be fetched again
+	current, err := c.getDBEntry(id)
+	if errors.Is(err, dinkur.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get shifted entry: %w", err)
+	}
+	return c.applyOverlapPolicyNoTran(current, c.OverlapPolicy)
+}
+
+func (c *client) DeleteEntries(ctx context.Context, del dinkur.BulkDeleteEntry) ([]dinkur.Entry, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	var dbEntries []dbmodel.Entry
+	err := c.withContext(ctx).dryRunTransaction(del.DryRun, func(tx *client) error {
+		var err error
+		dbEntries, err = tx.deleteDBEntriesNoTran(del.Search)
+		if err != nil {
+			return err
+		}
+		if len(dbEntries) == 0 {
+			return nil
+		}
+		return tx.appendJournalNoTran(dbmodel.JournalActionDelete, nil,
+			slices.Map(dbEntries, deletedJournalChange))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !del.DryRun {
+		for _, dbEntry := range dbEntries {
+			c.entryObs.PubWait(entryEvent{
+				dbEntry: dbEntry,
+				event:   dinkur.EventDeleted,
+			})
+		}
+	}
+	return fromdb.EntrySlice(dbEntries), nil
+}
+
+func (c *client) deleteDBEntriesNoTran(search dinkur.SearchEntry) ([]dbmodel.Entry, error) {
+	dbEntries, err := c.listDBEntries(bulkSearch(search))
+	if err != nil {
+		return nil, fmt.Errorf("list entries to delete: %w", err)
+	}
+	deleted := make([]dbmodel.Entry, 0, len(dbEntries))
+	for _, dbEntry := range dbEntries {
+		deletedEntry, err := c.deleteDBEntryNoTran(dbEntry.ID)
+		if err != nil {
+			return nil, fmt.Errorf("entry #%d: %w", dbEntry.ID, err)
+		}
+		deleted = append(deleted, deletedEntry)
+	}
+	return deleted, nil
+}
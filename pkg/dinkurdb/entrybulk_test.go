@@ -0,0 +1,82 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+//go:build fts5
+
+package dinkurdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+func TestUpdateEntriesShiftAppliesOverlapPolicy(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, Options{OverlapPolicy: dinkur.OverlapPolicyTrim})
+	shifted := createTestEntry(t, c, "shifted", 10, 11, "shift")
+	overlapped := createTestEntry(t, c, "overlapped", 11, 12)
+
+	_, err := c.UpdateEntries(ctx, dinkur.BulkEditEntry{
+		Search: dinkur.SearchEntry{Tags: []string{"shift"}},
+		Shift:  30 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("update entries: %s", err)
+	}
+	got := getTestEntry(t, c, overlapped.ID)
+	if want := testDate(11).Add(30 * time.Minute); !got.Start.Equal(want) {
+		t.Errorf("want overlapped entry trimmed to start at %s, got %s", want, got.Start)
+	}
+
+	if _, err := c.Undo(ctx); err != nil {
+		t.Fatalf("undo: %s", err)
+	}
+	got = getTestEntry(t, c, overlapped.ID)
+	if want := testDate(11); !got.Start.Equal(want) {
+		t.Errorf("want overlapped entry to start at %s after undo, got %s", want, got.Start)
+	}
+	got = getTestEntry(t, c, shifted.ID)
+	if want := testDate(10); !got.Start.Equal(want) {
+		t.Errorf("want shifted entry to start at %s after undo, got %s", want, got.Start)
+	}
+}
+
+func TestUpdateEntriesShiftConsecutiveEntries(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, Options{OverlapPolicy: dinkur.OverlapPolicyReject})
+	first := createTestEntry(t, c, "first", 10, 11, "shift")
+	second := createTestEntry(t, c, "second", 11, 12, "shift")
+
+	_, err := c.UpdateEntries(ctx, dinkur.BulkEditEntry{
+		Search: dinkur.SearchEntry{Tags: []string{"shift"}},
+		Shift:  30 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("want entries shifted together to not overlap, got error: %s", err)
+	}
+	for _, entry := range []dinkur.Entry{first, second} {
+		got := getTestEntry(t, c, entry.ID)
+		if want := entry.Start.Add(30 * time.Minute); !got.Start.Equal(want) {
+			t.Errorf("want entry %q to start at %s, got %s", entry.Name, want, got.Start)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromdb"
+	"gopkg.in/typ.v4/slices"
+)
+
+func (c *client) GetEntryGaps(ctx context.Context, search dinkur.SearchEntryGaps) ([]dinkur.EntryGap, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	if search.WorkHoursStart < 0 || search.WorkHoursEnd > 24*time.Hour ||
+		(search.WorkHoursEnd != 0 && search.WorkHoursEnd <= search.WorkHoursStart) {
+		return nil, dinkur.ErrWorkHoursInvalid
+	}
+	loc, err := loadTimeZone(search.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	span := search.Shorthand.Span(time.Now().In(loc))
+	if search.Start == nil {
+		search.Start = span.Start
+	}
+	if search.End == nil {
+		search.End = span.End
+	}
+	dbEntries, err := c.withContext(ctx).listDBEntries(dinkur.SearchEntry{
+		Start: search.Start,
+		End:   search.End,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		gaps       []dinkur.EntryGap
+		prev       *dbmodel.Entry
+		prevEnd    time.Time
+		hasPrevEnd bool
+	)
+	// the start and end of the searched time span are used as sentinels, so
+	// that gaps before the first entry and after the last entry are included
+	if search.Start != nil {
+		prevEnd, hasPrevEnd = *search.Start, true
+	}
+	for i := range dbEntries {
+		dbEntry := &dbEntries[i]
+		if hasPrevEnd && dbEntry.Start.After(prevEnd) {
+			gaps = appendEntryGaps(gaps, prevEnd, dbEntry.Start, prev, dbEntry, search, loc)
+		}
+		// the previous entry is the one that ends last, as entries may
+		// overlap
+		if end := conv.TimeOrNow(dbEntry.End); !hasPrevEnd || end.After(prevEnd) {
+			prev = dbEntry
+			prevEnd, hasPrevEnd = end, true
+		}
+	}
+	if search.End != nil && hasPrevEnd {
+		// time after now has not been tracked yet, so it is not a gap
+		end := *search.End
+		if now := time.Now(); end.After(now) {
+			end = now
+		}
+		if end.After(prevEnd) {
+			gaps = appendEntryGaps(gaps, prevEnd, end, prev, nil, search, loc)
+		}
+	}
+	return gaps, nil
+}
+
+func appendEntryGaps(gaps []dinkur.EntryGap, start, end time.Time, prev, next *dbmodel.Entry, search dinkur.SearchEntryGaps, loc *time.Location) []dinkur.EntryGap {
+	for _, workGap := range splitByWorkHours(start, end, search, loc) {
+		gaps = append(gaps, dinkur.EntryGap{
+			Start:    workGap.start,
+			End:      workGap.end,
+			Previous: fromdb.EntryPtr(prev),
+			Next:     fromdb.EntryPtr(next),
+		})
+	}
+	return gaps
+}
+
+type workHoursSpan struct {
+	start time.Time
+	end   time.Time
+}
+
+// splitByWorkHours returns the parts of the time span that are within working
+// hours, leaving out any parts shorter than the search's minimum duration.
+func splitByWorkHours(start, end time.Time, search dinkur.SearchEntryGaps, loc *time.Location) []workHoursSpan {
+	start = start.In(loc)
+	end = end.In(loc)
+	var spans []workHoursSpan
+	y, m, d := start.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, loc); day.Before(end); day = day.AddDate(0, 0, 1) {
+		if len(search.WorkDays) > 0 && !slices.Contains(search.WorkDays, day.Weekday()) {
+			continue
+		}
+		workStart := atTimeOfDay(day, search.WorkHoursStart)
+		workEnd := day.AddDate(0, 0, 1)
+		if search.WorkHoursEnd != 0 {
+			workEnd = atTimeOfDay(day, search.WorkHoursEnd)
+		}
+		if workStart.Before(start) {
+			workStart = start
+		}
+		if workEnd.After(end) {
+			workEnd = end
+		}
+		dur := workEnd.Sub(workStart)
+		if dur <= 0 || dur < search.MinDuration {
+			continue
+		}
+		spans = append(spans, workHoursSpan{workStart, workEnd})
+	}
+	return spans
+}
+
+// atTimeOfDay returns the wall clock time of day on the given day, where the
+// time of day is a duration since midnight. Unlike adding the duration to
+// midnight, this gives the expected time on days with daylight saving time
+// transitions.
+func atTimeOfDay(day time.Time, timeOfDay time.Duration) time.Time {
+	y, m, d := day.Date()
+	hour := int(timeOfDay / time.Hour)
+	min := int(timeOfDay % time.Hour / time.Minute)
+	sec := int(timeOfDay % time.Minute / time.Second)
+	return time.Date(y, m, d, hour, min, sec, 0, day.Location())
+}
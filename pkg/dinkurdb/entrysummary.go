@@ -0,0 +1,336 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/timeutil"
+	"gorm.io/gorm"
+)
+
+// summaryMaxBuckets is the maximum number of day, week, or month buckets that
+// a single summary may contain.
+const summaryMaxBuckets = 100000
+
+// summaryBucketsPerQuery is the number of buckets sent per SQL query, to keep
+// the number of query parameters below Sqlite's limit.
+const summaryBucketsPerQuery = 500
+
+// entrySQLSummaryMilliseconds sums up the entries' durations, in milliseconds,
+// where active entries are counted up until the "now" query parameter.
+var entrySQLSummaryMilliseconds = fmt.Sprintf(
+	"CAST(ROUND(COALESCE(SUM(julianday(COALESCE(entries.%[2]s, ?)) - julianday(entries.%[1]s)), 0) * 86400000.0) AS INTEGER)",
+	dbmodel.EntryColumnStart, dbmodel.EntryColumnEnd,
+)
+
+type summaryBucket struct {
+	key   string
+	start time.Time
+	end   time.Time
+	// queryStart and queryEnd are the bucket's start and end, but clipped to
+	// fit within the searched time span.
+	queryStart time.Time
+	queryEnd   time.Time
+}
+
+type summaryRow struct {
+	Key          string
+	Entries      uint
+	Milliseconds int64
+}
+
+func (c *client) GetEntrySummary(ctx context.Context, search dinkur.SearchEntrySummary) ([]dinkur.EntrySummary, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	var summaries []dinkur.EntrySummary
+	err := c.withContext(ctx).transaction(func(tx *client) error {
+		var err error
+		summaries, err = tx.summarizeDBEntriesNoTran(search)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+func (c *client) summarizeDBEntriesNoTran(search dinkur.SearchEntrySummary) ([]dinkur.EntrySummary, error) {
+	loc, err := loadTimeZone(search.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().In(loc)
+	span := search.Shorthand.Span(now)
+	if search.Start == nil {
+		search.Start = span.Start
+	}
+	if search.End == nil {
+		search.End = span.End
+	}
+	switch search.GroupBy {
+	case dinkur.EntrySummaryGroupName:
+		return c.summarizeDBEntriesByNameNoTran(search.Start, search.End, now)
+	case dinkur.EntrySummaryGroupDay, dinkur.EntrySummaryGroupWeek, dinkur.EntrySummaryGroupMonth:
+		return c.summarizeDBEntriesByTimeNoTran(search.GroupBy, search.Start, search.End, now)
+	default:
+		return nil, fmt.Errorf("%w: %d", dinkur.ErrSummaryGroupInvalid, search.GroupBy)
+	}
+}
+
+func (c *client) summarizeDBEntriesByNameNoTran(start, end *time.Time, now time.Time) ([]dinkur.EntrySummary, error) {
+	q := c.db.Model(&dbmodel.Entry{}).
+		Select(fmt.Sprintf("%[1]s AS key, COUNT(*) AS entries, %[2]s AS milliseconds",
+			dbmodel.EntryColumnName, entrySQLSummaryMilliseconds), now.UTC()).
+		Group(dbmodel.EntryColumnName).
+		Order("milliseconds DESC, key")
+	if start != nil {
+		q = q.Where(dbmodel.EntryColumnStart+" >= ?", start.UTC())
+	}
+	if end != nil {
+		q = q.Where(dbmodel.EntryColumnStart+" < ?", end.UTC())
+	}
+	var rows []summaryRow
+	if err := q.Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("summarize entries by name: %w", err)
+	}
+	summaries := make([]dinkur.EntrySummary, len(rows))
+	for i, row := range rows {
+		summaries[i] = dinkur.EntrySummary{
+			Key:      row.Key,
+			Entries:  row.Entries,
+			Duration: time.Duration(row.Milliseconds) * time.Millisecond,
+		}
+	}
+	return summaries, nil
+}
+
+func (c *client) summarizeDBEntriesByTimeNoTran(group dinkur.EntrySummaryGroup, start, end *time.Time, now time.Time) ([]dinkur.EntrySummary, error) {
+	if start == nil || end == nil {
+		first, last, ok, err := c.getDBEntryBoundsNoTran(start, end, now)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return []dinkur.EntrySummary{}, nil
+		}
+		if start == nil {
+			start = &first
+		}
+		if end == nil {
+			last = last.Add(time.Second)
+			end = &last
+		}
+	}
+	buckets, err := newSummaryBuckets(group, start.In(now.Location()), end.In(now.Location()))
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]dinkur.EntrySummary, 0, len(buckets))
+	for len(buckets) > 0 {
+		chunk := buckets
+		if len(chunk) > summaryBucketsPerQuery {
+			chunk = chunk[:summaryBucketsPerQuery]
+		}
+		buckets = buckets[len(chunk):]
+		chunkSummaries, err := c.summarizeDBEntryBucketsNoTran(chunk, now)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, chunkSummaries...)
+	}
+	return summaries, nil
+}
+
+// getDBEntryBoundsNoTran returns the start of the first entry and the end of
+// the last entry that starts within the optional time span, where active
+// entries end at the given "now" time.
+func (c *client) getDBEntryBoundsNoTran(start, end *time.Time, now time.Time) (time.Time, time.Time, bool, error) {
+	q := c.db.Model(&dbmodel.Entry{})
+	if start != nil {
+		q = q.Where(dbmodel.EntryColumnStart+" >= ?", start.UTC())
+	}
+	if end != nil {
+		q = q.Where(dbmodel.EntryColumnStart+" < ?", end.UTC())
+	}
+	var first, last []time.Time
+	if err := q.Session(&gorm.Session{}).
+		Order(dbmodel.EntryColumnStart).
+		Limit(1).
+		Pluck(dbmodel.EntryColumnStart, &first).Error; err != nil {
+		return time.Time{}, time.Time{}, false, fmt.Errorf("get first entry start: %w", err)
+	}
+	if len(first) == 0 {
+		return time.Time{}, time.Time{}, false, nil
+	}
+	var activeCount int64
+	if err := q.Session(&gorm.Session{}).
+		Where(dbmodel.EntryColumnEnd + " IS NULL").
+		Count(&activeCount).Error; err != nil {
+		return time.Time{}, time.Time{}, false, fmt.Errorf("count active entries: %w", err)
+	}
+	if activeCount > 0 {
+		return first[0], now, true, nil
+	}
+	if err := q.Session(&gorm.Session{}).
+		Order(dbmodel.EntryColumnEnd+" DESC").
+		Limit(1).
+		Pluck(dbmodel.EntryColumnEnd, &last).Error; err != nil {
+		return time.Time{}, time.Time{}, false, fmt.Errorf("get last entry end: %w", err)
+	}
+	if len(last) == 0 {
+		return time.Time{}, time.Time{}, false, nil
+	}
+	return first[0], last[0], true, nil
+}
+
+func (c *client) summarizeDBEntryBucketsNoTran(buckets []summaryBucket, now time.Time) ([]dinkur.EntrySummary, error) {
+	var sb strings.Builder
+	args := make([]any, 0, len(buckets)*3+1)
+	sb.WriteString("WITH params(now) AS (VALUES (?)), buckets(key, bucket_start, bucket_end) AS (VALUES ")
+	args = append(args, now.UTC())
+	for i, b := range buckets {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("(?, ?, ?)")
+		args = append(args, b.key, b.queryStart.UTC(), b.queryEnd.UTC())
+	}
+	// Entries that cross a bucket boundary are clipped to the bucket, so that
+	// only the part of the entry within the bucket is counted.
+	fmt.Fprintf(&sb, `)
+SELECT buckets.key AS key, COUNT(entries.%[1]s) AS entries,
+	CAST(ROUND(COALESCE(SUM(
+		julianday(MIN(COALESCE(entries.%[3]s, params.now), buckets.bucket_end)) -
+		julianday(MAX(entries.%[2]s, buckets.bucket_start))
+	), 0) * 86400000.0) AS INTEGER) AS milliseconds
+FROM buckets
+CROSS JOIN params
+LEFT JOIN entries
+	ON entries.%[2]s < buckets.bucket_end
+	AND COALESCE(entries.%[3]s, params.now) > buckets.bucket_start
+	AND entries.%[4]s IS NULL
+GROUP BY buckets.key`,
+		dbmodel.EntryColumnID, dbmodel.EntryColumnStart, dbmodel.EntryColumnEnd,
+		dbmodel.EntryColumnDeletedAt)
+	var rows []summaryRow
+	if err := c.db.Raw(sb.String(), args...).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("summarize entries by %d buckets: %w", len(buckets), err)
+	}
+	rowsByKey := make(map[string]summaryRow, len(rows))
+	for _, row := range rows {
+		rowsByKey[row.Key] = row
+	}
+	summaries := make([]dinkur.EntrySummary, len(buckets))
+	for i, b := range buckets {
+		row := rowsByKey[b.key]
+		start, end := b.start, b.end
+		summaries[i] = dinkur.EntrySummary{
+			Key:      b.key,
+			Start:    &start,
+			End:      &end,
+			Entries:  row.Entries,
+			Duration: time.Duration(row.Milliseconds) * time.Millisecond,
+		}
+	}
+	return summaries, nil
+}
+
+// newSummaryBuckets returns all day, week, or month buckets that overlaps the
+// time span. The time zone of the start time decides the bucket boundaries.
+func newSummaryBuckets(group dinkur.EntrySummaryGroup, start, end time.Time) ([]summaryBucket, error) {
+	var buckets []summaryBucket
+	for bucketStart := summaryBucketStart(group, start); bucketStart.Before(end); {
+		if len(buckets) >= summaryMaxBuckets {
+			return nil, fmt.Errorf("%w: more than %d %s buckets",
+				dinkur.ErrSummaryRangeTooLarge, summaryMaxBuckets, group)
+		}
+		bucketEnd := summaryBucketNext(group, bucketStart)
+		b := summaryBucket{
+			key:        summaryBucketKey(group, bucketStart),
+			start:      bucketStart,
+			end:        bucketEnd,
+			queryStart: bucketStart,
+			queryEnd:   bucketEnd,
+		}
+		if b.queryStart.Before(start) {
+			b.queryStart = start
+		}
+		if b.queryEnd.After(end) {
+			b.queryEnd = end
+		}
+		buckets = append(buckets, b)
+		bucketStart = bucketEnd
+	}
+	return buckets, nil
+}
+
+func summaryBucketStart(group dinkur.EntrySummaryGroup, t time.Time) time.Time {
+	y, m, d := t.Date()
+	switch group {
+	case dinkur.EntrySummaryGroupWeek:
+		return time.Date(y, m, d-timeutil.DaysSinceMonday(t.Weekday()), 0, 0, 0, 0, t.Location())
+	case dinkur.EntrySummaryGroupMonth:
+		return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	}
+}
+
+func summaryBucketNext(group dinkur.EntrySummaryGroup, bucketStart time.Time) time.Time {
+	switch group {
+	case dinkur.EntrySummaryGroupWeek:
+		return bucketStart.AddDate(0, 0, 7)
+	case dinkur.EntrySummaryGroupMonth:
+		return bucketStart.AddDate(0, 1, 0)
+	default:
+		return bucketStart.AddDate(0, 0, 1)
+	}
+}
+
+func summaryBucketKey(group dinkur.EntrySummaryGroup, bucketStart time.Time) string {
+	switch group {
+	case dinkur.EntrySummaryGroupWeek:
+		year, week := bucketStart.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	case dinkur.EntrySummaryGroupMonth:
+		return bucketStart.Format("2006-01")
+	default:
+		return bucketStart.Format("2006-01-02")
+	}
+}
+
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", dinkur.ErrTimeZoneInvalid, name)
+	}
+	return loc, nil
+}
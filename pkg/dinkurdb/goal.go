@@ -0,0 +1,347 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromdb"
+	"gorm.io/gorm"
+)
+
+func (c *client) GetGoal(ctx context.Context, id uint) (dinkur.Goal, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.Goal{}, err
+	}
+	dbGoal, err := c.withContext(ctx).getDBGoal(id)
+	if err != nil {
+		return dinkur.Goal{}, err
+	}
+	return fromdb.Goal(dbGoal), nil
+}
+
+func (c *client) preloadDBGoal() *gorm.DB {
+	return c.db.Preload(dbmodel.GoalFieldProject)
+}
+
+func (c *client) getDBGoal(id uint) (dbmodel.Goal, error) {
+	var dbGoal dbmodel.Goal
+	if err := c.preloadDBGoal().First(&dbGoal, id).Error; err != nil {
+		return dbmodel.Goal{}, err
+	}
+	return dbGoal, nil
+}
+
+func (c *client) GetGoalList(ctx context.Context) ([]dinkur.Goal, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	dbGoals, err := c.withContext(ctx).listDBGoals()
+	if err != nil {
+		return nil, err
+	}
+	return fromdb.GoalSlice(dbGoals), nil
+}
+
+func (c *client) listDBGoals() ([]dbmodel.Goal, error) {
+	var dbGoals []dbmodel.Goal
+	err := c.preloadDBGoal().
+		Order(dbmodel.GoalColumnPeriod).
+		Order(dbmodel.GoalColumnProjectID).
+		Find(&dbGoals).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return dbGoals, nil
+}
+
+func (c *client) CreateGoal(ctx context.Context, goal dinkur.NewGoal) (dinkur.Goal, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.Goal{}, err
+	}
+	period, err := dbGoalPeriod(goal.Period)
+	if err != nil {
+		return dinkur.Goal{}, err
+	}
+	dbGoal := dbmodel.Goal{
+		Period:    period,
+		Target:    goal.Target,
+		ProjectID: conv.ZeroAsNil(goal.ProjectIDOrZero),
+	}
+	if err := validateGoal(dbGoal); err != nil {
+		return dinkur.Goal{}, err
+	}
+	err = c.withContext(ctx).transaction(func(tx *client) (tranErr error) {
+		dbGoal, tranErr = tx.createDBGoalNoTran(dbGoal)
+		return
+	})
+	if err != nil {
+		return dinkur.Goal{}, err
+	}
+	return fromdb.Goal(dbGoal), nil
+}
+
+func (c *client) createDBGoalNoTran(dbGoal dbmodel.Goal) (dbmodel.Goal, error) {
+	if err := c.assertDBGoalProjectExistsNoTran(dbGoal); err != nil {
+		return dbmodel.Goal{}, err
+	}
+	if err := c.assertDBGoalIsUniqueNoTran(dbGoal); err != nil {
+		return dbmodel.Goal{}, err
+	}
+	if err := c.db.Create(&dbGoal).Error; err != nil {
+		return dbmodel.Goal{}, fmt.Errorf("create goal: %w", err)
+	}
+	return c.getDBGoal(dbGoal.ID)
+}
+
+func (c *client) UpdateGoal(ctx context.Context, edit dinkur.EditGoal) (dinkur.UpdatedGoal, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.UpdatedGoal{}, err
+	}
+	var update updatedDBGoal
+	err := c.withContext(ctx).transaction(func(tx *client) (tranErr error) {
+		update, tranErr = tx.editDBGoalNoTran(edit)
+		return
+	})
+	if err != nil {
+		return dinkur.UpdatedGoal{}, err
+	}
+	return dinkur.UpdatedGoal{
+		Before: fromdb.Goal(update.before),
+		After:  fromdb.Goal(update.after),
+	}, nil
+}
+
+type updatedDBGoal struct {
+	before dbmodel.Goal
+	after  dbmodel.Goal
+}
+
+func (c *client) editDBGoalNoTran(edit dinkur.EditGoal) (updatedDBGoal, error) {
+	dbGoal, err := c.getDBGoal(edit.ID)
+	if err != nil {
+		return updatedDBGoal{}, fmt.Errorf("get goal by ID: %d: %w", edit.ID, err)
+	}
+	goalBeforeEdit := dbGoal
+	if edit.Period != nil {
+		period, err := dbGoalPeriod(*edit.Period)
+		if err != nil {
+			return updatedDBGoal{}, err
+		}
+		dbGoal.Period = period
+	}
+	if edit.Target != nil {
+		dbGoal.Target = *edit.Target
+	}
+	if edit.RemoveProject {
+		dbGoal.ProjectID = nil
+	} else if edit.ProjectIDOrZero != 0 {
+		dbGoal.ProjectID = conv.ZeroAsNil(edit.ProjectIDOrZero)
+	}
+	dbGoal.Project = nil
+	if dbGoal.Period == goalBeforeEdit.Period &&
+		dbGoal.Target == goalBeforeEdit.Target &&
+		conv.DerefOrZero(dbGoal.ProjectID) == conv.DerefOrZero(goalBeforeEdit.ProjectID) {
+		return updatedDBGoal{
+			before: goalBeforeEdit,
+			after:  goalBeforeEdit,
+		}, nil
+	}
+	if err := validateGoal(dbGoal); err != nil {
+		return updatedDBGoal{}, err
+	}
+	if err := c.assertDBGoalProjectExistsNoTran(dbGoal); err != nil {
+		return updatedDBGoal{}, err
+	}
+	if err := c.assertDBGoalIsUniqueNoTran(dbGoal); err != nil {
+		return updatedDBGoal{}, err
+	}
+	if err := c.db.Save(&dbGoal).Error; err != nil {
+		return updatedDBGoal{}, fmt.Errorf("save updated goal: %w", err)
+	}
+	dbGoal, err = c.getDBGoal(dbGoal.ID)
+	if err != nil {
+		return updatedDBGoal{}, fmt.Errorf("get updated goal: %w", err)
+	}
+	return updatedDBGoal{
+		before: goalBeforeEdit,
+		after:  dbGoal,
+	}, nil
+}
+
+func (c *client) DeleteGoal(ctx context.Context, id uint) (dinkur.Goal, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.Goal{}, err
+	}
+	var dbGoal dbmodel.Goal
+	err := c.withContext(ctx).transaction(func(tx *client) (tranErr error) {
+		dbGoal, tranErr = tx.deleteDBGoalNoTran(id)
+		return
+	})
+	if err != nil {
+		return dinkur.Goal{}, err
+	}
+	return fromdb.Goal(dbGoal), nil
+}
+
+func (c *client) deleteDBGoalNoTran(id uint) (dbmodel.Goal, error) {
+	dbGoal, err := c.getDBGoal(id)
+	if err != nil {
+		return dbmodel.Goal{}, fmt.Errorf("get goal to delete: %w", err)
+	}
+	if err := c.db.Delete(&dbmodel.Goal{}, id).Error; err != nil {
+		return dbmodel.Goal{}, fmt.Errorf("delete goal: %w", err)
+	}
+	return dbGoal, nil
+}
+
+func (c *client) GetGoalProgress(ctx context.Context, search dinkur.SearchGoalProgress) ([]dinkur.GoalProgress, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	loc, err := loadTimeZone(search.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	at := now
+	if search.At != nil {
+		at = *search.At
+	}
+	at = at.In(loc)
+	var progress []dinkur.GoalProgress
+	err = c.withContext(ctx).transaction(func(tx *client) error {
+		dbGoals, err := tx.listDBGoals()
+		if err != nil {
+			return fmt.Errorf("list goals: %w", err)
+		}
+		progress = make([]dinkur.GoalProgress, len(dbGoals))
+		for i, dbGoal := range dbGoals {
+			goal := fromdb.Goal(dbGoal)
+			span := goal.Period.Span(at)
+			tracked, err := tx.sumDBEntryDurationsNoTran(*span.Start, *span.End, now, dbGoal.ProjectID)
+			if err != nil {
+				return fmt.Errorf("sum entries of goal #%d: %w", dbGoal.ID, err)
+			}
+			progress[i] = dinkur.GoalProgress{
+				Goal:    goal,
+				Start:   *span.Start,
+				End:     *span.End,
+				Tracked: tracked,
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+// sumDBEntryDurationsNoTran sums up the durations of the entries within the
+// time span, optionally only of a project. Entries that partially overlap the
+// time span are only counted for the overlapping part, and active entries are
+// counted up until now.
+func (c *client) sumDBEntryDurationsNoTran(start, end, now time.Time, projectID *uint) (time.Duration, error) {
+	var dbEntries []dbmodel.Entry
+	q := c.db.Model(&dbmodel.Entry{}).
+		Where(dbmodel.EntryColumnStart+" < ?", end.UTC()).
+		Where(fmt.Sprintf("(%[1]s IS NULL OR %[1]s > ?)", dbmodel.EntryColumnEnd), start.UTC())
+	if projectID != nil {
+		q = q.Where(dbmodel.EntryColumnProjectID+" = ?", *projectID)
+	}
+	if err := q.Find(&dbEntries).Error; err != nil {
+		return 0, err
+	}
+	var sum time.Duration
+	for _, dbEntry := range dbEntries {
+		entryStart := dbEntry.Start
+		entryEnd := now
+		if dbEntry.End != nil {
+			entryEnd = *dbEntry.End
+		}
+		if entryStart.Before(start) {
+			entryStart = start
+		}
+		if entryEnd.After(end) {
+			entryEnd = end
+		}
+		if entryEnd.After(entryStart) {
+			sum += entryEnd.Sub(entryStart)
+		}
+	}
+	return sum, nil
+}
+
+func (c *client) assertDBGoalProjectExistsNoTran(dbGoal dbmodel.Goal) error {
+	if dbGoal.ProjectID == nil {
+		return nil
+	}
+	if _, err := c.getDBProject(*dbGoal.ProjectID); err != nil {
+		return fmt.Errorf("get project by ID: %d: %w", *dbGoal.ProjectID, err)
+	}
+	return nil
+}
+
+func (c *client) assertDBGoalIsUniqueNoTran(dbGoal dbmodel.Goal) error {
+	var existing dbmodel.Goal
+	q := c.db.Where(dbmodel.GoalColumnPeriod+" = ?", dbGoal.Period)
+	if dbGoal.ProjectID != nil {
+		q = q.Where(dbmodel.GoalColumnProjectID+" = ?", *dbGoal.ProjectID)
+	} else {
+		q = q.Where(dbmodel.GoalColumnProjectID + " IS NULL")
+	}
+	if err := q.First(&existing).Error; err != nil {
+		if errors.Is(err, dinkur.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("check for duplicate goal: %w", err)
+	}
+	if existing.ID == dbGoal.ID {
+		return nil
+	}
+	return fmt.Errorf("goal #%d: %w", existing.ID, dinkur.ErrGoalExists)
+}
+
+func validateGoal(dbGoal dbmodel.Goal) error {
+	if dbGoal.Target <= 0 {
+		return dinkur.ErrGoalTargetInvalid
+	}
+	return nil
+}
+
+func dbGoalPeriod(period dinkur.GoalPeriod) (string, error) {
+	switch period {
+	case dinkur.GoalPeriodDay:
+		return dbmodel.GoalPeriodDay, nil
+	case dinkur.GoalPeriodWeek:
+		return dbmodel.GoalPeriodWeek, nil
+	default:
+		return "", fmt.Errorf("%w: %d", dinkur.ErrGoalPeriodInvalid, period)
+	}
+}
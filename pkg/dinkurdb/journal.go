@@ -0,0 +1,489 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromdb"
+	"gopkg.in/typ.v4/slices"
+)
+
+// journalSnapshot is a snapshot of an entry, as stored in the entry journal.
+type journalSnapshot struct {
+	ID        uint       `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	Name      string     `json:"name"`
+	Note      string     `json:"note,omitempty"`
+	Start     time.Time  `json:"start"`
+	End       *time.Time `json:"end,omitempty"`
+	ProjectID *uint      `json:"projectId,omitempty"`
+	Tags      []string   `json:"tags,omitempty"`
+}
+
+func newJournalSnapshot(dbEntry dbmodel.Entry) *journalSnapshot {
+	return &journalSnapshot{
+		ID:        dbEntry.ID,
+		CreatedAt: dbEntry.CreatedAt,
+		UpdatedAt: dbEntry.UpdatedAt,
+		Name:      dbEntry.Name,
+		Note:      dbEntry.Note,
+		Start:     dbEntry.Start,
+		End:       dbEntry.End,
+		ProjectID: dbEntry.ProjectID,
+		Tags:      fromdb.EntryTagNames(dbEntry.Tags),
+	}
+}
+
+func newJournalSnapshotPtr(dbEntry *dbmodel.Entry) *journalSnapshot {
+	if dbEntry == nil {
+		return nil
+	}
+	return newJournalSnapshot(*dbEntry)
+}
+
+func (s journalSnapshot) dbEntry() dbmodel.Entry {
+	return dbmodel.Entry{
+		CommonFields: dbmodel.CommonFields{
+			ID:        s.ID,
+			CreatedAt: s.CreatedAt,
+			UpdatedAt: s.UpdatedAt,
+		},
+		Name:      s.Name,
+		Note:      s.Note,
+		Start:     s.Start,
+		End:       s.End,
+		ProjectID: s.ProjectID,
+		Tags:      newDBEntryTags(s.ID, s.Tags),
+	}
+}
+
+// journalChange is a change to a single entry, as stored in the entry journal.
+// Before is nil for created entries, and After is nil for deleted entries.
+type journalChange struct {
+	Before *journalSnapshot `json:"before,omitempty"`
+	After  *journalSnapshot `json:"after,omitempty"`
+}
+
+func (ch journalChange) entryID() uint {
+	if ch.After != nil {
+		return ch.After.ID
+	}
+	if ch.Before != nil {
+		return ch.Before.ID
+	}
+	return 0
+}
+
+func createdJournalChange(dbEntry dbmodel.Entry) journalChange {
+	return journalChange{After: newJournalSnapshot(dbEntry)}
+}
+
+func updatedJournalChange(update updatedDBEntry) journalChange {
+	return journalChange{
+		Before: newJournalSnapshot(update.before),
+		After:  newJournalSnapshot(update.after),
+	}
+}
+
+func deletedJournalChange(dbEntry dbmodel.Entry) journalChange {
+	return journalChange{Before: newJournalSnapshot(dbEntry)}
+}
+
+func stoppedJournalChange(stopped dbmodel.Entry) journalChange {
+	before := newJournalSnapshot(stopped)
+	before.End = nil
+	return journalChange{
+		Before: before,
+		After:  newJournalSnapshot(stopped),
+	}
+}
+
+func startedJournalChanges(startedEntry startedDBEntry) []journalChange {
+	var changes []journalChange
+	if startedEntry.stopped != nil {
+		changes = append(changes, stoppedJournalChange(*startedEntry.stopped))
+	}
+	changes = append(changes, slices.Map(startedEntry.overlaps, changedJournalChange)...)
+	return append(changes, createdJournalChange(startedEntry.started))
+}
+
+func (c *client) appendJournalNoTran(action string, revertsID *uint, changes []journalChange) error {
+	b, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("encode journal changes: %w", err)
+	}
+	record := dbmodel.EntryJournal{
+		Action:    action,
+		RevertsID: revertsID,
+		Changes:   string(b),
+	}
+	if err := c.db.Create(&record).Error; err != nil {
+		return fmt.Errorf("append to entry journal: %w", err)
+	}
+	return nil
+}
+
+func (c *client) appendJournalUpdateNoTran(update updatedDBEntry) error {
+	change := updatedJournalChange(update)
+	if reflect.DeepEqual(change.Before, change.After) {
+		return nil
+	}
+	changes := append(slices.Map(update.overlaps, changedJournalChange), change)
+	return c.appendJournalNoTran(dbmodel.JournalActionUpdate, nil, changes)
+}
+
+// journalStacksNoTran replays the entry journal to figure out which records
+// can be undone and redone. The last element of each slice is the next record
+// to undo or redo, respectively.
+//
+// Records without any changes left, after all their entries have been purged
+// from the trash, are left out of both slices. They are still replayed, as
+// older undo and redo records refer to them. Undo and redo records therefore
+// move the record they refer to, which is not necessarily the last element
+// when any pruned records are left out.
+func (c *client) journalStacksNoTran() (undo, redo []uint, err error) {
+	var records []dbmodel.EntryJournal
+	err = c.db.Model(&dbmodel.EntryJournal{}).
+		Select(dbmodel.EntryJournalColumnID, dbmodel.EntryJournalColumnAction,
+			dbmodel.EntryJournalColumnRevertsID, dbmodel.EntryJournalColumnChanges).
+		Order(dbmodel.EntryJournalColumnID).
+		Find(&records).
+		Error
+	if err != nil {
+		return nil, nil, fmt.Errorf("list entry journal: %w", err)
+	}
+	pruned := map[uint]struct{}{}
+	for _, record := range records {
+		if record.Changes == "[]" {
+			pruned[record.ID] = struct{}{}
+		}
+		switch record.Action {
+		case dbmodel.JournalActionUndo:
+			if record.RevertsID != nil {
+				var ok bool
+				if undo, ok = removeLastUint(undo, *record.RevertsID); ok {
+					redo = append(redo, *record.RevertsID)
+				}
+			}
+		case dbmodel.JournalActionRedo:
+			if record.RevertsID != nil {
+				var ok bool
+				if redo, ok = removeLastUint(redo, *record.RevertsID); ok {
+					undo = append(undo, *record.RevertsID)
+				}
+			}
+		default:
+			undo = append(undo, record.ID)
+			redo = redo[:0]
+		}
+	}
+	notPruned := func(id uint) bool {
+		_, ok := pruned[id]
+		return !ok
+	}
+	return slices.Filter(undo, notPruned), slices.Filter(redo, notPruned), nil
+}
+
+func removeLastUint(slice []uint, value uint) ([]uint, bool) {
+	for i := len(slice) - 1; i >= 0; i-- {
+		if slice[i] == value {
+			return append(slice[:i], slice[i+1:]...), true
+		}
+	}
+	return slice, false
+}
+
+// pruneJournalNoTran removes the changes of the given entries from all entry
+// journal records, so that undoing or redoing a record can never recreate an
+// entry that has been purged from the trash.
+func (c *client) pruneJournalNoTran(ids []uint) error {
+	var records []dbmodel.EntryJournal
+	if err := c.db.Find(&records).Error; err != nil {
+		return fmt.Errorf("list entry journal: %w", err)
+	}
+	for _, record := range records {
+		var changes []journalChange
+		if err := json.Unmarshal([]byte(record.Changes), &changes); err != nil {
+			return fmt.Errorf("decode entry journal record: %d: %w", record.ID, err)
+		}
+		kept := make([]journalChange, 0, len(changes))
+		for _, ch := range changes {
+			if !slices.Contains(ids, ch.entryID()) {
+				kept = append(kept, ch)
+			}
+		}
+		if len(kept) == len(changes) {
+			continue
+		}
+		b, err := json.Marshal(kept)
+		if err != nil {
+			return fmt.Errorf("encode entry journal record: %d: %w", record.ID, err)
+		}
+		err = c.db.Model(&record).
+			UpdateColumn(dbmodel.EntryJournalColumnChanges, string(b)).
+			Error
+		if err != nil {
+			return fmt.Errorf("update entry journal record: %d: %w", record.ID, err)
+		}
+	}
+	return nil
+}
+
+func (c *client) getJournalNoTran(id uint) (dbmodel.EntryJournal, []journalChange, error) {
+	var record dbmodel.EntryJournal
+	if err := c.db.First(&record, id).Error; err != nil {
+		return dbmodel.EntryJournal{}, nil, fmt.Errorf("get entry journal record: %d: %w", id, err)
+	}
+	var changes []journalChange
+	if err := json.Unmarshal([]byte(record.Changes), &changes); err != nil {
+		return dbmodel.EntryJournal{}, nil, fmt.Errorf("decode entry journal record: %d: %w", id, err)
+	}
+	return record, changes, nil
+}
+
+type changedDBEntry struct {
+	before *dbmodel.Entry
+	after  *dbmodel.Entry
+	// restored is set if the entry was restored from the trash.
+	restored bool
+}
+
+func changedJournalChange(change changedDBEntry) journalChange {
+	return journalChange{
+		Before: newJournalSnapshotPtr(change.before),
+		After:  newJournalSnapshotPtr(change.after),
+	}
+}
+
+func fromChangedDBEntry(change changedDBEntry) dinkur.EntryChange {
+	return dinkur.EntryChange{
+		Before: fromdb.EntryPtr(change.before),
+		After:  fromdb.EntryPtr(change.after),
+	}
+}
+
+type revertedDBJournal struct {
+	action  string
+	changes []changedDBEntry
+}
+
+func (c *client) Undo(ctx context.Context) (dinkur.JournalResult, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.JournalResult{}, err
+	}
+	var reverted revertedDBJournal
+	err := c.withContext(ctx).transaction(func(tx *client) (tranErr error) {
+		reverted, tranErr = tx.undoNoTran()
+		return
+	})
+	if err != nil {
+		return dinkur.JournalResult{}, err
+	}
+	c.pubChangedDBEntries(reverted.changes)
+	return fromRevertedDBJournal(reverted), nil
+}
+
+func (c *client) undoNoTran() (revertedDBJournal, error) {
+	undo, _, err := c.journalStacksNoTran()
+	if err != nil {
+		return revertedDBJournal{}, err
+	}
+	if len(undo) == 0 {
+		return revertedDBJournal{}, dinkur.ErrNothingToUndo
+	}
+	id := undo[len(undo)-1]
+	record, changes, err := c.getJournalNoTran(id)
+	if err != nil {
+		return revertedDBJournal{}, err
+	}
+	slices.Reverse(changes)
+	targets := slices.Map(changes, func(ch journalChange) *journalSnapshot {
+		return ch.Before
+	})
+	applied, changed, err := c.applyJournalSnapshotsNoTran(changes, targets)
+	if err != nil {
+		return revertedDBJournal{}, fmt.Errorf("undo %s: %w", record.Action, err)
+	}
+	if err := c.appendJournalNoTran(dbmodel.JournalActionUndo, &id, applied); err != nil {
+		return revertedDBJournal{}, err
+	}
+	return revertedDBJournal{
+		action:  record.Action,
+		changes: changed,
+	}, nil
+}
+
+func (c *client) Redo(ctx context.Context) (dinkur.JournalResult, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.JournalResult{}, err
+	}
+	var reverted revertedDBJournal
+	err := c.withContext(ctx).transaction(func(tx *client) (tranErr error) {
+		reverted, tranErr = tx.redoNoTran()
+		return
+	})
+	if err != nil {
+		return dinkur.JournalResult{}, err
+	}
+	c.pubChangedDBEntries(reverted.changes)
+	return fromRevertedDBJournal(reverted), nil
+}
+
+func (c *client) redoNoTran() (revertedDBJournal, error) {
+	_, redo, err := c.journalStacksNoTran()
+	if err != nil {
+		return revertedDBJournal{}, err
+	}
+	if len(redo) == 0 {
+		return revertedDBJournal{}, dinkur.ErrNothingToRedo
+	}
+	id := redo[len(redo)-1]
+	record, changes, err := c.getJournalNoTran(id)
+	if err != nil {
+		return revertedDBJournal{}, err
+	}
+	targets := slices.Map(changes, func(ch journalChange) *journalSnapshot {
+		return ch.After
+	})
+	applied, changed, err := c.applyJournalSnapshotsNoTran(changes, targets)
+	if err != nil {
+		return revertedDBJournal{}, fmt.Errorf("redo %s: %w", record.Action, err)
+	}
+	if err := c.appendJournalNoTran(dbmodel.JournalActionRedo, &id, applied); err != nil {
+		return revertedDBJournal{}, err
+	}
+	return revertedDBJournal{
+		action:  record.Action,
+		changes: changed,
+	}, nil
+}
+
+// applyJournalSnapshotsNoTran sets each entry to the state of its target
+// snapshot, where a nil snapshot means the entry is deleted. Returns the
+// changes that were actually applied, both as journal changes and as the
+// changed entries.
+func (c *client) applyJournalSnapshotsNoTran(changes []journalChange, targets []*journalSnapshot) ([]journalChange, []changedDBEntry, error) {
+	var (
+		applied []journalChange
+		changed []changedDBEntry
+	)
+	for i, ch := range changes {
+		change, err := c.applyJournalSnapshotNoTran(ch.entryID(), targets[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("entry #%d: %w", ch.entryID(), err)
+		}
+		if change.before == nil && change.after == nil {
+			continue
+		}
+		applied = append(applied, changedJournalChange(change))
+		changed = append(changed, change)
+	}
+	return applied, changed, nil
+}
+
+func (c *client) applyJournalSnapshotNoTran(id uint, target *journalSnapshot) (changedDBEntry, error) {
+	var change changedDBEntry
+	current, err := c.getDBEntry(id)
+	if err != nil && !errors.Is(err, dinkur.ErrNotFound) {
+		return changedDBEntry{}, fmt.Errorf("get entry: %w", err)
+	}
+	exists := err == nil
+	if exists {
+		change.before = &current
+	}
+	if target == nil {
+		if !exists {
+			return change, nil
+		}
+		if _, err := c.deleteDBEntryNoTran(id); err != nil {
+			return changedDBEntry{}, err
+		}
+		return change, nil
+	}
+	dbEntry := target.dbEntry()
+	if dbEntry.ProjectID != nil {
+		// The project may have been removed since the snapshot was taken.
+		if _, err := c.getDBProject(*dbEntry.ProjectID); errors.Is(err, dinkur.ErrNotFound) {
+			dbEntry.ProjectID = nil
+		} else if err != nil {
+			return changedDBEntry{}, fmt.Errorf("get project by ID: %d: %w", *dbEntry.ProjectID, err)
+		}
+	}
+	if !exists {
+		// The entry may have been moved to the trash, in which case it is
+		// restored instead of recreated.
+		if _, err := c.getTrashedDBEntry(id); err == nil {
+			change.restored = true
+		} else if !errors.Is(err, dinkur.ErrNotFound) {
+			return changedDBEntry{}, fmt.Errorf("get trashed entry: %w", err)
+		}
+	}
+	if exists || change.restored {
+		// Unscoped, so the saved snapshot also clears the trashed timestamp.
+		if err := c.db.Unscoped().Omit(dbmodel.EntryFieldTags, dbmodel.EntryFieldProject).Save(&dbEntry).Error; err != nil {
+			return changedDBEntry{}, fmt.Errorf("save entry: %w", err)
+		}
+		if err := c.setDBEntryTagsNoTran(id, target.Tags); err != nil {
+			return changedDBEntry{}, fmt.Errorf("save entry tags: %w", err)
+		}
+	} else {
+		if err := c.db.Omit(dbmodel.EntryFieldProject).Create(&dbEntry).Error; err != nil {
+			return changedDBEntry{}, fmt.Errorf("recreate entry: %w", err)
+		}
+	}
+	after, err := c.getDBEntry(id)
+	if err != nil {
+		return changedDBEntry{}, fmt.Errorf("get reverted entry: %w", err)
+	}
+	change.after = &after
+	return change, nil
+}
+
+func (c *client) pubChangedDBEntries(changes []changedDBEntry) {
+	for _, ch := range changes {
+		switch {
+		case ch.restored && ch.after != nil:
+			c.entryObs.PubWait(entryEvent{dbEntry: *ch.after, event: dinkur.EventRestored})
+		case ch.before == nil && ch.after != nil:
+			c.entryObs.PubWait(entryEvent{dbEntry: *ch.after, event: dinkur.EventCreated})
+		case ch.after == nil && ch.before != nil:
+			c.entryObs.PubWait(entryEvent{dbEntry: *ch.before, event: dinkur.EventDeleted})
+		case ch.after != nil:
+			c.entryObs.PubWait(entryEvent{dbEntry: *ch.after, event: dinkur.EventUpdated})
+		}
+	}
+}
+
+func fromRevertedDBJournal(reverted revertedDBJournal) dinkur.JournalResult {
+	return dinkur.JournalResult{
+		Action:  fromdb.JournalAction(reverted.action),
+		Changes: slices.Map(reverted.changes, fromChangedDBEntry),
+	}
+}
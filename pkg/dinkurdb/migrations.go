@@ -72,6 +72,33 @@ func getMigrationStatus(db *gorm.DB) (dbmodel.MigrationVersion, error) {
 	return v, nil
 }
 
+// entriesIdxTriggersSQL creates the triggers that keeps the FTS5 (Sqlite
+// free-text search) virtual table up-to-date. Trashed entries are left out of
+// the index, and are added back when restored.
+const entriesIdxTriggersSQL = `
+CREATE TRIGGER entries_idx_insert AFTER INSERT ON entries WHEN new.deleted_at IS NULL BEGIN
+	INSERT INTO entries_idx(rowid, name, note) VALUES (new.id, new.name, new.note);
+END;
+CREATE TRIGGER entries_idx_delete AFTER DELETE ON entries WHEN old.deleted_at IS NULL BEGIN
+	INSERT INTO entries_idx(entries_idx, rowid, name, note) VALUES ('delete', old.id, old.name, old.note);
+END;
+CREATE TRIGGER entries_idx_update AFTER UPDATE ON entries BEGIN
+	INSERT INTO entries_idx(entries_idx, rowid, name, note) SELECT 'delete', old.id, old.name, old.note WHERE old.deleted_at IS NULL;
+	INSERT INTO entries_idx(rowid, name, note) SELECT new.id, new.name, new.note WHERE new.deleted_at IS NULL;
+END;
+`
+
+// entriesIdxCreateSQL creates the FTS5 (Sqlite free-text search) virtual
+// table and triggers to keep it up-to-date. Lastly it feeds it data from
+// existing entries table in case of old data.
+const entriesIdxCreateSQL = `
+CREATE VIRTUAL TABLE entries_idx USING fts5(name, note, content='entries',
+	tokenize="porter trigram"
+);
+` + entriesIdxTriggersSQL + `
+INSERT INTO entries_idx (rowid, name, note) SELECT id, name, note FROM entries WHERE deleted_at IS NULL;
+`
+
 func (c *client) Migrate(ctx context.Context) error {
 	if err := c.assertConnected(); err != nil {
 		return err
@@ -120,8 +147,15 @@ func (c *client) migrateNoTran() error {
 	}
 	tables := []any{
 		dbmodel.Migration{},
+		dbmodel.Project{},
 		dbmodel.Entry{},
+		dbmodel.EntryTag{},
 		dbmodel.Status{},
+		dbmodel.EntryJournal{},
+		dbmodel.EntryTemplate{},
+		dbmodel.EntryTemplateTag{},
+		dbmodel.EntryRecurrence{},
+		dbmodel.Goal{},
 		// Note: Do not add EntryFTS5 to auto migration! It is created separately
 		// through manual SQL queries down below.
 	}
@@ -138,25 +172,20 @@ func (c *client) migrateNoTran() error {
 	}
 	log.Debug().Message("Done with auto migrations.")
 	if oldVersion < 4 || !c.db.Migrator().HasTable("entries_idx") {
-		// Creates FTS5 (Sqlite free-text search) virtual table
-		// and triggers to keep it up-to-date.
-		// Lastly it feeds it data from existing entries table in case of old data.
+		if err := c.db.Exec(entriesIdxCreateSQL).Error; err != nil {
+			return err
+		}
+	} else if oldVersion < 13 {
+		// Recreates the FTS5 virtual table and its triggers to also index the
+		// entry notes. The virtual table's columns cannot be altered, so the
+		// index is dropped and rebuilt from the entries table. This also
+		// covers version 12, where trashed entries were excluded.
 		err = c.db.Exec(`
-CREATE VIRTUAL TABLE entries_idx USING fts5(name, content='entries',
-	tokenize="porter trigram"
-);
-CREATE TRIGGER entries_idx_insert AFTER INSERT ON entries BEGIN
-	INSERT INTO entries_idx(rowid, name) VALUES (new.id, new.name);
-END;
-CREATE TRIGGER entries_idx_delete AFTER DELETE ON entries BEGIN
-	INSERT INTO entries_idx(entries_idx, rowid, name) VALUES ('delete', old.id, old.name);
-END;
-CREATE TRIGGER entries_idx_update AFTER UPDATE ON entries BEGIN
-	INSERT INTO entries_idx(entries_idx, rowid, name) VALUES ('delete', old.id, old.name);
-	INSERT INTO entries_idx(rowid, name) VALUES (new.id, new.name);
-END;
-INSERT INTO entries_idx (rowid, name) SELECT id, name FROM entries;
-`).Error
+DROP TRIGGER IF EXISTS entries_idx_insert;
+DROP TRIGGER IF EXISTS entries_idx_delete;
+DROP TRIGGER IF EXISTS entries_idx_update;
+DROP TABLE IF EXISTS entries_idx;
+` + entriesIdxCreateSQL).Error
 		if err != nil {
 			return err
 		}
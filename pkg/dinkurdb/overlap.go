@@ -0,0 +1,222 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromdb"
+	"gopkg.in/typ.v4"
+	"gopkg.in/typ.v4/slices"
+)
+
+// entrySQLOverlaps matches entries whose time span overlaps the time span
+// between @start and @end, where active entries are treated as ending @now.
+// Entries that only touch the time span are not matched.
+var entrySQLOverlaps = fmt.Sprintf(
+	"%[1]s < @end AND COALESCE(%[2]s, @now) > @start",
+	dbmodel.EntryColumnStart, dbmodel.EntryColumnEnd,
+)
+
+func (c *client) GetEntryOverlaps(ctx context.Context, search dinkur.SearchEntry) ([]dinkur.EntryOverlap, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	dbEntries, err := c.withContext(ctx).listDBEntries(bulkSearch(search))
+	if err != nil {
+		return nil, err
+	}
+	var overlaps []dinkur.EntryOverlap
+	for i, first := range dbEntries {
+		firstEnd := conv.TimeOrNow(first.End)
+		for _, second := range dbEntries[i+1:] {
+			if !second.Start.Before(firstEnd) {
+				break
+			}
+			overlaps = append(overlaps, dinkur.EntryOverlap{
+				First:  fromdb.Entry(first),
+				Second: fromdb.Entry(second),
+			})
+		}
+	}
+	return overlaps, nil
+}
+
+func (c *client) ResolveEntryOverlaps(ctx context.Context, resolve dinkur.ResolveEntryOverlaps) ([]dinkur.EntryChange, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	if resolve.Policy != dinkur.OverlapPolicyTrim && resolve.Policy != dinkur.OverlapPolicySplit {
+		return nil, fmt.Errorf("%w: cannot resolve overlaps using policy %q",
+			dinkur.ErrOverlapPolicyInvalid, resolve.Policy)
+	}
+	var changes []changedDBEntry
+	err := c.withContext(ctx).transaction(func(tx *client) error {
+		var err error
+		changes, err = tx.resolveDBEntryOverlapsNoTran(resolve)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		return tx.appendJournalNoTran(dbmodel.JournalActionUpdate, nil,
+			slices.Map(changes, changedJournalChange))
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.pubChangedDBEntries(changes)
+	return slices.Map(changes, fromChangedDBEntry), nil
+}
+
+func (c *client) resolveDBEntryOverlapsNoTran(resolve dinkur.ResolveEntryOverlaps) ([]changedDBEntry, error) {
+	dbEntries, err := c.listDBEntries(bulkSearch(resolve.Search))
+	if err != nil {
+		return nil, fmt.Errorf("list entries to resolve: %w", err)
+	}
+	// the entry that started last takes precedence, so go through them in
+	// reverse order
+	sort.SliceStable(dbEntries, func(i, j int) bool {
+		if dbEntries[i].Start.Equal(dbEntries[j].Start) {
+			return dbEntries[i].ID > dbEntries[j].ID
+		}
+		return dbEntries[i].Start.After(dbEntries[j].Start)
+	})
+	var changes []changedDBEntry
+	for _, dbEntry := range dbEntries {
+		// the entry may have been changed or removed when resolving the
+		// previous entries, so it has to be fetched again
+		current, err := c.getDBEntry(dbEntry.ID)
+		if errors.Is(err, dinkur.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get entry #%d: %w", dbEntry.ID, err)
+		}
+		entryChanges, err := c.applyOverlapPolicyNoTran(current, resolve.Policy)
+		if err != nil {
+			return nil, fmt.Errorf("entry #%d: %w", dbEntry.ID, err)
+		}
+		changes = append(changes, entryChanges...)
+	}
+	return changes, nil
+}
+
+// overlappingDBEntriesNoTran returns the entries that overlaps the given entry,
+// excluding the entry itself, sorted by start time.
+func (c *client) overlappingDBEntriesNoTran(dbEntry dbmodel.Entry) ([]dbmodel.Entry, error) {
+	now := time.Now().UTC()
+	var dbEntries []dbmodel.Entry
+	err := c.preloadDBEntry().
+		Where(entrySQLOverlaps,
+			sql.Named("start", dbEntry.Start.UTC()),
+			sql.Named("end", conv.TimeOrNow(dbEntry.End).UTC()),
+			sql.Named("now", now)).
+		Where(dbmodel.EntryColumnID+" <> ?", dbEntry.ID).
+		Order(dbmodel.EntryColumnStart).
+		Find(&dbEntries).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return dbEntries, nil
+}
+
+// applyOverlapPolicyNoTran changes the entries that overlaps the given entry
+// according to the policy, while leaving the given entry as-is. Returns the
+// changes made to the overlapped entries.
+func (c *client) applyOverlapPolicyNoTran(dbEntry dbmodel.Entry, policy dinkur.OverlapPolicy) ([]changedDBEntry, error) {
+	if policy == dinkur.OverlapPolicyAllow {
+		return nil, nil
+	}
+	neighbors, err := c.overlappingDBEntriesNoTran(dbEntry)
+	if err != nil {
+		return nil, fmt.Errorf("find overlapping entries: %w", err)
+	}
+	if len(neighbors) == 0 {
+		return nil, nil
+	}
+	if policy == dinkur.OverlapPolicyReject {
+		return nil, fmt.Errorf("%w: #%d %q", dinkur.ErrEntryOverlaps, neighbors[0].ID, neighbors[0].Name)
+	}
+	end := conv.TimeOrNow(dbEntry.End).UTC()
+	var changes []changedDBEntry
+	for i := range neighbors {
+		neighbor := &neighbors[i]
+		before := *neighbor
+		neighborEnd := conv.TimeOrNow(neighbor.End).UTC()
+		var tail *dbmodel.Entry
+		switch {
+		case neighbor.Start.Before(dbEntry.Start):
+			if policy == dinkur.OverlapPolicySplit && dbEntry.End != nil && neighborEnd.After(end) {
+				tail = &dbmodel.Entry{
+					Name:      neighbor.Name,
+					Note:      neighbor.Note,
+					Start:     end,
+					End:       neighbor.End,
+					Tags:      newDBEntryTags(0, fromdb.EntryTagNames(neighbor.Tags)),
+					ProjectID: neighbor.ProjectID,
+					Project:   neighbor.Project,
+				}
+			}
+			neighbor.End = typ.Ref(dbEntry.Start.UTC())
+		case dbEntry.End != nil && neighborEnd.After(end):
+			neighbor.Start = end
+		default:
+			deleted, err := c.deleteDBEntryNoTran(neighbor.ID)
+			if err != nil {
+				return nil, fmt.Errorf("remove overlapped entry #%d: %w", neighbor.ID, err)
+			}
+			changes = append(changes, changedDBEntry{before: &deleted})
+			continue
+		}
+		if err := c.db.Omit(dbmodel.EntryFieldTags, dbmodel.EntryFieldProject).Save(neighbor).Error; err != nil {
+			return nil, fmt.Errorf("trim overlapped entry #%d: %w", neighbor.ID, err)
+		}
+		changes = append(changes, changedDBEntry{before: &before, after: neighbor})
+		if tail != nil {
+			if err := c.db.Omit(dbmodel.EntryFieldProject).Create(tail).Error; err != nil {
+				return nil, fmt.Errorf("split overlapped entry #%d: %w", neighbor.ID, err)
+			}
+			changes = append(changes, changedDBEntry{after: tail})
+		}
+	}
+	return changes, nil
+}
+
+func dbEntryTimesEqual(a, b dbmodel.Entry) bool {
+	if !a.Start.Equal(b.Start) {
+		return false
+	}
+	if a.End == nil || b.End == nil {
+		return a.End == nil && b.End == nil
+	}
+	return a.End.Equal(*b.End)
+}
@@ -0,0 +1,259 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromdb"
+)
+
+func (c *client) GetProject(ctx context.Context, id uint) (dinkur.Project, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.Project{}, err
+	}
+	dbProject, err := c.withContext(ctx).getDBProject(id)
+	if err != nil {
+		return dinkur.Project{}, err
+	}
+	return fromdb.Project(dbProject), nil
+}
+
+func (c *client) getDBProject(id uint) (dbmodel.Project, error) {
+	var dbProject dbmodel.Project
+	if err := c.db.First(&dbProject, id).Error; err != nil {
+		return dbmodel.Project{}, err
+	}
+	return dbProject, nil
+}
+
+func (c *client) GetProjectList(ctx context.Context, search dinkur.SearchProject) ([]dinkur.Project, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	dbProjects, err := c.withContext(ctx).listDBProjects(search)
+	if err != nil {
+		return nil, err
+	}
+	return fromdb.ProjectSlice(dbProjects), nil
+}
+
+func (c *client) listDBProjects(search dinkur.SearchProject) ([]dbmodel.Project, error) {
+	var dbProjects []dbmodel.Project
+	q := c.db.Model(&dbmodel.Project{}).
+		Order(dbmodel.ProjectColumnClient).
+		Order(dbmodel.ProjectColumnName)
+	if name := strings.TrimSpace(search.Name); name != "" {
+		q = q.Where(dbmodel.ProjectColumnName+" = ?", name)
+	}
+	if client := strings.TrimSpace(search.Client); client != "" {
+		q = q.Where(dbmodel.ProjectColumnClient+" = ?", client)
+	}
+	if err := q.Find(&dbProjects).Error; err != nil {
+		return nil, err
+	}
+	return dbProjects, nil
+}
+
+func (c *client) CreateProject(ctx context.Context, project dinkur.NewProject) (dinkur.Project, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.Project{}, err
+	}
+	dbProject := dbmodel.Project{
+		Name:   strings.TrimSpace(project.Name),
+		Client: strings.TrimSpace(project.Client),
+	}
+	if err := validateProject(dbProject); err != nil {
+		return dinkur.Project{}, err
+	}
+	err := c.withContext(ctx).transaction(func(tx *client) error {
+		return tx.createDBProjectNoTran(&dbProject)
+	})
+	if err != nil {
+		return dinkur.Project{}, err
+	}
+	return fromdb.Project(dbProject), nil
+}
+
+func (c *client) createDBProjectNoTran(dbProject *dbmodel.Project) error {
+	if err := c.assertDBProjectIsUniqueNoTran(*dbProject); err != nil {
+		return err
+	}
+	if err := c.db.Create(dbProject).Error; err != nil {
+		return fmt.Errorf("create project: %w", err)
+	}
+	return nil
+}
+
+func (c *client) getOrCreateDBProjectNoTran(dbProject dbmodel.Project) (dbmodel.Project, error) {
+	var existing dbmodel.Project
+	err := c.db.
+		Where(dbmodel.ProjectColumnName+" = ?", dbProject.Name).
+		Where(dbmodel.ProjectColumnClient+" = ?", dbProject.Client).
+		First(&existing).
+		Error
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, dinkur.ErrNotFound) {
+		return dbmodel.Project{}, fmt.Errorf("get project by name: %w", err)
+	}
+	if err := c.createDBProjectNoTran(&dbProject); err != nil {
+		return dbmodel.Project{}, err
+	}
+	return dbProject, nil
+}
+
+func (c *client) UpdateProject(ctx context.Context, edit dinkur.EditProject) (dinkur.UpdatedProject, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.UpdatedProject{}, err
+	}
+	var update updatedDBProject
+	err := c.withContext(ctx).transaction(func(tx *client) (tranErr error) {
+		update, tranErr = tx.editDBProjectNoTran(edit)
+		return
+	})
+	if err != nil {
+		return dinkur.UpdatedProject{}, err
+	}
+	return dinkur.UpdatedProject{
+		Before: fromdb.Project(update.before),
+		After:  fromdb.Project(update.after),
+	}, nil
+}
+
+type updatedDBProject struct {
+	before dbmodel.Project
+	after  dbmodel.Project
+}
+
+func (c *client) editDBProjectNoTran(edit dinkur.EditProject) (updatedDBProject, error) {
+	dbProject, err := c.getDBProject(edit.ID)
+	if err != nil {
+		return updatedDBProject{}, fmt.Errorf("get project by ID: %d: %w", edit.ID, err)
+	}
+	projectBeforeEdit := dbProject
+	if edit.Name != nil {
+		dbProject.Name = strings.TrimSpace(*edit.Name)
+	}
+	if edit.Client != nil {
+		dbProject.Client = strings.TrimSpace(*edit.Client)
+	}
+	if dbProject.Name == projectBeforeEdit.Name &&
+		dbProject.Client == projectBeforeEdit.Client {
+		return updatedDBProject{
+			before: projectBeforeEdit,
+			after:  dbProject,
+		}, nil
+	}
+	if err := validateProject(dbProject); err != nil {
+		return updatedDBProject{}, err
+	}
+	if err := c.assertDBProjectIsUniqueNoTran(dbProject); err != nil {
+		return updatedDBProject{}, err
+	}
+	if err := c.db.Save(&dbProject).Error; err != nil {
+		return updatedDBProject{}, fmt.Errorf("save updated project: %w", err)
+	}
+	return updatedDBProject{
+		before: projectBeforeEdit,
+		after:  dbProject,
+	}, nil
+}
+
+func (c *client) DeleteProject(ctx context.Context, id uint) (dinkur.Project, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.Project{}, err
+	}
+	var dbProject dbmodel.Project
+	err := c.withContext(ctx).transaction(func(tx *client) (tranErr error) {
+		dbProject, tranErr = tx.deleteDBProjectNoTran(id)
+		return
+	})
+	if err != nil {
+		return dinkur.Project{}, err
+	}
+	return fromdb.Project(dbProject), nil
+}
+
+func (c *client) deleteDBProjectNoTran(id uint) (dbmodel.Project, error) {
+	dbProject, err := c.getDBProject(id)
+	if err != nil {
+		return dbmodel.Project{}, fmt.Errorf("get project to delete: %w", err)
+	}
+	var goalCount int64
+	err = c.db.Model(&dbmodel.Goal{}).
+		Where(dbmodel.GoalColumnProjectID+" = ?", id).
+		Count(&goalCount).
+		Error
+	if err != nil {
+		return dbmodel.Project{}, fmt.Errorf("count goals of project: %w", err)
+	}
+	if goalCount > 0 {
+		return dbmodel.Project{}, fmt.Errorf("%w: %d goal(s)", dinkur.ErrProjectHasGoals, goalCount)
+	}
+	err = c.db.Unscoped().Model(&dbmodel.Entry{}).
+		Where(dbmodel.EntryColumnProjectID+" = ?", id).
+		Update(dbmodel.EntryColumnProjectID, nil).
+		Error
+	if err != nil {
+		return dbmodel.Project{}, fmt.Errorf("remove project from entries: %w", err)
+	}
+	if err := c.db.Delete(&dbmodel.Project{}, id).Error; err != nil {
+		return dbmodel.Project{}, fmt.Errorf("delete project: %w", err)
+	}
+	return dbProject, nil
+}
+
+func (c *client) assertDBProjectIsUniqueNoTran(dbProject dbmodel.Project) error {
+	var existing dbmodel.Project
+	err := c.db.
+		Where(dbmodel.ProjectColumnName+" = ?", dbProject.Name).
+		Where(dbmodel.ProjectColumnClient+" = ?", dbProject.Client).
+		First(&existing).
+		Error
+	if err != nil {
+		if errors.Is(err, dinkur.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("check for duplicate project: %w", err)
+	}
+	if existing.ID == dbProject.ID {
+		return nil
+	}
+	return fmt.Errorf("project #%d %q: %w", existing.ID, fromdb.Project(existing).String(), dinkur.ErrProjectExists)
+}
+
+func validateProject(dbProject dbmodel.Project) error {
+	if dbProject.Name == "" {
+		return dinkur.ErrProjectNameEmpty
+	}
+	if strings.ContainsRune(dbProject.Name, '/') ||
+		strings.ContainsRune(dbProject.Client, '/') {
+		return dinkur.ErrProjectNameInvalid
+	}
+	return nil
+}
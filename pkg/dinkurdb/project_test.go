@@ -0,0 +1,63 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+//go:build fts5
+
+package dinkurdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+func TestDeleteProjectTrackedByGoal(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, Options{})
+	project, err := c.CreateProject(ctx, dinkur.NewProject{Name: "dinkur"})
+	if err != nil {
+		t.Fatalf("create project: %s", err)
+	}
+	goal, err := c.CreateGoal(ctx, dinkur.NewGoal{
+		Period:          dinkur.GoalPeriodDay,
+		Target:          2 * time.Hour,
+		ProjectIDOrZero: project.ID,
+	})
+	if err != nil {
+		t.Fatalf("create goal: %s", err)
+	}
+
+	_, err = c.DeleteProject(ctx, project.ID)
+	if !errors.Is(err, dinkur.ErrProjectHasGoals) {
+		t.Fatalf("want error %q, got: %v", dinkur.ErrProjectHasGoals, err)
+	}
+	if _, err := c.GetGoal(ctx, goal.ID); err != nil {
+		t.Errorf("want goal kept, got error: %s", err)
+	}
+
+	if _, err := c.DeleteGoal(ctx, goal.ID); err != nil {
+		t.Fatalf("delete goal: %s", err)
+	}
+	if _, err := c.DeleteProject(ctx, project.ID); err != nil {
+		t.Errorf("want project removed once its goal is removed, got error: %s", err)
+	}
+}
@@ -85,19 +85,29 @@ func (c *client) SetStatus(ctx context.Context, edit dinkur.EditStatus) error {
 	if err := c.assertConnected(); err != nil {
 		return err
 	}
-	return c.withContext(ctx).setStatus(edit)
+	dbStatus, changed, err := c.withContext(ctx).setDBStatus(edit)
+	if err != nil || !changed {
+		return err
+	}
+	c.statusObs.PubWait(statusEvent{dbStatus})
+	return nil
 }
 
-func (c *client) setStatus(edit dinkur.EditStatus) error {
-	return c.transaction(func(tx *client) error {
-		return tx.setStatusNoTran(edit)
+func (c *client) setDBStatus(edit dinkur.EditStatus) (dbmodel.Status, bool, error) {
+	var dbStatus dbmodel.Status
+	var changed bool
+	err := c.transaction(func(tx *client) error {
+		var err error
+		dbStatus, changed, err = tx.setDBStatusNoTran(edit)
+		return err
 	})
+	return dbStatus, changed, err
 }
 
-func (c *client) setStatusNoTran(edit dinkur.EditStatus) error {
+func (c *client) setDBStatusNoTran(edit dinkur.EditStatus) (dbmodel.Status, bool, error) {
 	dbStatus, err := c.getDBStatusAtom()
 	if err != nil {
-		return err
+		return dbmodel.Status{}, false, err
 	}
 	var changed bool
 	if updateTimePtrUTC(&dbStatus.AFKSince, edit.AFKSince) {
@@ -107,15 +117,85 @@ func (c *client) setStatusNoTran(edit dinkur.EditStatus) error {
 		changed = true
 	}
 	if !changed {
-		return nil
+		return dbStatus, false, nil
 	}
 	if err := c.db.Save(&dbStatus).Error; err != nil {
+		return dbmodel.Status{}, false, err
+	}
+	return dbStatus, true, nil
+}
+
+func (c *client) SetLastSeenAlive(ctx context.Context, lastSeenAlive time.Time) error {
+	if err := c.assertConnected(); err != nil {
+		return err
+	}
+	return c.withContext(ctx).setDBStatusLastSeenAlive(lastSeenAlive)
+}
+
+func (c *client) setDBStatusLastSeenAlive(lastSeenAlive time.Time) error {
+	return c.transaction(func(tx *client) error {
+		return tx.setDBStatusLastSeenAliveNoTran(lastSeenAlive)
+	})
+}
+
+func (c *client) setDBStatusLastSeenAliveNoTran(lastSeenAlive time.Time) error {
+	dbStatus, err := c.getDBStatusAtom()
+	if err != nil {
+		return err
+	}
+	dbStatus.LastSeenAlive = typ.Ref(lastSeenAlive.UTC())
+	if dbStatus.ID == 0 {
+		return c.db.Create(&dbStatus).Error
+	}
+	// UpdateColumn does not touch the UpdatedAt field, as the heartbeat is
+	// not considered a change of the status.
+	return c.db.Model(&dbStatus).
+		UpdateColumn(dbmodel.StatusColumnLastSeenAlive, dbStatus.LastSeenAlive).
+		Error
+}
+
+func (c *client) SetAutoStoppedEntry(ctx context.Context, entryIDOrZero uint) error {
+	if err := c.assertConnected(); err != nil {
+		return err
+	}
+	dbStatus, changed, err := c.withContext(ctx).setDBStatusAutoStoppedEntry(entryIDOrZero)
+	if err != nil || !changed {
 		return err
 	}
 	c.statusObs.PubWait(statusEvent{dbStatus})
 	return nil
 }
 
+func (c *client) setDBStatusAutoStoppedEntry(entryIDOrZero uint) (dbmodel.Status, bool, error) {
+	var dbStatus dbmodel.Status
+	var changed bool
+	err := c.transaction(func(tx *client) error {
+		var err error
+		dbStatus, changed, err = tx.setDBStatusAutoStoppedEntryNoTran(entryIDOrZero)
+		return err
+	})
+	return dbStatus, changed, err
+}
+
+func (c *client) setDBStatusAutoStoppedEntryNoTran(entryIDOrZero uint) (dbmodel.Status, bool, error) {
+	dbStatus, err := c.getDBStatusAtom()
+	if err != nil {
+		return dbmodel.Status{}, false, err
+	}
+	if typ.DerefZero(dbStatus.AutoStoppedEntryID) == entryIDOrZero {
+		return dbStatus, false, nil
+	}
+	if entryIDOrZero != 0 {
+		dbStatus.AutoStoppedEntryID = &entryIDOrZero
+	} else {
+		dbStatus.AutoStoppedEntryID = nil
+	}
+	if err := c.db.Save(&dbStatus).Error; err != nil {
+		return dbmodel.Status{}, false, err
+	}
+	return dbStatus, true, nil
+}
+
 func updateTimePtrUTC(ptr **time.Time, newValue *time.Time) bool {
 	if (*ptr == nil) != (newValue == nil) || newValue != nil {
 		if newValue != nil {
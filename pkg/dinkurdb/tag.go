@@ -0,0 +1,106 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"gopkg.in/typ.v4/slices"
+	"gorm.io/gorm"
+)
+
+// normalizeTags trims, validates, deduplicates, and sorts a list of tag names.
+func normalizeTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if err := validateTag(tag); err != nil {
+			return nil, fmt.Errorf("tag %q: %w", tag, err)
+		}
+		normalized = append(normalized, tag)
+	}
+	normalized = slices.Distinct(normalized)
+	slices.Sort(normalized)
+	return normalized, nil
+}
+
+func validateTag(tag string) error {
+	if tag == "" {
+		return dinkur.ErrEntryTagEmpty
+	}
+	if strings.HasPrefix(tag, "-") ||
+		strings.ContainsRune(tag, ',') ||
+		strings.IndexFunc(tag, unicode.IsSpace) != -1 {
+		return dinkur.ErrEntryTagInvalid
+	}
+	return nil
+}
+
+func newDBEntryTags(entryID uint, tags []string) []dbmodel.EntryTag {
+	return slices.Map(tags, func(tag string) dbmodel.EntryTag {
+		return dbmodel.EntryTag{EntryID: entryID, Name: tag}
+	})
+}
+
+func (c *client) setDBEntryTagsNoTran(entryID uint, tags []string) error {
+	err := c.db.
+		Where(dbmodel.EntryTagColumnEntryID+" = ?", entryID).
+		Delete(&dbmodel.EntryTag{}).
+		Error
+	if err != nil {
+		return fmt.Errorf("delete old tags: %w", err)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	dbTags := newDBEntryTags(entryID, tags)
+	if err := c.db.Create(&dbTags).Error; err != nil {
+		return fmt.Errorf("create new tags: %w", err)
+	}
+	return nil
+}
+
+// whereEntryHasAllTags filters a query on the entries table to only include
+// entries that has all of the given tags.
+func (c *client) whereEntryHasAllTags(q *gorm.DB, tags []string) *gorm.DB {
+	subQ := c.db.Model(&dbmodel.EntryTag{}).
+		Select(dbmodel.EntryTagColumnEntryID).
+		Where(dbmodel.EntryTagColumnName+" IN ?", tags).
+		Group(dbmodel.EntryTagColumnEntryID).
+		Having("COUNT(*) = ?", len(tags))
+	return q.Where("entries."+dbmodel.EntryColumnID+" IN (?)", subQ)
+}
+
+// whereEntryHasNoTags filters a query on the entries table to only include
+// entries that has none of the given tags.
+func (c *client) whereEntryHasNoTags(q *gorm.DB, tags []string) *gorm.DB {
+	subQ := c.db.Model(&dbmodel.EntryTag{}).
+		Select(dbmodel.EntryTagColumnEntryID).
+		Where(dbmodel.EntryTagColumnName+" IN ?", tags)
+	return q.Where("entries."+dbmodel.EntryColumnID+" NOT IN (?)", subQ)
+}
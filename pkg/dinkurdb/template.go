@@ -0,0 +1,522 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/internal/rrule"
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromdb"
+	"gopkg.in/typ.v4/slices"
+	"gorm.io/gorm"
+)
+
+func (c *client) GetTemplate(ctx context.Context, id uint) (dinkur.Template, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.Template{}, err
+	}
+	dbTemplate, err := c.withContext(ctx).getDBTemplate(id)
+	if err != nil {
+		return dinkur.Template{}, err
+	}
+	return fromdb.Template(dbTemplate), nil
+}
+
+func (c *client) preloadDBTemplate() *gorm.DB {
+	return c.db.
+		Preload(dbmodel.EntryTemplateFieldTags).
+		Preload(dbmodel.EntryTemplateFieldRecurrence)
+}
+
+func (c *client) getDBTemplate(id uint) (dbmodel.EntryTemplate, error) {
+	var dbTemplate dbmodel.EntryTemplate
+	if err := c.preloadDBTemplate().First(&dbTemplate, id).Error; err != nil {
+		return dbmodel.EntryTemplate{}, err
+	}
+	return dbTemplate, nil
+}
+
+func (c *client) GetTemplateList(ctx context.Context, search dinkur.SearchTemplate) ([]dinkur.Template, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	dbTemplates, err := c.withContext(ctx).listDBTemplates(search)
+	if err != nil {
+		return nil, err
+	}
+	return fromdb.TemplateSlice(dbTemplates), nil
+}
+
+func (c *client) listDBTemplates(search dinkur.SearchTemplate) ([]dbmodel.EntryTemplate, error) {
+	var dbTemplates []dbmodel.EntryTemplate
+	q := c.preloadDBTemplate().
+		Model(&dbmodel.EntryTemplate{}).
+		Order(dbmodel.EntryTemplateColumnName)
+	if name := strings.TrimSpace(search.Name); name != "" {
+		q = q.Where(dbmodel.EntryTemplateColumnName+" = ?", name)
+	}
+	if err := q.Find(&dbTemplates).Error; err != nil {
+		return nil, err
+	}
+	return dbTemplates, nil
+}
+
+func (c *client) CreateTemplate(ctx context.Context, template dinkur.NewTemplate) (dinkur.Template, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.Template{}, err
+	}
+	tags, err := normalizeTags(template.Tags)
+	if err != nil {
+		return dinkur.Template{}, err
+	}
+	dbTemplate := dbmodel.EntryTemplate{
+		Name:       strings.TrimSpace(template.Name),
+		EntryName:  strings.TrimSpace(template.EntryName),
+		Duration:   template.Duration,
+		Tags:       newDBTemplateTags(0, tags),
+		Recurrence: newDBRecurrence(template.Recurrence),
+	}
+	if dbTemplate.EntryName == "" {
+		dbTemplate.EntryName = dbTemplate.Name
+	}
+	if err := validateTemplate(dbTemplate); err != nil {
+		return dinkur.Template{}, err
+	}
+	err = c.withContext(ctx).transaction(func(tx *client) error {
+		return tx.createDBTemplateNoTran(&dbTemplate)
+	})
+	if err != nil {
+		return dinkur.Template{}, err
+	}
+	return fromdb.Template(dbTemplate), nil
+}
+
+func (c *client) createDBTemplateNoTran(dbTemplate *dbmodel.EntryTemplate) error {
+	if err := c.assertDBTemplateIsUniqueNoTran(*dbTemplate); err != nil {
+		return err
+	}
+	if err := c.db.Create(dbTemplate).Error; err != nil {
+		return fmt.Errorf("create template: %w", err)
+	}
+	return nil
+}
+
+func newDBTemplateTags(templateID uint, tags []string) []dbmodel.EntryTemplateTag {
+	return slices.Map(tags, func(tag string) dbmodel.EntryTemplateTag {
+		return dbmodel.EntryTemplateTag{TemplateID: templateID, Name: tag}
+	})
+}
+
+func newDBRecurrence(recurrence *dinkur.Recurrence) *dbmodel.EntryRecurrence {
+	if recurrence == nil {
+		return nil
+	}
+	var dbRecurrence dbmodel.EntryRecurrence
+	setDBRecurrence(&dbRecurrence, *recurrence)
+	return &dbRecurrence
+}
+
+func setDBRecurrence(dbRecurrence *dbmodel.EntryRecurrence, recurrence dinkur.Recurrence) {
+	since := recurrence.Since
+	if since.IsZero() {
+		since = time.Now()
+	}
+	exceptions := slices.Map(recurrence.Exceptions, formatRecurrenceDate)
+	exceptions = slices.Distinct(exceptions)
+	slices.Sort(exceptions)
+	dbRecurrence.Rule = strings.TrimSpace(recurrence.Rule)
+	dbRecurrence.StartTime = recurrence.StartTime
+	dbRecurrence.Since = formatRecurrenceDate(since)
+	dbRecurrence.Exceptions = strings.Join(exceptions, ",")
+}
+
+func formatRecurrenceDate(date time.Time) string {
+	return date.Local().Format(dbmodel.EntryRecurrenceDateLayout)
+}
+
+func (c *client) UpdateTemplate(ctx context.Context, edit dinkur.EditTemplate) (dinkur.UpdatedTemplate, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.UpdatedTemplate{}, err
+	}
+	var update updatedDBTemplate
+	err := c.withContext(ctx).transaction(func(tx *client) (tranErr error) {
+		update, tranErr = tx.editDBTemplateNoTran(edit)
+		return
+	})
+	if err != nil {
+		return dinkur.UpdatedTemplate{}, err
+	}
+	return dinkur.UpdatedTemplate{
+		Before: fromdb.Template(update.before),
+		After:  fromdb.Template(update.after),
+	}, nil
+}
+
+type updatedDBTemplate struct {
+	before dbmodel.EntryTemplate
+	after  dbmodel.EntryTemplate
+}
+
+func (c *client) editDBTemplateNoTran(edit dinkur.EditTemplate) (updatedDBTemplate, error) {
+	var err error
+	if edit.Tags, err = normalizeTags(edit.Tags); err != nil {
+		return updatedDBTemplate{}, err
+	}
+	if edit.RemoveTags, err = normalizeTags(edit.RemoveTags); err != nil {
+		return updatedDBTemplate{}, err
+	}
+	dbTemplate, err := c.getDBTemplate(edit.ID)
+	if err != nil {
+		return updatedDBTemplate{}, fmt.Errorf("get template by ID: %d: %w", edit.ID, err)
+	}
+	templateBeforeEdit := dbTemplate
+	if dbTemplate.Recurrence != nil {
+		recurrenceBeforeEdit := *dbTemplate.Recurrence
+		templateBeforeEdit.Recurrence = &recurrenceBeforeEdit
+	}
+	if edit.Name != nil {
+		dbTemplate.Name = strings.TrimSpace(*edit.Name)
+	}
+	if edit.EntryName != nil {
+		dbTemplate.EntryName = strings.TrimSpace(*edit.EntryName)
+		if dbTemplate.EntryName == "" {
+			dbTemplate.EntryName = dbTemplate.Name
+		}
+	}
+	if edit.Duration != nil {
+		dbTemplate.Duration = *edit.Duration
+	}
+	var anyTagsChanged bool
+	if len(edit.Tags) > 0 || len(edit.RemoveTags) > 0 {
+		oldTags := fromdb.TemplateTagNames(templateBeforeEdit.Tags)
+		newTags := slices.Except(slices.Concat(oldTags, edit.Tags), edit.RemoveTags)
+		newTags, err = normalizeTags(newTags)
+		if err != nil {
+			return updatedDBTemplate{}, err
+		}
+		if !dinkur.TagsEqual(oldTags, newTags) {
+			dbTemplate.Tags = newDBTemplateTags(dbTemplate.ID, newTags)
+			anyTagsChanged = true
+		}
+	}
+	var recurrenceChanged bool
+	switch {
+	case edit.RemoveRecurrence:
+		if dbTemplate.Recurrence != nil {
+			dbTemplate.Recurrence = nil
+			recurrenceChanged = true
+		}
+	case edit.Recurrence != nil:
+		if dbTemplate.Recurrence == nil {
+			dbTemplate.Recurrence = &dbmodel.EntryRecurrence{TemplateID: dbTemplate.ID}
+		}
+		setDBRecurrence(dbTemplate.Recurrence, *edit.Recurrence)
+		recurrenceChanged = templateBeforeEdit.Recurrence == nil ||
+			!recurrencesEqual(*templateBeforeEdit.Recurrence, *dbTemplate.Recurrence)
+	}
+	if dbTemplate.Name == templateBeforeEdit.Name &&
+		dbTemplate.EntryName == templateBeforeEdit.EntryName &&
+		dbTemplate.Duration == templateBeforeEdit.Duration &&
+		!anyTagsChanged && !recurrenceChanged {
+		return updatedDBTemplate{
+			before: templateBeforeEdit,
+			after:  dbTemplate,
+		}, nil
+	}
+	if err := validateTemplate(dbTemplate); err != nil {
+		return updatedDBTemplate{}, err
+	}
+	if err := c.assertDBTemplateIsUniqueNoTran(dbTemplate); err != nil {
+		return updatedDBTemplate{}, err
+	}
+	err = c.db.
+		Omit(dbmodel.EntryTemplateFieldTags, dbmodel.EntryTemplateFieldRecurrence).
+		Save(&dbTemplate).
+		Error
+	if err != nil {
+		return updatedDBTemplate{}, fmt.Errorf("save updated template: %w", err)
+	}
+	if anyTagsChanged {
+		if err := c.setDBTemplateTagsNoTran(dbTemplate.ID, dbTemplate.Tags); err != nil {
+			return updatedDBTemplate{}, fmt.Errorf("update template tags: %w", err)
+		}
+	}
+	if recurrenceChanged {
+		if err := c.setDBRecurrenceNoTran(dbTemplate.ID, dbTemplate.Recurrence); err != nil {
+			return updatedDBTemplate{}, fmt.Errorf("update template recurrence: %w", err)
+		}
+	}
+	return updatedDBTemplate{
+		before: templateBeforeEdit,
+		after:  dbTemplate,
+	}, nil
+}
+
+func recurrencesEqual(a, b dbmodel.EntryRecurrence) bool {
+	return a.Rule == b.Rule &&
+		a.StartTime == b.StartTime &&
+		a.Since == b.Since &&
+		a.Exceptions == b.Exceptions
+}
+
+func (c *client) setDBTemplateTagsNoTran(templateID uint, dbTags []dbmodel.EntryTemplateTag) error {
+	err := c.db.
+		Where(dbmodel.EntryTemplateTagColumnTemplateID+" = ?", templateID).
+		Delete(&dbmodel.EntryTemplateTag{}).
+		Error
+	if err != nil {
+		return fmt.Errorf("delete old tags: %w", err)
+	}
+	if len(dbTags) == 0 {
+		return nil
+	}
+	if err := c.db.Create(&dbTags).Error; err != nil {
+		return fmt.Errorf("create new tags: %w", err)
+	}
+	return nil
+}
+
+func (c *client) setDBRecurrenceNoTran(templateID uint, dbRecurrence *dbmodel.EntryRecurrence) error {
+	if dbRecurrence == nil {
+		err := c.db.
+			Where(dbmodel.EntryRecurrenceColumnTemplateID+" = ?", templateID).
+			Delete(&dbmodel.EntryRecurrence{}).
+			Error
+		if err != nil {
+			return fmt.Errorf("delete recurrence: %w", err)
+		}
+		return nil
+	}
+	if err := c.db.Save(dbRecurrence).Error; err != nil {
+		return fmt.Errorf("save recurrence: %w", err)
+	}
+	return nil
+}
+
+func (c *client) DeleteTemplate(ctx context.Context, id uint) (dinkur.Template, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.Template{}, err
+	}
+	var dbTemplate dbmodel.EntryTemplate
+	err := c.withContext(ctx).transaction(func(tx *client) (tranErr error) {
+		dbTemplate, tranErr = tx.deleteDBTemplateNoTran(id)
+		return
+	})
+	if err != nil {
+		return dinkur.Template{}, err
+	}
+	return fromdb.Template(dbTemplate), nil
+}
+
+func (c *client) deleteDBTemplateNoTran(id uint) (dbmodel.EntryTemplate, error) {
+	dbTemplate, err := c.getDBTemplate(id)
+	if err != nil {
+		return dbmodel.EntryTemplate{}, fmt.Errorf("get template to delete: %w", err)
+	}
+	if err := c.setDBTemplateTagsNoTran(id, nil); err != nil {
+		return dbmodel.EntryTemplate{}, fmt.Errorf("remove template tags: %w", err)
+	}
+	if err := c.setDBRecurrenceNoTran(id, nil); err != nil {
+		return dbmodel.EntryTemplate{}, fmt.Errorf("remove template recurrence: %w", err)
+	}
+	if err := c.db.Delete(&dbmodel.EntryTemplate{}, id).Error; err != nil {
+		return dbmodel.EntryTemplate{}, fmt.Errorf("delete template: %w", err)
+	}
+	return dbTemplate, nil
+}
+
+func (c *client) assertDBTemplateIsUniqueNoTran(dbTemplate dbmodel.EntryTemplate) error {
+	var existing dbmodel.EntryTemplate
+	err := c.db.
+		Where(dbmodel.EntryTemplateColumnName+" = ?", dbTemplate.Name).
+		First(&existing).
+		Error
+	if err != nil {
+		if errors.Is(err, dinkur.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("check for duplicate template: %w", err)
+	}
+	if existing.ID == dbTemplate.ID {
+		return nil
+	}
+	return fmt.Errorf("template #%d %q: %w", existing.ID, existing.Name, dinkur.ErrTemplateExists)
+}
+
+func validateTemplate(dbTemplate dbmodel.EntryTemplate) error {
+	if dbTemplate.Name == "" {
+		return dinkur.ErrTemplateNameEmpty
+	}
+	if dbTemplate.Duration < 0 {
+		return dinkur.ErrEntryEndBeforeStart
+	}
+	if dbTemplate.Recurrence != nil {
+		if _, err := rrule.Parse(dbTemplate.Recurrence.Rule); err != nil {
+			return fmt.Errorf("%w: %q", dinkur.ErrRecurrenceInvalid, dbTemplate.Recurrence.Rule)
+		}
+		if dbTemplate.Recurrence.StartTime < 0 || dbTemplate.Recurrence.StartTime >= 24*time.Hour {
+			return fmt.Errorf("%w: start time must be within the day", dinkur.ErrRecurrenceInvalid)
+		}
+	}
+	return nil
+}
+
+func (c *client) MaterializeRecurringEntries(ctx context.Context, until time.Time) ([]dinkur.Entry, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	var startedEntries []startedDBEntry
+	err := c.withContext(ctx).transaction(func(tx *client) (tranErr error) {
+		startedEntries, tranErr = tx.materializeDBRecurrencesNoTran(until)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, startedEntry := range startedEntries {
+		c.pubStartedDBEntry(startedEntry)
+	}
+	return slices.Map(startedEntries, func(startedEntry startedDBEntry) dinkur.Entry {
+		return fromdb.Entry(startedEntry.started)
+	}), nil
+}
+
+func (c *client) materializeDBRecurrencesNoTran(until time.Time) ([]startedDBEntry, error) {
+	dbTemplates, err := c.listDBTemplates(dinkur.SearchTemplate{})
+	if err != nil {
+		return nil, fmt.Errorf("list templates: %w", err)
+	}
+	var startedEntries []startedDBEntry
+	var changes []journalChange
+	for _, dbTemplate := range dbTemplates {
+		if dbTemplate.Recurrence == nil {
+			continue
+		}
+		started, err := c.materializeDBRecurrenceNoTran(dbTemplate, until)
+		if err != nil {
+			return nil, fmt.Errorf("template #%d %q: %w", dbTemplate.ID, dbTemplate.Name, err)
+		}
+		for _, startedEntry := range started {
+			changes = append(changes, startedJournalChanges(startedEntry)...)
+		}
+		startedEntries = append(startedEntries, started...)
+	}
+	if len(changes) > 0 {
+		if err := c.appendJournalNoTran(dbmodel.JournalActionCreate, nil, changes); err != nil {
+			return nil, err
+		}
+	}
+	return startedEntries, nil
+}
+
+func (c *client) materializeDBRecurrenceNoTran(dbTemplate dbmodel.EntryTemplate, until time.Time) ([]startedDBEntry, error) {
+	dbRecurrence := dbTemplate.Recurrence
+	from := dbRecurrence.CreatedAt
+	if dbRecurrence.MaterializedUntil != nil {
+		from = *dbRecurrence.MaterializedUntil
+	}
+	if !until.After(from) {
+		return nil, nil
+	}
+	rule, err := rrule.Parse(dbRecurrence.Rule)
+	if err != nil {
+		return nil, err
+	}
+	since := fromdb.RecurrenceDate(dbRecurrence.Since)
+	if since.IsZero() {
+		since = dbRecurrence.CreatedAt.Local()
+	}
+	exceptions := strings.Split(dbRecurrence.Exceptions, ",")
+	var startedEntries []startedDBEntry
+	for _, date := range rule.Dates(since, from, until) {
+		if slices.Contains(exceptions, date.Format(dbmodel.EntryRecurrenceDateLayout)) {
+			continue
+		}
+		start := recurrenceStartOnDate(date, dbRecurrence.StartTime)
+		if !start.After(from) || start.After(until) {
+			continue
+		}
+		startedEntry, err := c.materializeDBTemplateNoTran(dbTemplate, start)
+		if errors.Is(err, dinkur.ErrEntryOverlaps) ||
+			errors.Is(err, dinkur.ErrEntryEndBeforeStart) {
+			// skip instead of failing, as the same occurrence would otherwise
+			// fail again on every later attempt
+			log.Warn().
+				WithError(err).
+				WithString("template", dbTemplate.Name).
+				WithTime("start", start).
+				Message("Skipped recurring entry.")
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		startedEntries = append(startedEntries, startedEntry)
+	}
+	err = c.db.Model(dbRecurrence).
+		Update(dbmodel.EntryRecurrenceColumnMaterializedUntil, until.UTC()).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("update materialized time: %w", err)
+	}
+	return startedEntries, nil
+}
+
+// materializeDBTemplateNoTran adds an entry from a template in its own nested
+// transaction, so that an entry rejected by the overlap policy does not leave
+// any changes behind.
+func (c *client) materializeDBTemplateNoTran(dbTemplate dbmodel.EntryTemplate, start time.Time) (startedDBEntry, error) {
+	entry := dinkur.NewEntry{
+		Name:  dbTemplate.EntryName,
+		Start: &start,
+		Tags:  fromdb.TemplateTagNames(dbTemplate.Tags),
+	}
+	if dbTemplate.Duration > 0 {
+		end := start.Add(dbTemplate.Duration)
+		entry.End = &end
+	}
+	newEntry, err := newDBEntry(entry)
+	if err != nil {
+		return startedDBEntry{}, err
+	}
+	var startedEntry startedDBEntry
+	err = c.transaction(func(tx *client) (tranErr error) {
+		startedEntry, tranErr = tx.startDBEntryNoTran(newEntry)
+		return
+	})
+	return startedEntry, err
+}
+
+// recurrenceStartOnDate returns the wall clock time on the given date, so that
+// recurring entries keep their time of day across daylight saving time.
+func recurrenceStartOnDate(date time.Time, startTime time.Duration) time.Time {
+	y, m, d := date.Date()
+	hour := int(startTime / time.Hour)
+	min := int(startTime % time.Hour / time.Minute)
+	sec := int(startTime % time.Minute / time.Second)
+	return time.Date(y, m, d, hour, min, sec, 0, date.Location())
+}
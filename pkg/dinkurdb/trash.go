@@ -0,0 +1,166 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dinkurdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"github.com/dinkur/dinkur/pkg/fromdb"
+	"gopkg.in/typ.v4/slices"
+	"gorm.io/gorm"
+)
+
+var entrySQLTrashed = dbmodel.EntryColumnDeletedAt + " IS NOT NULL"
+
+// preloadTrashedDBEntry is the same as preloadDBEntry, but only targets
+// entries that have been moved to the trash.
+func (c *client) preloadTrashedDBEntry() *gorm.DB {
+	return c.preloadDBEntry().Unscoped().Where(entrySQLTrashed)
+}
+
+func (c *client) GetTrashedEntryList(ctx context.Context) ([]dinkur.Entry, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	dbEntries, err := c.withContext(ctx).listTrashedDBEntries()
+	if err != nil {
+		return nil, err
+	}
+	return fromdb.EntrySlice(dbEntries), nil
+}
+
+func (c *client) listTrashedDBEntries() ([]dbmodel.Entry, error) {
+	var dbEntries []dbmodel.Entry
+	err := c.preloadTrashedDBEntry().
+		Order(dbmodel.EntryColumnDeletedAt).
+		Find(&dbEntries).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("list trashed entries: %w", err)
+	}
+	return dbEntries, nil
+}
+
+func (c *client) getTrashedDBEntry(id uint) (dbmodel.Entry, error) {
+	var dbEntry dbmodel.Entry
+	if err := c.preloadTrashedDBEntry().First(&dbEntry, id).Error; err != nil {
+		return dbmodel.Entry{}, err
+	}
+	return dbEntry, nil
+}
+
+func (c *client) RestoreEntry(ctx context.Context, id uint) (dinkur.Entry, error) {
+	if err := c.assertConnected(); err != nil {
+		return dinkur.Entry{}, err
+	}
+	dbEntry, err := c.withContext(ctx).restoreDBEntry(id)
+	if err != nil {
+		return dinkur.Entry{}, err
+	}
+	c.entryObs.PubWait(entryEvent{
+		dbEntry: dbEntry,
+		event:   dinkur.EventRestored,
+	})
+	return fromdb.Entry(dbEntry), nil
+}
+
+func (c *client) restoreDBEntry(id uint) (dbmodel.Entry, error) {
+	var dbEntry dbmodel.Entry
+	err := c.transaction(func(tx *client) error {
+		var err error
+		dbEntry, err = tx.restoreDBEntryNoTran(id)
+		if err != nil {
+			return err
+		}
+		return tx.appendJournalNoTran(dbmodel.JournalActionRestore, nil,
+			[]journalChange{createdJournalChange(dbEntry)})
+	})
+	return dbEntry, err
+}
+
+func (c *client) restoreDBEntryNoTran(id uint) (dbmodel.Entry, error) {
+	if _, err := c.getTrashedDBEntry(id); err != nil {
+		return dbmodel.Entry{}, fmt.Errorf("get trashed entry to restore: %w", err)
+	}
+	err := c.db.Unscoped().Model(&dbmodel.Entry{}).
+		Where(dbmodel.EntryColumnID+" = ?", id).
+		Update(dbmodel.EntryColumnDeletedAt, nil).
+		Error
+	if err != nil {
+		return dbmodel.Entry{}, fmt.Errorf("restore entry: %w", err)
+	}
+	dbEntry, err := c.getDBEntry(id)
+	if err != nil {
+		return dbmodel.Entry{}, fmt.Errorf("get restored entry: %w", err)
+	}
+	return dbEntry, nil
+}
+
+func (c *client) PurgeTrashedEntries(ctx context.Context, deletedBefore time.Time) ([]dinkur.Entry, error) {
+	if err := c.assertConnected(); err != nil {
+		return nil, err
+	}
+	var dbEntries []dbmodel.Entry
+	err := c.withContext(ctx).transaction(func(tx *client) (tranErr error) {
+		dbEntries, tranErr = tx.purgeTrashedDBEntriesNoTran(deletedBefore)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromdb.EntrySlice(dbEntries), nil
+}
+
+func (c *client) purgeTrashedDBEntriesNoTran(deletedBefore time.Time) ([]dbmodel.Entry, error) {
+	var dbEntries []dbmodel.Entry
+	err := c.preloadTrashedDBEntry().
+		Where(dbmodel.EntryColumnDeletedAt+" < ?", deletedBefore.UTC()).
+		Order(dbmodel.EntryColumnDeletedAt).
+		Find(&dbEntries).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("list trashed entries to purge: %w", err)
+	}
+	if len(dbEntries) == 0 {
+		return nil, nil
+	}
+	ids := slices.Map(dbEntries, func(dbEntry dbmodel.Entry) uint {
+		return dbEntry.ID
+	})
+	err = c.db.
+		Where(dbmodel.EntryTagColumnEntryID+" IN ?", ids).
+		Delete(&dbmodel.EntryTag{}).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("delete purged entry tags: %w", err)
+	}
+	if err := c.db.Unscoped().Delete(&dbmodel.Entry{}, ids).Error; err != nil {
+		return nil, fmt.Errorf("delete purged entries: %w", err)
+	}
+	if err := c.pruneJournalNoTran(ids); err != nil {
+		return nil, fmt.Errorf("prune purged entries from journal: %w", err)
+	}
+	return dbEntries, nil
+}
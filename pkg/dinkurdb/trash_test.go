@@ -0,0 +1,79 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// Copyright (C) 2021 Kalle Fagerberg
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+//go:build fts5
+
+package dinkurdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+func TestUndoAfterPurge(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, Options{})
+	purged := createTestEntry(t, c, "purged", 10, 11)
+	kept := createTestEntry(t, c, "kept", 11, 12)
+	if _, err := c.DeleteEntry(ctx, purged.ID); err != nil {
+		t.Fatalf("delete entry: %s", err)
+	}
+	if _, err := c.PurgeTrashedEntries(ctx, time.Now().Add(time.Minute)); err != nil {
+		t.Fatalf("purge trashed entries: %s", err)
+	}
+
+	// Both the removal and creation of the purged entry are skipped, so only
+	// the creation of the kept entry is left to undo.
+	result, err := c.Undo(ctx)
+	if err != nil {
+		t.Fatalf("undo: %s", err)
+	}
+	if len(result.Changes) != 1 || result.Changes[0].Before == nil || result.Changes[0].Before.ID != kept.ID {
+		t.Errorf("want undo to only remove entry #%d, got %+v", kept.ID, result.Changes)
+	}
+	if _, err := c.GetEntry(ctx, purged.ID); !errors.Is(err, dinkur.ErrNotFound) {
+		t.Errorf("want purged entry to stay removed, got error: %v", err)
+	}
+	trashed, err := c.GetTrashedEntryList(ctx)
+	if err != nil {
+		t.Fatalf("get trashed entries: %s", err)
+	}
+	for _, entry := range trashed {
+		if entry.ID == purged.ID {
+			t.Errorf("want purged entry to not be back in the trash")
+		}
+	}
+
+	if _, err := c.Redo(ctx); err != nil {
+		t.Fatalf("redo: %s", err)
+	}
+	if _, err := c.GetEntry(ctx, kept.ID); err != nil {
+		t.Errorf("want kept entry back after redo, got error: %s", err)
+	}
+	if _, err := c.Undo(ctx); err != nil {
+		t.Fatalf("undo after redo: %s", err)
+	}
+	if _, err := c.Undo(ctx); !errors.Is(err, dinkur.ErrNothingToUndo) {
+		t.Errorf("want error %q, got: %v", dinkur.ErrNothingToUndo, err)
+	}
+}
@@ -32,8 +32,12 @@ func Entry(t dbmodel.Entry) dinkur.Entry {
 	return dinkur.Entry{
 		CommonFields: CommonFields(t.CommonFields),
 		Name:         t.Name,
+		Note:         t.Note,
 		Start:        t.Start.Local(),
 		End:          conv.TimePtrLocal(t.End),
+		Tags:         EntryTagNames(t.Tags),
+		Project:      ProjectPtr(t.Project),
+		DeletedAt:    DeletedAtPtr(t.DeletedAt),
 	}
 }
 
@@ -49,3 +53,13 @@ func EntryPtr(t *dbmodel.Entry) *dinkur.Entry {
 func EntrySlice(entries []dbmodel.Entry) []dinkur.Entry {
 	return slices.Map(entries, Entry)
 }
+
+// EntryTagNames converts a slice of dbmodel entry tags to a sorted slice of
+// tag names.
+func EntryTagNames(tags []dbmodel.EntryTag) []string {
+	names := slices.Map(tags, func(tag dbmodel.EntryTag) string {
+		return tag.Name
+	})
+	slices.Sort(names)
+	return names
+}
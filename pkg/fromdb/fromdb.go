@@ -20,8 +20,12 @@
 package fromdb
 
 import (
+	"time"
+
 	"github.com/dinkur/dinkur/pkg/dbmodel"
 	"github.com/dinkur/dinkur/pkg/dinkur"
+	"gopkg.in/typ.v4"
+	"gorm.io/gorm"
 )
 
 // TimeFields converts dbmodel common fields to dinkur time fields.
@@ -48,3 +52,12 @@ func CommonFieldsID(f dbmodel.CommonFields, id uint) dinkur.CommonFields {
 		ID:         id,
 	}
 }
+
+// DeletedAtPtr converts a dbmodel soft-delete timestamp to a local time, or
+// nil if not deleted.
+func DeletedAtPtr(d gorm.DeletedAt) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return typ.Ref(d.Time.Local())
+}
@@ -0,0 +1,49 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromdb
+
+import (
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"gopkg.in/typ.v4/slices"
+)
+
+// Goal converts a dbmodel goal to a dinkur goal.
+func Goal(g dbmodel.Goal) dinkur.Goal {
+	return dinkur.Goal{
+		CommonFields: CommonFields(g.CommonFields),
+		Period:       GoalPeriod(g.Period),
+		Target:       g.Target,
+		Project:      ProjectPtr(g.Project),
+	}
+}
+
+// GoalSlice converts a slice of dbmodel goals to dinkur goals.
+func GoalSlice(goals []dbmodel.Goal) []dinkur.Goal {
+	return slices.Map(goals, Goal)
+}
+
+// GoalPeriod converts a dbmodel goal period to a dinkur goal period.
+func GoalPeriod(period string) dinkur.GoalPeriod {
+	if period == dbmodel.GoalPeriodWeek {
+		return dinkur.GoalPeriodWeek
+	}
+	return dinkur.GoalPeriodDay
+}
@@ -0,0 +1,43 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromdb
+
+import (
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// JournalAction converts a dbmodel journal action to a dinkur journal action.
+func JournalAction(action string) dinkur.JournalAction {
+	switch action {
+	case dbmodel.JournalActionCreate:
+		return dinkur.JournalActionCreate
+	case dbmodel.JournalActionUpdate:
+		return dinkur.JournalActionUpdate
+	case dbmodel.JournalActionDelete:
+		return dinkur.JournalActionDelete
+	case dbmodel.JournalActionStop:
+		return dinkur.JournalActionStop
+	case dbmodel.JournalActionRestore:
+		return dinkur.JournalActionRestore
+	default:
+		return dinkur.JournalActionUnknown
+	}
+}
@@ -0,0 +1,49 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromdb
+
+import (
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"gopkg.in/typ.v4"
+	"gopkg.in/typ.v4/slices"
+)
+
+// Project converts a dbmodel project to a dinkur project.
+func Project(p dbmodel.Project) dinkur.Project {
+	return dinkur.Project{
+		CommonFields: CommonFields(p.CommonFields),
+		Name:         p.Name,
+		Client:       p.Client,
+	}
+}
+
+// ProjectPtr converts a dbmodel project pointer to a dinkur project, or nil.
+func ProjectPtr(p *dbmodel.Project) *dinkur.Project {
+	if p == nil {
+		return nil
+	}
+	return typ.Ref(Project(*p))
+}
+
+// ProjectSlice converts a slice of dbmodel projects to dinkur projects.
+func ProjectSlice(projects []dbmodel.Project) []dinkur.Project {
+	return slices.Map(projects, Project)
+}
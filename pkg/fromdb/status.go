@@ -23,13 +23,16 @@ import (
 	"github.com/dinkur/dinkur/pkg/conv"
 	"github.com/dinkur/dinkur/pkg/dbmodel"
 	"github.com/dinkur/dinkur/pkg/dinkur"
+	"gopkg.in/typ.v4"
 )
 
 // Status converts a DB status model to a dinkur status model.
 func Status(status dbmodel.Status) dinkur.Status {
 	return dinkur.Status{
-		TimeFields: TimeFields(status.CommonFields),
-		AFKSince:   conv.TimePtrLocal(status.AFKSince),
-		BackSince:  conv.TimePtrLocal(status.BackSince),
+		TimeFields:               TimeFields(status.CommonFields),
+		AFKSince:                 conv.TimePtrLocal(status.AFKSince),
+		BackSince:                conv.TimePtrLocal(status.BackSince),
+		LastSeenAlive:            conv.TimePtrLocal(status.LastSeenAlive),
+		AutoStoppedEntryIDOrZero: typ.DerefZero(status.AutoStoppedEntryID),
 	}
 }
@@ -0,0 +1,98 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromdb
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dinkur/dinkur/pkg/dbmodel"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"gopkg.in/typ.v4"
+	"gopkg.in/typ.v4/slices"
+)
+
+// Template converts a dbmodel template to a dinkur template.
+func Template(t dbmodel.EntryTemplate) dinkur.Template {
+	return dinkur.Template{
+		CommonFields: CommonFields(t.CommonFields),
+		Name:         t.Name,
+		EntryName:    t.EntryName,
+		Duration:     t.Duration,
+		Tags:         TemplateTagNames(t.Tags),
+		Recurrence:   RecurrencePtr(t.Recurrence),
+	}
+}
+
+// TemplateSlice converts a slice of dbmodel templates to dinkur templates.
+func TemplateSlice(templates []dbmodel.EntryTemplate) []dinkur.Template {
+	return slices.Map(templates, Template)
+}
+
+// TemplateTagNames converts a slice of dbmodel template tags to a sorted slice
+// of tag names.
+func TemplateTagNames(tags []dbmodel.EntryTemplateTag) []string {
+	names := slices.Map(tags, func(tag dbmodel.EntryTemplateTag) string {
+		return tag.Name
+	})
+	slices.Sort(names)
+	return names
+}
+
+// Recurrence converts a dbmodel recurrence to a dinkur recurrence.
+func Recurrence(r dbmodel.EntryRecurrence) dinkur.Recurrence {
+	return dinkur.Recurrence{
+		Rule:       r.Rule,
+		StartTime:  r.StartTime,
+		Since:      RecurrenceDate(r.Since),
+		Exceptions: RecurrenceDates(r.Exceptions),
+	}
+}
+
+// RecurrencePtr converts a dbmodel recurrence pointer to a dinkur recurrence,
+// or nil.
+func RecurrencePtr(r *dbmodel.EntryRecurrence) *dinkur.Recurrence {
+	if r == nil {
+		return nil
+	}
+	return typ.Ref(Recurrence(*r))
+}
+
+// RecurrenceDate parses a date stored in a dbmodel recurrence as midnight in
+// the local time zone, or the zero value if the date is invalid.
+func RecurrenceDate(s string) time.Time {
+	date, err := time.ParseInLocation(dbmodel.EntryRecurrenceDateLayout, s, time.Local)
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}
+
+// RecurrenceDates parses a comma-separated list of dates stored in a dbmodel
+// recurrence. Invalid dates are left out.
+func RecurrenceDates(s string) []time.Time {
+	var dates []time.Time
+	for _, str := range strings.Split(s, ",") {
+		if date := RecurrenceDate(str); !date.IsZero() {
+			dates = append(dates, date)
+		}
+	}
+	return dates
+}
@@ -0,0 +1,83 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"fmt"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// AwayAction converts a gRPC away action to a Go away action, or error on
+// unspecified or unknown values.
+func AwayAction(action dinkurapiv1.AwayAction) (dinkur.AwayAction, error) {
+	switch action {
+	case dinkurapiv1.AwayAction_AWAY_ACTION_KEEP:
+		return dinkur.AwayActionKeep, nil
+	case dinkurapiv1.AwayAction_AWAY_ACTION_DISCARD:
+		return dinkur.AwayActionDiscard, nil
+	case dinkurapiv1.AwayAction_AWAY_ACTION_SAVE_AS_NEW:
+		return dinkur.AwayActionSaveAsNew, nil
+	case dinkurapiv1.AwayAction_AWAY_ACTION_SPLIT:
+		return dinkur.AwayActionSplit, nil
+	default:
+		return 0, fmt.Errorf("%w: unknown action: %d", dinkur.ErrAwayInvalid, action)
+	}
+}
+
+// PendingAwayPtr converts a gRPC pending away time to a Go pending away time.
+func PendingAwayPtr(pending *dinkurapiv1.PendingAway) (*dinkur.PendingAway, error) {
+	if pending == nil {
+		return nil, nil
+	}
+	activeEntry, err := EntryPtrNoNil(pending.ActiveEntry)
+	if err != nil {
+		return nil, fmt.Errorf("convert active entry: %w", err)
+	}
+	return &dinkur.PendingAway{
+		AFKSince:    TimeOrZero(pending.AfkSince),
+		BackSince:   TimeOrZero(pending.BackSince),
+		ActiveEntry: activeEntry,
+	}, nil
+}
+
+// AwayPartSlice converts a slice of gRPC away time parts to a slice of Go
+// away time parts.
+func AwayPartSlice(slice []*dinkurapiv1.AwayPart) ([]dinkur.AwayPart, error) {
+	parts := make([]dinkur.AwayPart, len(slice))
+	for i, part := range slice {
+		if part == nil {
+			return nil, fmt.Errorf("part %d: %w", i+1, ErrUnexpectedNilAwayPart)
+		}
+		projectID, err := conv.Uint64ToUint(part.ProjectIdOrZero)
+		if err != nil {
+			return nil, fmt.Errorf("part %d %q: convert project ID: %w", i+1, part.Name, err)
+		}
+		parts[i] = dinkur.AwayPart{
+			Name:            part.Name,
+			Tags:            part.Tags,
+			ProjectIDOrZero: projectID,
+			End:             TimePtr(part.End),
+		}
+	}
+	return parts, nil
+}
@@ -30,8 +30,13 @@ import (
 
 // Errors that are specific to converting gRPC entries to Go.
 var (
-	ErrUnexpectedNilEntry  = errors.New("unexpected nil entry")
-	ErrUnexpectedNilStatus = errors.New("unexpected nil status")
+	ErrUnexpectedNilEntry    = errors.New("unexpected nil entry")
+	ErrUnexpectedNilStatus   = errors.New("unexpected nil status")
+	ErrUnexpectedNilProject  = errors.New("unexpected nil project")
+	ErrUnexpectedNilTemplate = errors.New("unexpected nil template")
+	ErrUnexpectedNilFocus    = errors.New("unexpected nil focus state")
+	ErrUnexpectedNilGoal     = errors.New("unexpected nil goal")
+	ErrUnexpectedNilAwayPart = errors.New("unexpected nil away part")
 )
 
 // EntryPtr converts a gRPC entry to a Go entry.
@@ -43,6 +48,10 @@ func EntryPtr(entry *dinkurapiv1.Entry) (*dinkur.Entry, error) {
 	if err != nil {
 		return nil, fmt.Errorf("convert entry ID: %w", err)
 	}
+	project, err := ProjectPtr(entry.Project)
+	if err != nil {
+		return nil, fmt.Errorf("convert entry project: %w", err)
+	}
 	return &dinkur.Entry{
 		CommonFields: dinkur.CommonFields{
 			TimeFields: dinkur.TimeFields{
@@ -51,9 +60,13 @@ func EntryPtr(entry *dinkurapiv1.Entry) (*dinkur.Entry, error) {
 			},
 			ID: id,
 		},
-		Name:  entry.Name,
-		Start: TimeOrZero(entry.Start),
-		End:   TimePtr(entry.End),
+		Name:      entry.Name,
+		Note:      entry.Note,
+		Start:     TimeOrZero(entry.Start),
+		End:       TimePtr(entry.End),
+		Tags:      append([]string{}, entry.Tags...),
+		Project:   project,
+		DeletedAt: TimePtr(entry.Deleted),
 	}, nil
 }
 
@@ -0,0 +1,63 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"fmt"
+	"time"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// WeekdaySlice converts a slice of gRPC weekdays to Go weekdays.
+func WeekdaySlice(slice []dinkurapiv1.Weekday) []time.Weekday {
+	weekdays := make([]time.Weekday, len(slice))
+	for i, d := range slice {
+		weekdays[i] = time.Weekday(d)
+	}
+	return weekdays
+}
+
+// EntryGapSlice converts a slice of gRPC entry gaps to Go entry gaps. Nils are
+// skipped.
+func EntryGapSlice(slice []*dinkurapiv1.EntryGap) ([]dinkur.EntryGap, error) {
+	gaps := make([]dinkur.EntryGap, 0, len(slice))
+	for i, g := range slice {
+		if g == nil {
+			continue
+		}
+		prev, err := EntryPtr(g.Previous)
+		if err != nil {
+			return nil, fmt.Errorf("entry gap #%d previous: %w", i+1, err)
+		}
+		next, err := EntryPtr(g.Next)
+		if err != nil {
+			return nil, fmt.Errorf("entry gap #%d next: %w", i+1, err)
+		}
+		gaps = append(gaps, dinkur.EntryGap{
+			Start:    TimeOrZero(g.Start),
+			End:      TimeOrZero(g.End),
+			Previous: prev,
+			Next:     next,
+		})
+	}
+	return gaps, nil
+}
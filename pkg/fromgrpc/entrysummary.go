@@ -0,0 +1,67 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"fmt"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// EntrySummaryGroup converts a gRPC entry summary grouping to a Go grouping.
+func EntrySummaryGroup(g dinkurapiv1.GetEntrySummaryRequest_GroupBy) (dinkur.EntrySummaryGroup, error) {
+	switch g {
+	case dinkurapiv1.GetEntrySummaryRequest_GROUP_BY_DAY:
+		return dinkur.EntrySummaryGroupDay, nil
+	case dinkurapiv1.GetEntrySummaryRequest_GROUP_BY_WEEK:
+		return dinkur.EntrySummaryGroupWeek, nil
+	case dinkurapiv1.GetEntrySummaryRequest_GROUP_BY_MONTH:
+		return dinkur.EntrySummaryGroupMonth, nil
+	case dinkurapiv1.GetEntrySummaryRequest_GROUP_BY_NAME:
+		return dinkur.EntrySummaryGroupName, nil
+	default:
+		return 0, fmt.Errorf("%w: %d", dinkur.ErrSummaryGroupInvalid, g)
+	}
+}
+
+// EntrySummarySlice converts a slice of gRPC entry summaries to Go entry
+// summaries. Nils are skipped.
+func EntrySummarySlice(slice []*dinkurapiv1.EntrySummary) ([]dinkur.EntrySummary, error) {
+	summaries := make([]dinkur.EntrySummary, 0, len(slice))
+	for _, s := range slice {
+		if s == nil {
+			continue
+		}
+		entries, err := conv.Uint64ToUint(s.Entries)
+		if err != nil {
+			return nil, fmt.Errorf("entry summary %q: convert entries count: %w", s.Key, err)
+		}
+		summaries = append(summaries, dinkur.EntrySummary{
+			Key:      s.Key,
+			Start:    TimePtr(s.Start),
+			End:      TimePtr(s.End),
+			Entries:  entries,
+			Duration: DurationOrZero(s.Duration),
+		})
+	}
+	return summaries, nil
+}
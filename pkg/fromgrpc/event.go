@@ -33,6 +33,8 @@ func Event(ev dinkurapiv1.Event) dinkur.EventType {
 		return dinkur.EventUpdated
 	case dinkurapiv1.Event_EVENT_DELETED:
 		return dinkur.EventDeleted
+	case dinkurapiv1.Event_EVENT_RESTORED:
+		return dinkur.EventRestored
 	default:
 		return dinkur.EventUnknown
 	}
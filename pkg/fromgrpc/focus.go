@@ -0,0 +1,71 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"fmt"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// FocusPhase converts a gRPC focus phase to a Go focus phase.
+func FocusPhase(phase dinkurapiv1.FocusPhase) dinkur.FocusPhase {
+	switch phase {
+	case dinkurapiv1.FocusPhase_FOCUS_PHASE_WORK:
+		return dinkur.FocusPhaseWork
+	case dinkurapiv1.FocusPhase_FOCUS_PHASE_BREAK:
+		return dinkur.FocusPhaseBreak
+	default:
+		return dinkur.FocusPhaseIdle
+	}
+}
+
+// FocusPtrNoNil converts a gRPC focus timer state to a Go focus timer state,
+// or error on nil.
+func FocusPtrNoNil(focus *dinkurapiv1.FocusState) (dinkur.Focus, error) {
+	if focus == nil {
+		return dinkur.Focus{}, ErrUnexpectedNilFocus
+	}
+	cycle, err := conv.Uint64ToUint(focus.Cycle)
+	if err != nil {
+		return dinkur.Focus{}, fmt.Errorf("convert focus cycle: %w", err)
+	}
+	cycles, err := conv.Uint64ToUint(focus.Cycles)
+	if err != nil {
+		return dinkur.Focus{}, fmt.Errorf("convert focus cycles: %w", err)
+	}
+	entryID, err := conv.Uint64ToUint(focus.EntryIdOrZero)
+	if err != nil {
+		return dinkur.Focus{}, fmt.Errorf("convert focus entry ID: %w", err)
+	}
+	return dinkur.Focus{
+		Phase:         FocusPhase(focus.Phase),
+		Name:          focus.Name,
+		Cycle:         cycle,
+		Cycles:        cycles,
+		Work:          DurationOrZero(focus.Work),
+		Break:         DurationOrZero(focus.Break),
+		PhaseStart:    TimeOrZero(focus.PhaseStart),
+		PhaseEnd:      TimeOrZero(focus.PhaseEnd),
+		EntryIDOrZero: entryID,
+	}, nil
+}
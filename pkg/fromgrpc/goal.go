@@ -0,0 +1,121 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"fmt"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// GoalPeriod converts a gRPC goal period to a Go goal period.
+func GoalPeriod(period dinkurapiv1.GoalPeriod) (dinkur.GoalPeriod, error) {
+	switch period {
+	case dinkurapiv1.GoalPeriod_GOAL_PERIOD_DAY:
+		return dinkur.GoalPeriodDay, nil
+	case dinkurapiv1.GoalPeriod_GOAL_PERIOD_WEEK:
+		return dinkur.GoalPeriodWeek, nil
+	default:
+		return 0, fmt.Errorf("%w: %d", dinkur.ErrGoalPeriodInvalid, period)
+	}
+}
+
+// GoalPtr converts a gRPC goal to a Go goal.
+func GoalPtr(goal *dinkurapiv1.Goal) (*dinkur.Goal, error) {
+	if goal == nil {
+		return nil, nil
+	}
+	id, err := conv.Uint64ToUint(goal.Id)
+	if err != nil {
+		return nil, fmt.Errorf("convert goal ID: %w", err)
+	}
+	period, err := GoalPeriod(goal.Period)
+	if err != nil {
+		return nil, err
+	}
+	project, err := ProjectPtr(goal.Project)
+	if err != nil {
+		return nil, fmt.Errorf("convert goal project: %w", err)
+	}
+	return &dinkur.Goal{
+		CommonFields: dinkur.CommonFields{
+			TimeFields: dinkur.TimeFields{
+				CreatedAt: TimeOrZero(goal.Created),
+				UpdatedAt: TimeOrZero(goal.Updated),
+			},
+			ID: id,
+		},
+		Period:  period,
+		Target:  DurationOrZero(goal.Target),
+		Project: project,
+	}, nil
+}
+
+// GoalPtrNoNil converts a gRPC goal to a Go goal, or error if nil.
+func GoalPtrNoNil(goal *dinkurapiv1.Goal) (dinkur.Goal, error) {
+	g, err := GoalPtr(goal)
+	if err != nil {
+		return dinkur.Goal{}, err
+	}
+	if g == nil {
+		return dinkur.Goal{}, ErrUnexpectedNilGoal
+	}
+	return *g, nil
+}
+
+// GoalSlice converts a slice of gRPC goals to Go goals. Nils are skipped.
+func GoalSlice(slice []*dinkurapiv1.Goal) ([]dinkur.Goal, error) {
+	goals := make([]dinkur.Goal, 0, len(slice))
+	for _, g := range slice {
+		g2, err := GoalPtr(g)
+		if err != nil {
+			return nil, fmt.Errorf("goal #%d: %w", g.Id, err)
+		}
+		if g2 == nil {
+			continue
+		}
+		goals = append(goals, *g2)
+	}
+	return goals, nil
+}
+
+// GoalProgressSlice converts a slice of gRPC goal progress to Go goal
+// progress. Nils are skipped.
+func GoalProgressSlice(slice []*dinkurapiv1.GoalProgress) ([]dinkur.GoalProgress, error) {
+	progress := make([]dinkur.GoalProgress, 0, len(slice))
+	for i, p := range slice {
+		if p == nil {
+			continue
+		}
+		goal, err := GoalPtrNoNil(p.Goal)
+		if err != nil {
+			return nil, fmt.Errorf("goal progress #%d: %w", i+1, err)
+		}
+		progress = append(progress, dinkur.GoalProgress{
+			Goal:    goal,
+			Start:   TimeOrZero(p.Start),
+			End:     TimeOrZero(p.End),
+			Tracked: DurationOrZero(p.Tracked),
+		})
+	}
+	return progress, nil
+}
@@ -0,0 +1,69 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"fmt"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// JournalAction converts a gRPC journal action to a Go journal action.
+func JournalAction(action dinkurapiv1.JournalAction) dinkur.JournalAction {
+	switch action {
+	case dinkurapiv1.JournalAction_JOURNAL_ACTION_CREATE:
+		return dinkur.JournalActionCreate
+	case dinkurapiv1.JournalAction_JOURNAL_ACTION_UPDATE:
+		return dinkur.JournalActionUpdate
+	case dinkurapiv1.JournalAction_JOURNAL_ACTION_DELETE:
+		return dinkur.JournalActionDelete
+	case dinkurapiv1.JournalAction_JOURNAL_ACTION_STOP:
+		return dinkur.JournalActionStop
+	case dinkurapiv1.JournalAction_JOURNAL_ACTION_RESTORE:
+		return dinkur.JournalActionRestore
+	default:
+		return dinkur.JournalActionUnknown
+	}
+}
+
+// EntryChangeSlice converts a slice of gRPC entry changes to Go entry changes.
+// Nils are skipped.
+func EntryChangeSlice(slice []*dinkurapiv1.EntryChange) ([]dinkur.EntryChange, error) {
+	changes := make([]dinkur.EntryChange, 0, len(slice))
+	for i, ch := range slice {
+		if ch == nil {
+			continue
+		}
+		before, err := EntryPtr(ch.Before)
+		if err != nil {
+			return nil, fmt.Errorf("entry change #%d before: %w", i+1, err)
+		}
+		after, err := EntryPtr(ch.After)
+		if err != nil {
+			return nil, fmt.Errorf("entry change #%d after: %w", i+1, err)
+		}
+		changes = append(changes, dinkur.EntryChange{
+			Before: before,
+			After:  after,
+		})
+	}
+	return changes, nil
+}
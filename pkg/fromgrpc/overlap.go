@@ -0,0 +1,67 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"fmt"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// OverlapPolicy converts a gRPC overlap policy to a Go overlap policy.
+func OverlapPolicy(policy dinkurapiv1.OverlapPolicy) (dinkur.OverlapPolicy, error) {
+	switch policy {
+	case dinkurapiv1.OverlapPolicy_OVERLAP_POLICY_ALLOW:
+		return dinkur.OverlapPolicyAllow, nil
+	case dinkurapiv1.OverlapPolicy_OVERLAP_POLICY_REJECT:
+		return dinkur.OverlapPolicyReject, nil
+	case dinkurapiv1.OverlapPolicy_OVERLAP_POLICY_TRIM:
+		return dinkur.OverlapPolicyTrim, nil
+	case dinkurapiv1.OverlapPolicy_OVERLAP_POLICY_SPLIT:
+		return dinkur.OverlapPolicySplit, nil
+	default:
+		return 0, fmt.Errorf("%w: %d", dinkur.ErrOverlapPolicyInvalid, policy)
+	}
+}
+
+// EntryOverlapSlice converts a slice of gRPC entry overlaps to Go entry
+// overlaps. Nils are skipped.
+func EntryOverlapSlice(slice []*dinkurapiv1.EntryOverlap) ([]dinkur.EntryOverlap, error) {
+	overlaps := make([]dinkur.EntryOverlap, 0, len(slice))
+	for i, overlap := range slice {
+		if overlap == nil {
+			continue
+		}
+		first, err := EntryPtrNoNil(overlap.First)
+		if err != nil {
+			return nil, fmt.Errorf("entry overlap #%d first: %w", i+1, err)
+		}
+		second, err := EntryPtrNoNil(overlap.Second)
+		if err != nil {
+			return nil, fmt.Errorf("entry overlap #%d second: %w", i+1, err)
+		}
+		overlaps = append(overlaps, dinkur.EntryOverlap{
+			First:  first,
+			Second: second,
+		})
+	}
+	return overlaps, nil
+}
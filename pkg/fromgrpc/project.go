@@ -0,0 +1,79 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"fmt"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// ProjectPtr converts a gRPC project to a Go project.
+func ProjectPtr(project *dinkurapiv1.Project) (*dinkur.Project, error) {
+	if project == nil {
+		return nil, nil
+	}
+	id, err := conv.Uint64ToUint(project.Id)
+	if err != nil {
+		return nil, fmt.Errorf("convert project ID: %w", err)
+	}
+	return &dinkur.Project{
+		CommonFields: dinkur.CommonFields{
+			TimeFields: dinkur.TimeFields{
+				CreatedAt: TimeOrZero(project.Created),
+				UpdatedAt: TimeOrZero(project.Updated),
+			},
+			ID: id,
+		},
+		Name:   project.Name,
+		Client: project.Client,
+	}, nil
+}
+
+// ProjectPtrNoNil converts a gRPC project to a Go project, or error if nil.
+func ProjectPtrNoNil(project *dinkurapiv1.Project) (dinkur.Project, error) {
+	p, err := ProjectPtr(project)
+	if err != nil {
+		return dinkur.Project{}, err
+	}
+	if p == nil {
+		return dinkur.Project{}, ErrUnexpectedNilProject
+	}
+	return *p, nil
+}
+
+// ProjectSlice converts a slice of gRPC projects to Go projects. Nils are
+// skipped.
+func ProjectSlice(slice []*dinkurapiv1.Project) ([]dinkur.Project, error) {
+	projects := make([]dinkur.Project, 0, len(slice))
+	for _, p := range slice {
+		p2, err := ProjectPtr(p)
+		if err != nil {
+			return nil, fmt.Errorf("project #%d %q: %w", p.Id, p.Name, err)
+		}
+		if p2 == nil {
+			continue
+		}
+		projects = append(projects, *p2)
+	}
+	return projects, nil
+}
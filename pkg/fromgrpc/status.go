@@ -21,6 +21,7 @@ package fromgrpc
 
 import (
 	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
 	"github.com/dinkur/dinkur/pkg/dinkur"
 )
 
@@ -29,12 +30,18 @@ func StatusPtrNoNil(status *dinkurapiv1.Status) (dinkur.Status, error) {
 	if status == nil {
 		return dinkur.Status{}, ErrUnexpectedNilStatus
 	}
+	autoStoppedEntryID, err := conv.Uint64ToUint(status.AutoStoppedEntryIdOrZero)
+	if err != nil {
+		return dinkur.Status{}, err
+	}
 	return dinkur.Status{
 		TimeFields: dinkur.TimeFields{
 			CreatedAt: TimeOrZero(status.Created),
 			UpdatedAt: TimeOrZero(status.Updated),
 		},
-		AFKSince:  TimePtr(status.AfkSince),
-		BackSince: TimePtr(status.BackSince),
+		AFKSince:                 TimePtr(status.AfkSince),
+		BackSince:                TimePtr(status.BackSince),
+		LastSeenAlive:            TimePtr(status.LastSeenAlive),
+		AutoStoppedEntryIDOrZero: autoStoppedEntryID,
 	}, nil
 }
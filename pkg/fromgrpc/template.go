@@ -0,0 +1,96 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package fromgrpc
+
+import (
+	"fmt"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/conv"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"gopkg.in/typ.v4/slices"
+)
+
+// TemplatePtr converts a gRPC template to a Go template.
+func TemplatePtr(template *dinkurapiv1.Template) (*dinkur.Template, error) {
+	if template == nil {
+		return nil, nil
+	}
+	id, err := conv.Uint64ToUint(template.Id)
+	if err != nil {
+		return nil, fmt.Errorf("convert template ID: %w", err)
+	}
+	return &dinkur.Template{
+		CommonFields: dinkur.CommonFields{
+			TimeFields: dinkur.TimeFields{
+				CreatedAt: TimeOrZero(template.Created),
+				UpdatedAt: TimeOrZero(template.Updated),
+			},
+			ID: id,
+		},
+		Name:       template.Name,
+		EntryName:  template.EntryName,
+		Duration:   DurationOrZero(template.Duration),
+		Tags:       template.Tags,
+		Recurrence: RecurrencePtr(template.Recurrence),
+	}, nil
+}
+
+// TemplatePtrNoNil converts a gRPC template to a Go template, or error if nil.
+func TemplatePtrNoNil(template *dinkurapiv1.Template) (dinkur.Template, error) {
+	t, err := TemplatePtr(template)
+	if err != nil {
+		return dinkur.Template{}, err
+	}
+	if t == nil {
+		return dinkur.Template{}, ErrUnexpectedNilTemplate
+	}
+	return *t, nil
+}
+
+// TemplateSlice converts a slice of gRPC templates to Go templates. Nils are
+// skipped.
+func TemplateSlice(slice []*dinkurapiv1.Template) ([]dinkur.Template, error) {
+	templates := make([]dinkur.Template, 0, len(slice))
+	for _, t := range slice {
+		t2, err := TemplatePtr(t)
+		if err != nil {
+			return nil, fmt.Errorf("template #%d %q: %w", t.Id, t.Name, err)
+		}
+		if t2 == nil {
+			continue
+		}
+		templates = append(templates, *t2)
+	}
+	return templates, nil
+}
+
+// RecurrencePtr converts a gRPC recurrence to a Go recurrence.
+func RecurrencePtr(recurrence *dinkurapiv1.Recurrence) *dinkur.Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+	return &dinkur.Recurrence{
+		Rule:       recurrence.Rule,
+		StartTime:  DurationOrZero(recurrence.StartTime),
+		Since:      TimeOrZero(recurrence.Since),
+		Exceptions: slices.Map(recurrence.Exceptions, TimeOrZero),
+	}
+}
@@ -22,6 +22,7 @@ package fromgrpc
 import (
 	"time"
 
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/typ.v4"
 )
@@ -49,3 +50,11 @@ func TimeOrNow(ts *timestamppb.Timestamp) time.Time {
 	}
 	return ts.AsTime()
 }
+
+// DurationOrZero converts gRPC duration to Go duration, or zero if nil.
+func DurationOrZero(d *durationpb.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+	return d.AsDuration()
+}
@@ -0,0 +1,68 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// AwayAction converts a Go away action to a gRPC away action.
+func AwayAction(action dinkur.AwayAction) dinkurapiv1.AwayAction {
+	switch action {
+	case dinkur.AwayActionKeep:
+		return dinkurapiv1.AwayAction_AWAY_ACTION_KEEP
+	case dinkur.AwayActionDiscard:
+		return dinkurapiv1.AwayAction_AWAY_ACTION_DISCARD
+	case dinkur.AwayActionSaveAsNew:
+		return dinkurapiv1.AwayAction_AWAY_ACTION_SAVE_AS_NEW
+	case dinkur.AwayActionSplit:
+		return dinkurapiv1.AwayAction_AWAY_ACTION_SPLIT
+	default:
+		return dinkurapiv1.AwayAction_AWAY_ACTION_UNSPECIFIED
+	}
+}
+
+// PendingAwayPtr converts a Go pending away time to a gRPC pending away time.
+func PendingAwayPtr(pending *dinkur.PendingAway) *dinkurapiv1.PendingAway {
+	if pending == nil {
+		return nil
+	}
+	return &dinkurapiv1.PendingAway{
+		AfkSince:    Timestamp(pending.AFKSince),
+		BackSince:   Timestamp(pending.BackSince),
+		ActiveEntry: EntryPtr(&pending.ActiveEntry),
+	}
+}
+
+// AwayPartSlice converts a slice of Go away time parts to a slice of gRPC away
+// time parts.
+func AwayPartSlice(slice []dinkur.AwayPart) []*dinkurapiv1.AwayPart {
+	result := make([]*dinkurapiv1.AwayPart, len(slice))
+	for i, part := range slice {
+		result[i] = &dinkurapiv1.AwayPart{
+			Name:            part.Name,
+			Tags:            part.Tags,
+			ProjectIdOrZero: uint64(part.ProjectIDOrZero),
+			End:             TimestampPtr(part.End),
+		}
+	}
+	return result
+}
@@ -34,8 +34,12 @@ func EntryPtr(entry *dinkur.Entry) *dinkurapiv1.Entry {
 		Created: Timestamp(entry.CreatedAt),
 		Updated: Timestamp(entry.UpdatedAt),
 		Name:    entry.Name,
+		Note:    entry.Note,
 		Start:   Timestamp(entry.Start),
 		End:     TimestampPtr(entry.End),
+		Tags:    entry.Tags,
+		Project: ProjectPtr(entry.Project),
+		Deleted: TimestampPtr(entry.DeletedAt),
 	}
 }
 
@@ -0,0 +1,50 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	"time"
+
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// WeekdaySlice converts a slice of Go weekdays to gRPC weekdays.
+func WeekdaySlice(slice []time.Weekday) []dinkurapiv1.Weekday {
+	weekdays := make([]dinkurapiv1.Weekday, len(slice))
+	for i, d := range slice {
+		weekdays[i] = dinkurapiv1.Weekday(d)
+	}
+	return weekdays
+}
+
+// EntryGapSlice converts a slice of Go entry gaps to gRPC entry gaps.
+func EntryGapSlice(slice []dinkur.EntryGap) []*dinkurapiv1.EntryGap {
+	gaps := make([]*dinkurapiv1.EntryGap, len(slice))
+	for i, g := range slice {
+		gaps[i] = &dinkurapiv1.EntryGap{
+			Start:    Timestamp(g.Start),
+			End:      Timestamp(g.End),
+			Previous: EntryPtr(g.Previous),
+			Next:     EntryPtr(g.Next),
+		}
+	}
+	return gaps
+}
@@ -0,0 +1,55 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// EntrySummaryGroup converts a Go entry summary grouping to a gRPC grouping.
+func EntrySummaryGroup(g dinkur.EntrySummaryGroup) dinkurapiv1.GetEntrySummaryRequest_GroupBy {
+	switch g {
+	case dinkur.EntrySummaryGroupWeek:
+		return dinkurapiv1.GetEntrySummaryRequest_GROUP_BY_WEEK
+	case dinkur.EntrySummaryGroupMonth:
+		return dinkurapiv1.GetEntrySummaryRequest_GROUP_BY_MONTH
+	case dinkur.EntrySummaryGroupName:
+		return dinkurapiv1.GetEntrySummaryRequest_GROUP_BY_NAME
+	default:
+		return dinkurapiv1.GetEntrySummaryRequest_GROUP_BY_DAY
+	}
+}
+
+// EntrySummarySlice converts a slice of Go entry summaries to gRPC entry
+// summaries.
+func EntrySummarySlice(slice []dinkur.EntrySummary) []*dinkurapiv1.EntrySummary {
+	summaries := make([]*dinkurapiv1.EntrySummary, len(slice))
+	for i, s := range slice {
+		summaries[i] = &dinkurapiv1.EntrySummary{
+			Key:      s.Key,
+			Start:    TimestampPtr(s.Start),
+			End:      TimestampPtr(s.End),
+			Entries:  uint64(s.Entries),
+			Duration: Duration(s.Duration),
+		}
+	}
+	return summaries
+}
@@ -33,6 +33,8 @@ func Event(ev dinkur.EventType) dinkurapiv1.Event {
 		return dinkurapiv1.Event_EVENT_UPDATED
 	case dinkur.EventDeleted:
 		return dinkurapiv1.Event_EVENT_DELETED
+	case dinkur.EventRestored:
+		return dinkurapiv1.Event_EVENT_RESTORED
 	default:
 		return dinkurapiv1.Event_EVENT_UNSPECIFIED
 	}
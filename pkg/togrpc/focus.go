@@ -0,0 +1,54 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// FocusPhase converts a Go focus phase to a gRPC focus phase.
+func FocusPhase(phase dinkur.FocusPhase) dinkurapiv1.FocusPhase {
+	switch phase {
+	case dinkur.FocusPhaseIdle:
+		return dinkurapiv1.FocusPhase_FOCUS_PHASE_IDLE
+	case dinkur.FocusPhaseWork:
+		return dinkurapiv1.FocusPhase_FOCUS_PHASE_WORK
+	case dinkur.FocusPhaseBreak:
+		return dinkurapiv1.FocusPhase_FOCUS_PHASE_BREAK
+	default:
+		return dinkurapiv1.FocusPhase_FOCUS_PHASE_UNSPECIFIED
+	}
+}
+
+// Focus converts a Go focus timer state to a gRPC focus timer state.
+func Focus(focus dinkur.Focus) *dinkurapiv1.FocusState {
+	return &dinkurapiv1.FocusState{
+		Phase:         FocusPhase(focus.Phase),
+		Name:          focus.Name,
+		Cycle:         uint64(focus.Cycle),
+		Cycles:        uint64(focus.Cycles),
+		Work:          Duration(focus.Work),
+		Break:         Duration(focus.Break),
+		PhaseStart:    Timestamp(focus.PhaseStart),
+		PhaseEnd:      Timestamp(focus.PhaseEnd),
+		EntryIdOrZero: uint64(focus.EntryIDOrZero),
+	}
+}
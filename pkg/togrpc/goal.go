@@ -0,0 +1,76 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// GoalPeriod converts a Go goal period to a gRPC goal period.
+func GoalPeriod(period dinkur.GoalPeriod) dinkurapiv1.GoalPeriod {
+	switch period {
+	case dinkur.GoalPeriodDay:
+		return dinkurapiv1.GoalPeriod_GOAL_PERIOD_DAY
+	case dinkur.GoalPeriodWeek:
+		return dinkurapiv1.GoalPeriod_GOAL_PERIOD_WEEK
+	default:
+		return dinkurapiv1.GoalPeriod_GOAL_PERIOD_UNSPECIFIED
+	}
+}
+
+// GoalPtr converts a Go goal pointer to a gRPC goal.
+func GoalPtr(goal *dinkur.Goal) *dinkurapiv1.Goal {
+	if goal == nil {
+		return nil
+	}
+	return &dinkurapiv1.Goal{
+		Id:      uint64(goal.ID),
+		Created: Timestamp(goal.CreatedAt),
+		Updated: Timestamp(goal.UpdatedAt),
+		Period:  GoalPeriod(goal.Period),
+		Target:  Duration(goal.Target),
+		Project: ProjectPtr(goal.Project),
+	}
+}
+
+// GoalSlice converts a slice of Go goals to gRPC goals.
+func GoalSlice(slice []dinkur.Goal) []*dinkurapiv1.Goal {
+	goals := make([]*dinkurapiv1.Goal, len(slice))
+	for i := range slice {
+		goals[i] = GoalPtr(&slice[i])
+	}
+	return goals
+}
+
+// GoalProgressSlice converts a slice of Go goal progress to gRPC goal
+// progress.
+func GoalProgressSlice(slice []dinkur.GoalProgress) []*dinkurapiv1.GoalProgress {
+	progress := make([]*dinkurapiv1.GoalProgress, len(slice))
+	for i := range slice {
+		progress[i] = &dinkurapiv1.GoalProgress{
+			Goal:    GoalPtr(&slice[i].Goal),
+			Start:   Timestamp(slice[i].Start),
+			End:     Timestamp(slice[i].End),
+			Tracked: Duration(slice[i].Tracked),
+		}
+	}
+	return progress
+}
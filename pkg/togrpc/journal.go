@@ -0,0 +1,55 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// JournalAction converts a Go journal action to a gRPC journal action.
+func JournalAction(action dinkur.JournalAction) dinkurapiv1.JournalAction {
+	switch action {
+	case dinkur.JournalActionCreate:
+		return dinkurapiv1.JournalAction_JOURNAL_ACTION_CREATE
+	case dinkur.JournalActionUpdate:
+		return dinkurapiv1.JournalAction_JOURNAL_ACTION_UPDATE
+	case dinkur.JournalActionDelete:
+		return dinkurapiv1.JournalAction_JOURNAL_ACTION_DELETE
+	case dinkur.JournalActionStop:
+		return dinkurapiv1.JournalAction_JOURNAL_ACTION_STOP
+	case dinkur.JournalActionRestore:
+		return dinkurapiv1.JournalAction_JOURNAL_ACTION_RESTORE
+	default:
+		return dinkurapiv1.JournalAction_JOURNAL_ACTION_UNSPECIFIED
+	}
+}
+
+// EntryChangeSlice converts a slice of Go entry changes to gRPC entry changes.
+func EntryChangeSlice(slice []dinkur.EntryChange) []*dinkurapiv1.EntryChange {
+	changes := make([]*dinkurapiv1.EntryChange, len(slice))
+	for i, ch := range slice {
+		changes[i] = &dinkurapiv1.EntryChange{
+			Before: EntryPtr(ch.Before),
+			After:  EntryPtr(ch.After),
+		}
+	}
+	return changes
+}
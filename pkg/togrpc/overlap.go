@@ -0,0 +1,54 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// OverlapPolicy converts a Go overlap policy to a gRPC overlap policy.
+func OverlapPolicy(policy dinkur.OverlapPolicy) dinkurapiv1.OverlapPolicy {
+	switch policy {
+	case dinkur.OverlapPolicyAllow:
+		return dinkurapiv1.OverlapPolicy_OVERLAP_POLICY_ALLOW
+	case dinkur.OverlapPolicyReject:
+		return dinkurapiv1.OverlapPolicy_OVERLAP_POLICY_REJECT
+	case dinkur.OverlapPolicyTrim:
+		return dinkurapiv1.OverlapPolicy_OVERLAP_POLICY_TRIM
+	case dinkur.OverlapPolicySplit:
+		return dinkurapiv1.OverlapPolicy_OVERLAP_POLICY_SPLIT
+	default:
+		return dinkurapiv1.OverlapPolicy_OVERLAP_POLICY_UNSPECIFIED
+	}
+}
+
+// EntryOverlapSlice converts a slice of Go entry overlaps to gRPC entry
+// overlaps.
+func EntryOverlapSlice(slice []dinkur.EntryOverlap) []*dinkurapiv1.EntryOverlap {
+	overlaps := make([]*dinkurapiv1.EntryOverlap, len(slice))
+	for i, overlap := range slice {
+		overlaps[i] = &dinkurapiv1.EntryOverlap{
+			First:  EntryPtr(&overlap.First),
+			Second: EntryPtr(&overlap.Second),
+		}
+	}
+	return overlaps
+}
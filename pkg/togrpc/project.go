@@ -0,0 +1,48 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+)
+
+// ProjectPtr converts a Go project pointer to a gRPC project.
+func ProjectPtr(project *dinkur.Project) *dinkurapiv1.Project {
+	if project == nil {
+		return nil
+	}
+	return &dinkurapiv1.Project{
+		Id:      uint64(project.ID),
+		Created: Timestamp(project.CreatedAt),
+		Updated: Timestamp(project.UpdatedAt),
+		Name:    project.Name,
+		Client:  project.Client,
+	}
+}
+
+// ProjectSlice converts a slice of Go projects to gRPC projects.
+func ProjectSlice(slice []dinkur.Project) []*dinkurapiv1.Project {
+	projects := make([]*dinkurapiv1.Project, len(slice))
+	for i, p := range slice {
+		projects[i] = ProjectPtr(&p)
+	}
+	return projects
+}
@@ -27,9 +27,11 @@ import (
 // Status converts a dinkur status to a gRPC status.
 func Status(status dinkur.Status) *dinkurapiv1.Status {
 	return &dinkurapiv1.Status{
-		Created:   Timestamp(status.CreatedAt),
-		Updated:   Timestamp(status.UpdatedAt),
-		AfkSince:  TimestampPtr(status.AFKSince),
-		BackSince: TimestampPtr(status.BackSince),
+		Created:                  Timestamp(status.CreatedAt),
+		Updated:                  Timestamp(status.UpdatedAt),
+		AfkSince:                 TimestampPtr(status.AFKSince),
+		BackSince:                TimestampPtr(status.BackSince),
+		LastSeenAlive:            TimestampPtr(status.LastSeenAlive),
+		AutoStoppedEntryIdOrZero: uint64(status.AutoStoppedEntryIDOrZero),
 	}
 }
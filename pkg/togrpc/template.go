@@ -0,0 +1,75 @@
+// Dinkur the task time tracking utility.
+// <https://github.com/dinkur/dinkur>
+//
+// SPDX-FileCopyrightText: 2021 Kalle Fagerberg
+// SPDX-License-Identifier: GPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify it
+// under the terms of the GNU General Public License as published by the
+// Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful, but WITHOUT
+// ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or
+// FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for
+// more details.
+//
+// You should have received a copy of the GNU General Public License along
+// with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package togrpc
+
+import (
+	dinkurapiv1 "github.com/dinkur/dinkur/api/dinkurapi/v1"
+	"github.com/dinkur/dinkur/pkg/dinkur"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/typ.v4/slices"
+)
+
+// TemplatePtr converts a Go template pointer to a gRPC template.
+func TemplatePtr(template *dinkur.Template) *dinkurapiv1.Template {
+	if template == nil {
+		return nil
+	}
+	var duration *durationpb.Duration
+	if template.Duration > 0 {
+		duration = Duration(template.Duration)
+	}
+	return &dinkurapiv1.Template{
+		Id:         uint64(template.ID),
+		Created:    Timestamp(template.CreatedAt),
+		Updated:    Timestamp(template.UpdatedAt),
+		Name:       template.Name,
+		EntryName:  template.EntryName,
+		Duration:   duration,
+		Tags:       template.Tags,
+		Recurrence: RecurrencePtr(template.Recurrence),
+	}
+}
+
+// TemplateSlice converts a slice of Go templates to gRPC templates.
+func TemplateSlice(slice []dinkur.Template) []*dinkurapiv1.Template {
+	templates := make([]*dinkurapiv1.Template, len(slice))
+	for i := range slice {
+		templates[i] = TemplatePtr(&slice[i])
+	}
+	return templates
+}
+
+// RecurrencePtr converts a Go recurrence pointer to a gRPC recurrence.
+func RecurrencePtr(recurrence *dinkur.Recurrence) *dinkurapiv1.Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+	var since *timestamppb.Timestamp
+	if !recurrence.Since.IsZero() {
+		since = Timestamp(recurrence.Since)
+	}
+	return &dinkurapiv1.Recurrence{
+		Rule:       recurrence.Rule,
+		StartTime:  Duration(recurrence.StartTime),
+		Since:      since,
+		Exceptions: slices.Map(recurrence.Exceptions, Timestamp),
+	}
+}
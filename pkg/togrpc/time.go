@@ -22,6 +22,7 @@ package togrpc
 import (
 	"time"
 
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -37,3 +38,8 @@ func TimestampPtr(t *time.Time) *timestamppb.Timestamp {
 	}
 	return timestamppb.New(*t)
 }
+
+// Duration converts Go duration to gRPC duration.
+func Duration(d time.Duration) *durationpb.Duration {
+	return durationpb.New(d)
+}